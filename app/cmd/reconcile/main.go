@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/app"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// Compares the image storage with the images in the database once and reports the orphans.
+// The orphaned objects are deleted only when the command is run with -dry-run=false
+func main() {
+	isDryRun := flag.Bool("dry-run", true, "report the orphans without deleting them")
+	flag.Parse()
+	ctx, cancel := signal.NotifyContext(context.Background(),
+		os.Interrupt, os.Kill, syscall.SIGQUIT, syscall.SIGTERM)
+	defer cancel()
+	a := app.New()
+	if err := a.ReconcileStorage(ctx, *isDryRun); err != nil {
+		cancel()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,1035 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v3.12.4
+// source: contracts/proto/events/event.proto
+
+package events
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED       EventType = 0
+	EventType_EVENT_TYPE_LIKE_RECEIVED     EventType = 1 // пользователь получил лайк
+	EventType_EVENT_TYPE_MATCH_CREATED     EventType = 2 // взаимная симпатия
+	EventType_EVENT_TYPE_MESSAGE_RECEIVED  EventType = 3 // новое сообщение в чате
+	EventType_EVENT_TYPE_PROFILE_BLOCKED   EventType = 4 // профиль заблокирован модерацией
+	EventType_EVENT_TYPE_PAYMENT_SUCCEEDED EventType = 5 // платеж прошел успешно
+	EventType_EVENT_TYPE_IMAGE_MODERATED   EventType = 6 // фотография прошла модерацию
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_LIKE_RECEIVED",
+		2: "EVENT_TYPE_MATCH_CREATED",
+		3: "EVENT_TYPE_MESSAGE_RECEIVED",
+		4: "EVENT_TYPE_PROFILE_BLOCKED",
+		5: "EVENT_TYPE_PAYMENT_SUCCEEDED",
+		6: "EVENT_TYPE_IMAGE_MODERATED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":       0,
+		"EVENT_TYPE_LIKE_RECEIVED":     1,
+		"EVENT_TYPE_MATCH_CREATED":     2,
+		"EVENT_TYPE_MESSAGE_RECEIVED":  3,
+		"EVENT_TYPE_PROFILE_BLOCKED":   4,
+		"EVENT_TYPE_PAYMENT_SUCCEEDED": 5,
+		"EVENT_TYPE_IMAGE_MODERATED":   6,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_contracts_proto_events_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_contracts_proto_events_event_proto_enumTypes[0]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{0}
+}
+
+type EventVersion int32
+
+const (
+	EventVersion_EVENT_VERSION_UNSPECIFIED EventVersion = 0
+	EventVersion_EVENT_VERSION_V1          EventVersion = 1 // текущая версия схемы событий
+)
+
+// Enum value maps for EventVersion.
+var (
+	EventVersion_name = map[int32]string{
+		0: "EVENT_VERSION_UNSPECIFIED",
+		1: "EVENT_VERSION_V1",
+	}
+	EventVersion_value = map[string]int32{
+		"EVENT_VERSION_UNSPECIFIED": 0,
+		"EVENT_VERSION_V1":          1,
+	}
+)
+
+func (x EventVersion) Enum() *EventVersion {
+	p := new(EventVersion)
+	*p = x
+	return p
+}
+
+func (x EventVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_contracts_proto_events_event_proto_enumTypes[1].Descriptor()
+}
+
+func (EventVersion) Type() protoreflect.EnumType {
+	return &file_contracts_proto_events_event_proto_enumTypes[1]
+}
+
+func (x EventVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventVersion.Descriptor instead.
+func (EventVersion) EnumDescriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{1}
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                           // идентификатор события
+	Type                    EventType            `protobuf:"varint,2,opt,name=type,proto3,enum=events.EventType" json:"type,omitempty"`                // тип события
+	Version                 EventVersion         `protobuf:"varint,3,opt,name=version,proto3,enum=events.EventVersion" json:"version,omitempty"`       // версия схемы события
+	CreatedAt               *timestamp.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                             // время создания события
+	RecipientTelegramUserId string               `protobuf:"bytes,5,opt,name=recipientTelegramUserId,proto3" json:"recipientTelegramUserId,omitempty"` // получатель события
+	// Types that are assignable to Payload:
+	//	*Event_LikeReceived
+	//	*Event_MatchCreated
+	//	*Event_MessageReceived
+	//	*Event_ProfileBlocked
+	//	*Event_PaymentSucceeded
+	//	*Event_ImageModerated
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetVersion() EventVersion {
+	if x != nil {
+		return x.Version
+	}
+	return EventVersion_EVENT_VERSION_UNSPECIFIED
+}
+
+func (x *Event) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Event) GetRecipientTelegramUserId() string {
+	if x != nil {
+		return x.RecipientTelegramUserId
+	}
+	return ""
+}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetLikeReceived() *LikeReceived {
+	if x, ok := x.GetPayload().(*Event_LikeReceived); ok {
+		return x.LikeReceived
+	}
+	return nil
+}
+
+func (x *Event) GetMatchCreated() *MatchCreated {
+	if x, ok := x.GetPayload().(*Event_MatchCreated); ok {
+		return x.MatchCreated
+	}
+	return nil
+}
+
+func (x *Event) GetMessageReceived() *MessageReceived {
+	if x, ok := x.GetPayload().(*Event_MessageReceived); ok {
+		return x.MessageReceived
+	}
+	return nil
+}
+
+func (x *Event) GetProfileBlocked() *ProfileBlocked {
+	if x, ok := x.GetPayload().(*Event_ProfileBlocked); ok {
+		return x.ProfileBlocked
+	}
+	return nil
+}
+
+func (x *Event) GetPaymentSucceeded() *PaymentSucceeded {
+	if x, ok := x.GetPayload().(*Event_PaymentSucceeded); ok {
+		return x.PaymentSucceeded
+	}
+	return nil
+}
+
+func (x *Event) GetImageModerated() *ImageModerated {
+	if x, ok := x.GetPayload().(*Event_ImageModerated); ok {
+		return x.ImageModerated
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_LikeReceived struct {
+	LikeReceived *LikeReceived `protobuf:"bytes,6,opt,name=likeReceived,proto3,oneof"`
+}
+
+type Event_MatchCreated struct {
+	MatchCreated *MatchCreated `protobuf:"bytes,7,opt,name=matchCreated,proto3,oneof"`
+}
+
+type Event_MessageReceived struct {
+	MessageReceived *MessageReceived `protobuf:"bytes,8,opt,name=messageReceived,proto3,oneof"`
+}
+
+type Event_ProfileBlocked struct {
+	ProfileBlocked *ProfileBlocked `protobuf:"bytes,9,opt,name=profileBlocked,proto3,oneof"`
+}
+
+type Event_PaymentSucceeded struct {
+	PaymentSucceeded *PaymentSucceeded `protobuf:"bytes,10,opt,name=paymentSucceeded,proto3,oneof"`
+}
+
+type Event_ImageModerated struct {
+	ImageModerated *ImageModerated `protobuf:"bytes,11,opt,name=imageModerated,proto3,oneof"`
+}
+
+func (*Event_LikeReceived) isEvent_Payload() {}
+
+func (*Event_MatchCreated) isEvent_Payload() {}
+
+func (*Event_MessageReceived) isEvent_Payload() {}
+
+func (*Event_ProfileBlocked) isEvent_Payload() {}
+
+func (*Event_PaymentSucceeded) isEvent_Payload() {}
+
+func (*Event_ImageModerated) isEvent_Payload() {}
+
+type LikeReceived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // кто поставил лайк
+	Username       string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	UserImageUrl   string `protobuf:"bytes,3,opt,name=userImageUrl,proto3" json:"userImageUrl,omitempty"`
+	Message        string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`           // текст уведомления на языке получателя
+	LanguageCode   string `protobuf:"bytes,5,opt,name=languageCode,proto3" json:"languageCode,omitempty"` // язык получателя
+}
+
+func (x *LikeReceived) Reset() {
+	*x = LikeReceived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeReceived) ProtoMessage() {}
+
+func (x *LikeReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeReceived.ProtoReflect.Descriptor instead.
+func (*LikeReceived) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LikeReceived) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *LikeReceived) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LikeReceived) GetUserImageUrl() string {
+	if x != nil {
+		return x.UserImageUrl
+	}
+	return ""
+}
+
+func (x *LikeReceived) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LikeReceived) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+type MatchCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // с кем произошла взаимная симпатия
+	Username       string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	UserImageUrl   string `protobuf:"bytes,3,opt,name=userImageUrl,proto3" json:"userImageUrl,omitempty"`
+	Message        string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // текст уведомления на языке получателя
+}
+
+func (x *MatchCreated) Reset() {
+	*x = MatchCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchCreated) ProtoMessage() {}
+
+func (x *MatchCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchCreated.ProtoReflect.Descriptor instead.
+func (*MatchCreated) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MatchCreated) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetUserImageUrl() string {
+	if x != nil {
+		return x.UserImageUrl
+	}
+	return ""
+}
+
+func (x *MatchCreated) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MessageReceived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationId       uint64 `protobuf:"varint,1,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	MessageId            uint64 `protobuf:"varint,2,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	SenderTelegramUserId string `protobuf:"bytes,3,opt,name=senderTelegramUserId,proto3" json:"senderTelegramUserId,omitempty"`
+	Username             string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	UserImageUrl         string `protobuf:"bytes,5,opt,name=userImageUrl,proto3" json:"userImageUrl,omitempty"`
+	Text                 string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *MessageReceived) Reset() {
+	*x = MessageReceived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReceived) ProtoMessage() {}
+
+func (x *MessageReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReceived.ProtoReflect.Descriptor instead.
+func (*MessageReceived) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MessageReceived) GetConversationId() uint64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MessageReceived) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MessageReceived) GetSenderTelegramUserId() string {
+	if x != nil {
+		return x.SenderTelegramUserId
+	}
+	return ""
+}
+
+func (x *MessageReceived) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MessageReceived) GetUserImageUrl() string {
+	if x != nil {
+		return x.UserImageUrl
+	}
+	return ""
+}
+
+func (x *MessageReceived) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ProfileBlocked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // причина блокировки
+}
+
+func (x *ProfileBlocked) Reset() {
+	*x = ProfileBlocked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileBlocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileBlocked) ProtoMessage() {}
+
+func (x *ProfileBlocked) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileBlocked.ProtoReflect.Descriptor instead.
+func (*ProfileBlocked) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProfileBlocked) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PaymentSucceeded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price          string               `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Currency       string               `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Tariff         string               `protobuf:"bytes,3,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	AvailableUntil *timestamp.Timestamp `protobuf:"bytes,4,opt,name=availableUntil,proto3" json:"availableUntil,omitempty"` // окончание действия тарифа
+	LanguageCode   string               `protobuf:"bytes,5,opt,name=languageCode,proto3" json:"languageCode,omitempty"`     // язык получателя
+}
+
+func (x *PaymentSucceeded) Reset() {
+	*x = PaymentSucceeded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSucceeded) ProtoMessage() {}
+
+func (x *PaymentSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSucceeded.ProtoReflect.Descriptor instead.
+func (*PaymentSucceeded) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PaymentSucceeded) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetTariff() string {
+	if x != nil {
+		return x.Tariff
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetAvailableUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.AvailableUntil
+	}
+	return nil
+}
+
+func (x *PaymentSucceeded) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+type ImageModerated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageId      uint64 `protobuf:"varint,1,opt,name=imageId,proto3" json:"imageId,omitempty"`
+	IsApproved   bool   `protobuf:"varint,2,opt,name=isApproved,proto3" json:"isApproved,omitempty"`    // фотография одобрена или заблокирована
+	Reason       string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`             // причина блокировки
+	LanguageCode string `protobuf:"bytes,4,opt,name=languageCode,proto3" json:"languageCode,omitempty"` // язык получателя
+}
+
+func (x *ImageModerated) Reset() {
+	*x = ImageModerated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_events_event_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageModerated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageModerated) ProtoMessage() {}
+
+func (x *ImageModerated) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_events_event_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageModerated.ProtoReflect.Descriptor instead.
+func (*ImageModerated) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_events_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImageModerated) GetImageId() uint64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+func (x *ImageModerated) GetIsApproved() bool {
+	if x != nil {
+		return x.IsApproved
+	}
+	return false
+}
+
+func (x *ImageModerated) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImageModerated) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+var File_contracts_proto_events_event_proto protoreflect.FileDescriptor
+
+var file_contracts_proto_events_event_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x04,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0c, 0x6c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x40, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xdf, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc4, 0x01, 0x0a,
+	0x10, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x12, 0x42, 0x0a, 0x0e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x2a, 0xe6, 0x01, 0x0a,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x43, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x31, 0x10, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x45, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79,
+	0x42, 0x75, 0x64, 0x61, 0x65, 0x76, 0x2f, 0x74, 0x67, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2d,
+	0x67, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_contracts_proto_events_event_proto_rawDescOnce sync.Once
+	file_contracts_proto_events_event_proto_rawDescData = file_contracts_proto_events_event_proto_rawDesc
+)
+
+func file_contracts_proto_events_event_proto_rawDescGZIP() []byte {
+	file_contracts_proto_events_event_proto_rawDescOnce.Do(func() {
+		file_contracts_proto_events_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_contracts_proto_events_event_proto_rawDescData)
+	})
+	return file_contracts_proto_events_event_proto_rawDescData
+}
+
+var file_contracts_proto_events_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_contracts_proto_events_event_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_contracts_proto_events_event_proto_goTypes = []interface{}{
+	(EventType)(0),              // 0: events.EventType
+	(EventVersion)(0),           // 1: events.EventVersion
+	(*Event)(nil),               // 2: events.Event
+	(*LikeReceived)(nil),        // 3: events.LikeReceived
+	(*MatchCreated)(nil),        // 4: events.MatchCreated
+	(*MessageReceived)(nil),     // 5: events.MessageReceived
+	(*ProfileBlocked)(nil),      // 6: events.ProfileBlocked
+	(*PaymentSucceeded)(nil),    // 7: events.PaymentSucceeded
+	(*ImageModerated)(nil),      // 8: events.ImageModerated
+	(*timestamp.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_contracts_proto_events_event_proto_depIdxs = []int32{
+	0,  // 0: events.Event.type:type_name -> events.EventType
+	1,  // 1: events.Event.version:type_name -> events.EventVersion
+	9,  // 2: events.Event.createdAt:type_name -> google.protobuf.Timestamp
+	3,  // 3: events.Event.likeReceived:type_name -> events.LikeReceived
+	4,  // 4: events.Event.matchCreated:type_name -> events.MatchCreated
+	5,  // 5: events.Event.messageReceived:type_name -> events.MessageReceived
+	6,  // 6: events.Event.profileBlocked:type_name -> events.ProfileBlocked
+	7,  // 7: events.Event.paymentSucceeded:type_name -> events.PaymentSucceeded
+	8,  // 8: events.Event.imageModerated:type_name -> events.ImageModerated
+	9,  // 9: events.PaymentSucceeded.availableUntil:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_contracts_proto_events_event_proto_init() }
+func file_contracts_proto_events_event_proto_init() {
+	if File_contracts_proto_events_event_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_contracts_proto_events_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_events_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeReceived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_events_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_events_event_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReceived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_events_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileBlocked); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_events_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentSucceeded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_events_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageModerated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_contracts_proto_events_event_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Event_LikeReceived)(nil),
+		(*Event_MatchCreated)(nil),
+		(*Event_MessageReceived)(nil),
+		(*Event_ProfileBlocked)(nil),
+		(*Event_PaymentSucceeded)(nil),
+		(*Event_ImageModerated)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_contracts_proto_events_event_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_contracts_proto_events_event_proto_goTypes,
+		DependencyIndexes: file_contracts_proto_events_event_proto_depIdxs,
+		EnumInfos:         file_contracts_proto_events_event_proto_enumTypes,
+		MessageInfos:      file_contracts_proto_events_event_proto_msgTypes,
+	}.Build()
+	File_contracts_proto_events_event_proto = out.File
+	file_contracts_proto_events_event_proto_rawDesc = nil
+	file_contracts_proto_events_event_proto_goTypes = nil
+	file_contracts_proto_events_event_proto_depIdxs = nil
+}
@@ -152,10 +152,11 @@ type ImageResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                        // id изображения
-	TelegramUserId string `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
-	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                     // название файла
-	Url            string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                       // url файла
+	Id             uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                        // id изображения
+	TelegramUserId string          `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	Name           string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                     // название файла
+	Url            string          `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                       // url файла
+	Variants       []*ImageVariant `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`             // варианты изображения от меньшего к большему
 }
 
 func (x *ImageResponse) Reset() {
@@ -218,6 +219,84 @@ func (x *ImageResponse) GetUrl() string {
 	return ""
 }
 
+func (x *ImageResponse) GetVariants() []*ImageVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type ImageVariant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variant string `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"` // вариант изображения: thumbnail, medium, full
+	Url     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`         // url варианта
+	Width   int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`    // ширина в пикселях
+	Height  int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`  // высота в пикселях
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImageVariant) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type Point struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -230,7 +309,7 @@ type Point struct {
 func (x *Point) Reset() {
 	*x = Point{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[3]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -243,7 +322,7 @@ func (x *Point) String() string {
 func (*Point) ProtoMessage() {}
 
 func (x *Point) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[3]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +335,7 @@ func (x *Point) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Point.ProtoReflect.Descriptor instead.
 func (*Point) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{3}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Point) GetLatitude() float64 {
@@ -284,7 +363,7 @@ type NavigatorResponse struct {
 func (x *NavigatorResponse) Reset() {
 	*x = NavigatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[4]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -297,7 +376,7 @@ func (x *NavigatorResponse) String() string {
 func (*NavigatorResponse) ProtoMessage() {}
 
 func (x *NavigatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[4]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +389,7 @@ func (x *NavigatorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavigatorResponse.ProtoReflect.Descriptor instead.
 func (*NavigatorResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{4}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NavigatorResponse) GetLocation() *Point {
@@ -333,7 +412,7 @@ type NavigatorDetailResponse struct {
 func (x *NavigatorDetailResponse) Reset() {
 	*x = NavigatorDetailResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[5]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -346,7 +425,7 @@ func (x *NavigatorDetailResponse) String() string {
 func (*NavigatorDetailResponse) ProtoMessage() {}
 
 func (x *NavigatorDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[5]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +438,7 @@ func (x *NavigatorDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NavigatorDetailResponse.ProtoReflect.Descriptor instead.
 func (*NavigatorDetailResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{5}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NavigatorDetailResponse) GetCountryName() string {
@@ -401,7 +480,7 @@ type FilterResponse struct {
 func (x *FilterResponse) Reset() {
 	*x = FilterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[6]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +493,7 @@ func (x *FilterResponse) String() string {
 func (*FilterResponse) ProtoMessage() {}
 
 func (x *FilterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[6]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +506,7 @@ func (x *FilterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterResponse.ProtoReflect.Descriptor instead.
 func (*FilterResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{6}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FilterResponse) GetSearchGender() string {
@@ -503,7 +582,7 @@ type TelegramResponse struct {
 func (x *TelegramResponse) Reset() {
 	*x = TelegramResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[7]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -516,7 +595,7 @@ func (x *TelegramResponse) String() string {
 func (*TelegramResponse) ProtoMessage() {}
 
 func (x *TelegramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[7]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +608,7 @@ func (x *TelegramResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelegramResponse.ProtoReflect.Descriptor instead.
 func (*TelegramResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{7}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TelegramResponse) GetUserId() string {
@@ -598,7 +677,7 @@ type StatusResponse struct {
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[8]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -611,7 +690,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[8]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +703,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{8}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StatusResponse) GetIsBlocked() bool {
@@ -687,7 +766,7 @@ type SettingsResponse struct {
 func (x *SettingsResponse) Reset() {
 	*x = SettingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[9]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -700,7 +779,7 @@ func (x *SettingsResponse) String() string {
 func (*SettingsResponse) ProtoMessage() {}
 
 func (x *SettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[9]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +792,7 @@ func (x *SettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettingsResponse.ProtoReflect.Descriptor instead.
 func (*SettingsResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{9}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SettingsResponse) GetMeasurement() string {
@@ -734,7 +813,7 @@ type BlockResponse struct {
 func (x *BlockResponse) Reset() {
 	*x = BlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[10]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -747,7 +826,7 @@ func (x *BlockResponse) String() string {
 func (*BlockResponse) ProtoMessage() {}
 
 func (x *BlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[10]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +839,7 @@ func (x *BlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
 func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{10}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BlockResponse) GetIsBlocked() bool {
@@ -783,7 +862,7 @@ type LikeResponse struct {
 func (x *LikeResponse) Reset() {
 	*x = LikeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[11]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -796,7 +875,7 @@ func (x *LikeResponse) String() string {
 func (*LikeResponse) ProtoMessage() {}
 
 func (x *LikeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[11]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +888,7 @@ func (x *LikeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeResponse.ProtoReflect.Descriptor instead.
 func (*LikeResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{11}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LikeResponse) GetId() uint64 {
@@ -849,7 +928,7 @@ type LikeEntity struct {
 func (x *LikeEntity) Reset() {
 	*x = LikeEntity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[12]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -862,7 +941,7 @@ func (x *LikeEntity) String() string {
 func (*LikeEntity) ProtoMessage() {}
 
 func (x *LikeEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[12]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +954,7 @@ func (x *LikeEntity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeEntity.ProtoReflect.Descriptor instead.
 func (*LikeEntity) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{12}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LikeEntity) GetId() uint64 {
@@ -957,7 +1036,7 @@ type ProfileAddRequest struct {
 func (x *ProfileAddRequest) Reset() {
 	*x = ProfileAddRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[13]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -970,7 +1049,7 @@ func (x *ProfileAddRequest) String() string {
 func (*ProfileAddRequest) ProtoMessage() {}
 
 func (x *ProfileAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[13]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1062,7 @@ func (x *ProfileAddRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileAddRequest.ProtoReflect.Descriptor instead.
 func (*ProfileAddRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{13}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ProfileAddRequest) GetDisplayName() string {
@@ -1186,7 +1265,7 @@ type ProfileAddResponse struct {
 func (x *ProfileAddResponse) Reset() {
 	*x = ProfileAddResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[14]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1199,7 +1278,7 @@ func (x *ProfileAddResponse) String() string {
 func (*ProfileAddResponse) ProtoMessage() {}
 
 func (x *ProfileAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[14]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,7 +1291,7 @@ func (x *ProfileAddResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileAddResponse.ProtoReflect.Descriptor instead.
 func (*ProfileAddResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{14}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ProfileAddResponse) GetSuccess() bool {
@@ -1259,7 +1338,7 @@ type ProfileUpdateRequest struct {
 func (x *ProfileUpdateRequest) Reset() {
 	*x = ProfileUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[15]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1272,7 +1351,7 @@ func (x *ProfileUpdateRequest) String() string {
 func (*ProfileUpdateRequest) ProtoMessage() {}
 
 func (x *ProfileUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[15]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,7 +1364,7 @@ func (x *ProfileUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileUpdateRequest.ProtoReflect.Descriptor instead.
 func (*ProfileUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{15}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ProfileUpdateRequest) GetDisplayName() string {
@@ -1488,7 +1567,7 @@ type ProfileFreezeRequest struct {
 func (x *ProfileFreezeRequest) Reset() {
 	*x = ProfileFreezeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[16]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1501,7 +1580,7 @@ func (x *ProfileFreezeRequest) String() string {
 func (*ProfileFreezeRequest) ProtoMessage() {}
 
 func (x *ProfileFreezeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[16]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +1593,7 @@ func (x *ProfileFreezeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileFreezeRequest.ProtoReflect.Descriptor instead.
 func (*ProfileFreezeRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{16}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ProfileFreezeRequest) GetTelegramUserId() string {
@@ -1535,7 +1614,7 @@ type ProfileFreezeResponse struct {
 func (x *ProfileFreezeResponse) Reset() {
 	*x = ProfileFreezeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[17]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1548,7 +1627,7 @@ func (x *ProfileFreezeResponse) String() string {
 func (*ProfileFreezeResponse) ProtoMessage() {}
 
 func (x *ProfileFreezeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[17]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,7 +1640,7 @@ func (x *ProfileFreezeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileFreezeResponse.ProtoReflect.Descriptor instead.
 func (*ProfileFreezeResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{17}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ProfileFreezeResponse) GetSuccess() bool {
@@ -1582,7 +1661,7 @@ type ProfileRestoreRequest struct {
 func (x *ProfileRestoreRequest) Reset() {
 	*x = ProfileRestoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[18]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1595,7 +1674,7 @@ func (x *ProfileRestoreRequest) String() string {
 func (*ProfileRestoreRequest) ProtoMessage() {}
 
 func (x *ProfileRestoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[18]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +1687,7 @@ func (x *ProfileRestoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileRestoreRequest.ProtoReflect.Descriptor instead.
 func (*ProfileRestoreRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{18}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ProfileRestoreRequest) GetTelegramUserId() string {
@@ -1629,7 +1708,7 @@ type ProfileRestoreResponse struct {
 func (x *ProfileRestoreResponse) Reset() {
 	*x = ProfileRestoreResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[19]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1642,7 +1721,7 @@ func (x *ProfileRestoreResponse) String() string {
 func (*ProfileRestoreResponse) ProtoMessage() {}
 
 func (x *ProfileRestoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[19]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1655,7 +1734,7 @@ func (x *ProfileRestoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileRestoreResponse.ProtoReflect.Descriptor instead.
 func (*ProfileRestoreResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{19}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ProfileRestoreResponse) GetSuccess() bool {
@@ -1676,7 +1755,7 @@ type ProfileDeleteRequest struct {
 func (x *ProfileDeleteRequest) Reset() {
 	*x = ProfileDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[20]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1689,7 +1768,7 @@ func (x *ProfileDeleteRequest) String() string {
 func (*ProfileDeleteRequest) ProtoMessage() {}
 
 func (x *ProfileDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[20]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1702,7 +1781,7 @@ func (x *ProfileDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ProfileDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{20}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ProfileDeleteRequest) GetTelegramUserId() string {
@@ -1723,7 +1802,7 @@ type ProfileDeleteResponse struct {
 func (x *ProfileDeleteResponse) Reset() {
 	*x = ProfileDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[21]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1736,7 +1815,7 @@ func (x *ProfileDeleteResponse) String() string {
 func (*ProfileDeleteResponse) ProtoMessage() {}
 
 func (x *ProfileDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[21]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1749,7 +1828,7 @@ func (x *ProfileDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileDeleteResponse.ProtoReflect.Descriptor instead.
 func (*ProfileDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{21}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProfileDeleteResponse) GetSuccess() bool {
@@ -1775,7 +1854,7 @@ type ProfileGetRequest struct {
 func (x *ProfileGetRequest) Reset() {
 	*x = ProfileGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[22]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1788,7 +1867,7 @@ func (x *ProfileGetRequest) String() string {
 func (*ProfileGetRequest) ProtoMessage() {}
 
 func (x *ProfileGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[22]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +1880,7 @@ func (x *ProfileGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileGetRequest.ProtoReflect.Descriptor instead.
 func (*ProfileGetRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{22}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProfileGetRequest) GetTelegramUserId() string {
@@ -1866,7 +1945,7 @@ type ProfileResponse struct {
 func (x *ProfileResponse) Reset() {
 	*x = ProfileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[23]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1879,7 +1958,7 @@ func (x *ProfileResponse) String() string {
 func (*ProfileResponse) ProtoMessage() {}
 
 func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[23]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1892,7 +1971,7 @@ func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
 func (*ProfileResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{23}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ProfileResponse) GetTelegramUserId() string {
@@ -1982,7 +2061,7 @@ type ProfileGetDetailRequest struct {
 func (x *ProfileGetDetailRequest) Reset() {
 	*x = ProfileGetDetailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[24]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1995,7 +2074,7 @@ func (x *ProfileGetDetailRequest) String() string {
 func (*ProfileGetDetailRequest) ProtoMessage() {}
 
 func (x *ProfileGetDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[24]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2087,7 @@ func (x *ProfileGetDetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileGetDetailRequest.ProtoReflect.Descriptor instead.
 func (*ProfileGetDetailRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{24}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ProfileGetDetailRequest) GetTelegramUserId() string {
@@ -2082,7 +2161,7 @@ type ProfileDetailResponse struct {
 func (x *ProfileDetailResponse) Reset() {
 	*x = ProfileDetailResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[25]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2095,7 +2174,7 @@ func (x *ProfileDetailResponse) String() string {
 func (*ProfileDetailResponse) ProtoMessage() {}
 
 func (x *ProfileDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[25]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2108,7 +2187,7 @@ func (x *ProfileDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileDetailResponse.ProtoReflect.Descriptor instead.
 func (*ProfileDetailResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{25}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ProfileDetailResponse) GetTelegramUserId() string {
@@ -2206,7 +2285,7 @@ type ProfileGetShortInfoRequest struct {
 func (x *ProfileGetShortInfoRequest) Reset() {
 	*x = ProfileGetShortInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[26]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2219,7 +2298,7 @@ func (x *ProfileGetShortInfoRequest) String() string {
 func (*ProfileGetShortInfoRequest) ProtoMessage() {}
 
 func (x *ProfileGetShortInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[26]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,7 +2311,7 @@ func (x *ProfileGetShortInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileGetShortInfoRequest.ProtoReflect.Descriptor instead.
 func (*ProfileGetShortInfoRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{26}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ProfileGetShortInfoRequest) GetTelegramUserId() string {
@@ -2260,7 +2339,7 @@ type ProfileShortInfoResponse struct {
 func (x *ProfileShortInfoResponse) Reset() {
 	*x = ProfileShortInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[27]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2273,7 +2352,7 @@ func (x *ProfileShortInfoResponse) String() string {
 func (*ProfileShortInfoResponse) ProtoMessage() {}
 
 func (x *ProfileShortInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[27]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,7 +2365,7 @@ func (x *ProfileShortInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileShortInfoResponse.ProtoReflect.Descriptor instead.
 func (*ProfileShortInfoResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{27}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ProfileShortInfoResponse) GetTelegramUserId() string {
@@ -2356,12 +2435,13 @@ type ProfileGetListRequest struct {
 	City           *string  `protobuf:"bytes,4,opt,name=city,proto3,oneof" json:"city,omitempty"`               // название города
 	Latitude       *float64 `protobuf:"fixed64,5,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`     // широта
 	Longitude      *float64 `protobuf:"fixed64,6,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`   // долгота
+	Cursor         string   `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                 // курсор следующей страницы
 }
 
 func (x *ProfileGetListRequest) Reset() {
 	*x = ProfileGetListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[28]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2374,7 +2454,7 @@ func (x *ProfileGetListRequest) String() string {
 func (*ProfileGetListRequest) ProtoMessage() {}
 
 func (x *ProfileGetListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[28]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2387,7 +2467,7 @@ func (x *ProfileGetListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileGetListRequest.ProtoReflect.Descriptor instead.
 func (*ProfileGetListRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{28}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ProfileGetListRequest) GetTelegramUserId() string {
@@ -2432,6 +2512,13 @@ func (x *ProfileGetListRequest) GetLongitude() float64 {
 	return 0
 }
 
+func (x *ProfileGetListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type ProfileListItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2447,7 +2534,7 @@ type ProfileListItemResponse struct {
 func (x *ProfileListItemResponse) Reset() {
 	*x = ProfileListItemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[29]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2460,7 +2547,7 @@ func (x *ProfileListItemResponse) String() string {
 func (*ProfileListItemResponse) ProtoMessage() {}
 
 func (x *ProfileListItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[29]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2473,7 +2560,7 @@ func (x *ProfileListItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileListItemResponse.ProtoReflect.Descriptor instead.
 func (*ProfileListItemResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{29}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ProfileListItemResponse) GetTelegramUserId() string {
@@ -2516,19 +2603,17 @@ type ProfileListResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HasPrevious   bool                       `protobuf:"varint,1,opt,name=hasPrevious,proto3" json:"hasPrevious,omitempty"`     // наличие предыдущих страниц да/нет
-	HasNext       bool                       `protobuf:"varint,2,opt,name=hasNext,proto3" json:"hasNext,omitempty"`             // наличие следующих страниц да/нет
-	Page          uint64                     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                   // номер текущей страницы
-	Size          uint64                     `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                   // количество элементов на странице
-	TotalEntities uint64                     `protobuf:"varint,5,opt,name=totalEntities,proto3" json:"totalEntities,omitempty"` // общее количество элементов
-	TotalPages    uint64                     `protobuf:"varint,6,opt,name=totalPages,proto3" json:"totalPages,omitempty"`       // общее количество страниц
-	Content       []*ProfileListItemResponse `protobuf:"bytes,7,rep,name=content,proto3" json:"content,omitempty"`              // список профилей
+	HasNext            bool                       `protobuf:"varint,2,opt,name=hasNext,proto3" json:"hasNext,omitempty"`                       // наличие следующих страниц да/нет
+	TotalEntities      uint64                     `protobuf:"varint,5,opt,name=totalEntities,proto3" json:"totalEntities,omitempty"`           // общее количество элементов
+	Content            []*ProfileListItemResponse `protobuf:"bytes,7,rep,name=content,proto3" json:"content,omitempty"`                        // список профилей
+	NextCursor         string                     `protobuf:"bytes,8,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`                  // курсор следующей страницы
+	IsTotalApproximate bool                       `protobuf:"varint,9,opt,name=isTotalApproximate,proto3" json:"isTotalApproximate,omitempty"` // общее количество элементов приблизительное да/нет
 }
 
 func (x *ProfileListResponse) Reset() {
 	*x = ProfileListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[30]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2541,7 +2626,7 @@ func (x *ProfileListResponse) String() string {
 func (*ProfileListResponse) ProtoMessage() {}
 
 func (x *ProfileListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[30]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2554,14 +2639,7 @@ func (x *ProfileListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileListResponse.ProtoReflect.Descriptor instead.
 func (*ProfileListResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ProfileListResponse) GetHasPrevious() bool {
-	if x != nil {
-		return x.HasPrevious
-	}
-	return false
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ProfileListResponse) GetHasNext() bool {
@@ -2571,39 +2649,32 @@ func (x *ProfileListResponse) GetHasNext() bool {
 	return false
 }
 
-func (x *ProfileListResponse) GetPage() uint64 {
+func (x *ProfileListResponse) GetTotalEntities() uint64 {
 	if x != nil {
-		return x.Page
+		return x.TotalEntities
 	}
 	return 0
 }
 
-func (x *ProfileListResponse) GetSize() uint64 {
+func (x *ProfileListResponse) GetContent() []*ProfileListItemResponse {
 	if x != nil {
-		return x.Size
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-func (x *ProfileListResponse) GetTotalEntities() uint64 {
+func (x *ProfileListResponse) GetNextCursor() string {
 	if x != nil {
-		return x.TotalEntities
+		return x.NextCursor
 	}
-	return 0
+	return ""
 }
 
-func (x *ProfileListResponse) GetTotalPages() uint64 {
+func (x *ProfileListResponse) GetIsTotalApproximate() bool {
 	if x != nil {
-		return x.TotalPages
+		return x.IsTotalApproximate
 	}
-	return 0
-}
-
-func (x *ProfileListResponse) GetContent() []*ProfileListItemResponse {
-	if x != nil {
-		return x.Content
-	}
-	return nil
+	return false
 }
 
 type CheckProfileExistsRequest struct {
@@ -2617,7 +2688,7 @@ type CheckProfileExistsRequest struct {
 func (x *CheckProfileExistsRequest) Reset() {
 	*x = CheckProfileExistsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[31]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2630,7 +2701,7 @@ func (x *CheckProfileExistsRequest) String() string {
 func (*CheckProfileExistsRequest) ProtoMessage() {}
 
 func (x *CheckProfileExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[31]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2643,7 +2714,7 @@ func (x *CheckProfileExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProfileExistsRequest.ProtoReflect.Descriptor instead.
 func (*CheckProfileExistsRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{31}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CheckProfileExistsRequest) GetTelegramUserId() string {
@@ -2664,7 +2735,7 @@ type CheckProfileExistsResponse struct {
 func (x *CheckProfileExistsResponse) Reset() {
 	*x = CheckProfileExistsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[32]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2677,7 +2748,7 @@ func (x *CheckProfileExistsResponse) String() string {
 func (*CheckProfileExistsResponse) ProtoMessage() {}
 
 func (x *CheckProfileExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[32]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,7 +2761,7 @@ func (x *CheckProfileExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProfileExistsResponse.ProtoReflect.Descriptor instead.
 func (*CheckProfileExistsResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{32}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CheckProfileExistsResponse) GetIsExists() bool {
@@ -2712,7 +2783,7 @@ type GetImageByTelegramUserIdRequest struct {
 func (x *GetImageByTelegramUserIdRequest) Reset() {
 	*x = GetImageByTelegramUserIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[33]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2725,7 +2796,7 @@ func (x *GetImageByTelegramUserIdRequest) String() string {
 func (*GetImageByTelegramUserIdRequest) ProtoMessage() {}
 
 func (x *GetImageByTelegramUserIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[33]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,7 +2809,7 @@ func (x *GetImageByTelegramUserIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImageByTelegramUserIdRequest.ProtoReflect.Descriptor instead.
 func (*GetImageByTelegramUserIdRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{33}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetImageByTelegramUserIdRequest) GetTelegramUserId() string {
@@ -2766,7 +2837,7 @@ type ImageByTelegramUserIdResponse struct {
 func (x *ImageByTelegramUserIdResponse) Reset() {
 	*x = ImageByTelegramUserIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[34]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2779,7 +2850,7 @@ func (x *ImageByTelegramUserIdResponse) String() string {
 func (*ImageByTelegramUserIdResponse) ProtoMessage() {}
 
 func (x *ImageByTelegramUserIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[34]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2792,7 +2863,7 @@ func (x *ImageByTelegramUserIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageByTelegramUserIdResponse.ProtoReflect.Descriptor instead.
 func (*ImageByTelegramUserIdResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{34}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ImageByTelegramUserIdResponse) GetFile() []byte {
@@ -2813,7 +2884,7 @@ type GetImageLastByTelegramUserIdRequest struct {
 func (x *GetImageLastByTelegramUserIdRequest) Reset() {
 	*x = GetImageLastByTelegramUserIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[35]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2826,7 +2897,7 @@ func (x *GetImageLastByTelegramUserIdRequest) String() string {
 func (*GetImageLastByTelegramUserIdRequest) ProtoMessage() {}
 
 func (x *GetImageLastByTelegramUserIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[35]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,7 +2910,7 @@ func (x *GetImageLastByTelegramUserIdRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetImageLastByTelegramUserIdRequest.ProtoReflect.Descriptor instead.
 func (*GetImageLastByTelegramUserIdRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{35}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetImageLastByTelegramUserIdRequest) GetTelegramUserId() string {
@@ -2860,7 +2931,7 @@ type GetImageByIdRequest struct {
 func (x *GetImageByIdRequest) Reset() {
 	*x = GetImageByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[36]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2873,7 +2944,7 @@ func (x *GetImageByIdRequest) String() string {
 func (*GetImageByIdRequest) ProtoMessage() {}
 
 func (x *GetImageByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[36]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2886,7 +2957,7 @@ func (x *GetImageByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImageByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetImageByIdRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{36}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetImageByIdRequest) GetId() uint64 {
@@ -2907,7 +2978,7 @@ type ImageDeleteRequest struct {
 func (x *ImageDeleteRequest) Reset() {
 	*x = ImageDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[37]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2920,7 +2991,7 @@ func (x *ImageDeleteRequest) String() string {
 func (*ImageDeleteRequest) ProtoMessage() {}
 
 func (x *ImageDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[37]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2933,7 +3004,7 @@ func (x *ImageDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ImageDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{37}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ImageDeleteRequest) GetId() uint64 {
@@ -2954,7 +3025,7 @@ type ImageDeleteResponse struct {
 func (x *ImageDeleteResponse) Reset() {
 	*x = ImageDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[38]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2967,7 +3038,7 @@ func (x *ImageDeleteResponse) String() string {
 func (*ImageDeleteResponse) ProtoMessage() {}
 
 func (x *ImageDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[38]
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,7 +3051,7 @@ func (x *ImageDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageDeleteResponse.ProtoReflect.Descriptor instead.
 func (*ImageDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{38}
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ImageDeleteResponse) GetSuccess() bool {
@@ -2990,31 +3061,33 @@ func (x *ImageDeleteResponse) GetSuccess() bool {
 	return false
 }
 
-type FilterGetRequest struct {
+type ImageUploadInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	Filename       string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`             // имя файла
+	Size           int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                    // размер файла в байтах
 }
 
-func (x *FilterGetRequest) Reset() {
-	*x = FilterGetRequest{}
+func (x *ImageUploadInfo) Reset() {
+	*x = ImageUploadInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[39]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterGetRequest) String() string {
+func (x *ImageUploadInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterGetRequest) ProtoMessage() {}
+func (*ImageUploadInfo) ProtoMessage() {}
 
-func (x *FilterGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[39]
+func (x *ImageUploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3025,49 +3098,60 @@ func (x *FilterGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterGetRequest.ProtoReflect.Descriptor instead.
-func (*FilterGetRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ImageUploadInfo.ProtoReflect.Descriptor instead.
+func (*ImageUploadInfo) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *FilterGetRequest) GetTelegramUserId() string {
+func (x *ImageUploadInfo) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-type FilterUpdateRequest struct {
+func (x *ImageUploadInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImageUploadInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ImageUploadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string  `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
-	SearchGender   string  `protobuf:"bytes,2,opt,name=searchGender,proto3" json:"searchGender,omitempty"`     // поиск по половому признаку
-	AgeFrom        uint64  `protobuf:"varint,3,opt,name=ageFrom,proto3" json:"ageFrom,omitempty"`              // возраст от
-	AgeTo          uint64  `protobuf:"varint,4,opt,name=ageTo,proto3" json:"ageTo,omitempty"`                  // возраст до
-	Distance       float64 `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"`           // дистанция
-	IsLiked        bool    `protobuf:"varint,6,opt,name=isLiked,proto3" json:"isLiked,omitempty"`              // показывать пользователей у которых лайк
-	IsOnline       bool    `protobuf:"varint,7,opt,name=isOnline,proto3" json:"isOnline,omitempty"`            // показывать только онлайн пользователей
+	// Types that are assignable to Data:
+	//	*ImageUploadRequest_Info
+	//	*ImageUploadRequest_Chunk
+	Data isImageUploadRequest_Data `protobuf_oneof:"data"`
 }
 
-func (x *FilterUpdateRequest) Reset() {
-	*x = FilterUpdateRequest{}
+func (x *ImageUploadRequest) Reset() {
+	*x = ImageUploadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[40]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterUpdateRequest) String() string {
+func (x *ImageUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterUpdateRequest) ProtoMessage() {}
+func (*ImageUploadRequest) ProtoMessage() {}
 
-func (x *FilterUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[40]
+func (x *ImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3078,85 +3162,73 @@ func (x *FilterUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterUpdateRequest.ProtoReflect.Descriptor instead.
-func (*FilterUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*ImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *FilterUpdateRequest) GetTelegramUserId() string {
-	if x != nil {
-		return x.TelegramUserId
+func (m *ImageUploadRequest) GetData() isImageUploadRequest_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *FilterUpdateRequest) GetSearchGender() string {
-	if x != nil {
-		return x.SearchGender
+func (x *ImageUploadRequest) GetInfo() *ImageUploadInfo {
+	if x, ok := x.GetData().(*ImageUploadRequest_Info); ok {
+		return x.Info
 	}
-	return ""
+	return nil
 }
 
-func (x *FilterUpdateRequest) GetAgeFrom() uint64 {
-	if x != nil {
-		return x.AgeFrom
+func (x *ImageUploadRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*ImageUploadRequest_Chunk); ok {
+		return x.Chunk
 	}
-	return 0
+	return nil
 }
 
-func (x *FilterUpdateRequest) GetAgeTo() uint64 {
-	if x != nil {
-		return x.AgeTo
-	}
-	return 0
+type isImageUploadRequest_Data interface {
+	isImageUploadRequest_Data()
 }
 
-func (x *FilterUpdateRequest) GetDistance() float64 {
-	if x != nil {
-		return x.Distance
-	}
-	return 0
+type ImageUploadRequest_Info struct {
+	Info *ImageUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"` // первое сообщение потока: данные файла
 }
 
-func (x *FilterUpdateRequest) GetIsLiked() bool {
-	if x != nil {
-		return x.IsLiked
-	}
-	return false
+type ImageUploadRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // следующие сообщения: части содержимого файла
 }
 
-func (x *FilterUpdateRequest) GetIsOnline() bool {
-	if x != nil {
-		return x.IsOnline
-	}
-	return false
-}
+func (*ImageUploadRequest_Info) isImageUploadRequest_Data() {}
 
-type TelegramGetRequest struct {
+func (*ImageUploadRequest_Chunk) isImageUploadRequest_Data() {}
+
+type ImageUploadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	Image *ImageResponse `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"` // загруженное изображение
 }
 
-func (x *TelegramGetRequest) Reset() {
-	*x = TelegramGetRequest{}
+func (x *ImageUploadResponse) Reset() {
+	*x = ImageUploadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[41]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TelegramGetRequest) String() string {
+func (x *ImageUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TelegramGetRequest) ProtoMessage() {}
+func (*ImageUploadResponse) ProtoMessage() {}
 
-func (x *TelegramGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[41]
+func (x *ImageUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3167,44 +3239,44 @@ func (x *TelegramGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TelegramGetRequest.ProtoReflect.Descriptor instead.
-func (*TelegramGetRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ImageUploadResponse.ProtoReflect.Descriptor instead.
+func (*ImageUploadResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *TelegramGetRequest) GetTelegramUserId() string {
+func (x *ImageUploadResponse) GetImage() *ImageResponse {
 	if x != nil {
-		return x.TelegramUserId
+		return x.Image
 	}
-	return ""
+	return nil
 }
 
-type BlockAddRequest struct {
+type ImageSetPrimaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId        string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`               // id пользователя в телеграм
-	BlockedTelegramUserId string `protobuf:"bytes,2,opt,name=blockedTelegramUserId,proto3" json:"blockedTelegramUserId,omitempty"` // id того, кого блокируем
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	ImageId        uint64 `protobuf:"varint,2,opt,name=imageId,proto3" json:"imageId,omitempty"`              // id изображения, которое станет главным
 }
 
-func (x *BlockAddRequest) Reset() {
-	*x = BlockAddRequest{}
+func (x *ImageSetPrimaryRequest) Reset() {
+	*x = ImageSetPrimaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[42]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockAddRequest) String() string {
+func (x *ImageSetPrimaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockAddRequest) ProtoMessage() {}
+func (*ImageSetPrimaryRequest) ProtoMessage() {}
 
-func (x *BlockAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[42]
+func (x *ImageSetPrimaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3215,50 +3287,50 @@ func (x *BlockAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockAddRequest.ProtoReflect.Descriptor instead.
-func (*BlockAddRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ImageSetPrimaryRequest.ProtoReflect.Descriptor instead.
+func (*ImageSetPrimaryRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *BlockAddRequest) GetTelegramUserId() string {
+func (x *ImageSetPrimaryRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-func (x *BlockAddRequest) GetBlockedTelegramUserId() string {
+func (x *ImageSetPrimaryRequest) GetImageId() uint64 {
 	if x != nil {
-		return x.BlockedTelegramUserId
+		return x.ImageId
 	}
-	return ""
+	return 0
 }
 
-type BlockAddResponse struct {
+type ImageSetPrimaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно заблокирован да/нет
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // главное изображение изменено да/нет
 }
 
-func (x *BlockAddResponse) Reset() {
-	*x = BlockAddResponse{}
+func (x *ImageSetPrimaryResponse) Reset() {
+	*x = ImageSetPrimaryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[43]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockAddResponse) String() string {
+func (x *ImageSetPrimaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockAddResponse) ProtoMessage() {}
+func (*ImageSetPrimaryResponse) ProtoMessage() {}
 
-func (x *BlockAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[43]
+func (x *ImageSetPrimaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3269,43 +3341,44 @@ func (x *BlockAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockAddResponse.ProtoReflect.Descriptor instead.
-func (*BlockAddResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ImageSetPrimaryResponse.ProtoReflect.Descriptor instead.
+func (*ImageSetPrimaryResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *BlockAddResponse) GetSuccess() bool {
+func (x *ImageSetPrimaryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type GetBlockedListRequest struct {
+type ImageReorderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	TelegramUserId string   `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	ImageIds       []uint64 `protobuf:"varint,2,rep,packed,name=imageIds,proto3" json:"imageIds,omitempty"`     // id всех изображений пользователя в новом порядке, первое становится главным
 }
 
-func (x *GetBlockedListRequest) Reset() {
-	*x = GetBlockedListRequest{}
+func (x *ImageReorderRequest) Reset() {
+	*x = ImageReorderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[44]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockedListRequest) String() string {
+func (x *ImageReorderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockedListRequest) ProtoMessage() {}
+func (*ImageReorderRequest) ProtoMessage() {}
 
-func (x *GetBlockedListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[44]
+func (x *ImageReorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3316,44 +3389,50 @@ func (x *GetBlockedListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockedListRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockedListRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ImageReorderRequest.ProtoReflect.Descriptor instead.
+func (*ImageReorderRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetBlockedListRequest) GetTelegramUserId() string {
+func (x *ImageReorderRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-type BlockedListItemResponse struct {
+func (x *ImageReorderRequest) GetImageIds() []uint64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type ImageReorderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockedTelegramUserId string `protobuf:"bytes,1,opt,name=blockedTelegramUserId,proto3" json:"blockedTelegramUserId,omitempty"` // id пользователя в телеграм
-	Url                   string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                     // url изображения
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // порядок изображений изменен да/нет
 }
 
-func (x *BlockedListItemResponse) Reset() {
-	*x = BlockedListItemResponse{}
+func (x *ImageReorderResponse) Reset() {
+	*x = ImageReorderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[45]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockedListItemResponse) String() string {
+func (x *ImageReorderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockedListItemResponse) ProtoMessage() {}
+func (*ImageReorderResponse) ProtoMessage() {}
 
-func (x *BlockedListItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[45]
+func (x *ImageReorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3364,50 +3443,45 @@ func (x *BlockedListItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockedListItemResponse.ProtoReflect.Descriptor instead.
-func (*BlockedListItemResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *BlockedListItemResponse) GetBlockedTelegramUserId() string {
-	if x != nil {
-		return x.BlockedTelegramUserId
-	}
-	return ""
+// Deprecated: Use ImageReorderResponse.ProtoReflect.Descriptor instead.
+func (*ImageReorderResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *BlockedListItemResponse) GetUrl() string {
+func (x *ImageReorderResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Url
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetBlockedListResponse struct {
+type ImageUpdatePrivacyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content []*BlockedListItemResponse `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"` // список заблокированных пользователей
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	ImageId        uint64 `protobuf:"varint,2,opt,name=imageId,proto3" json:"imageId,omitempty"`              // id изображения
+	IsPrivate      bool   `protobuf:"varint,3,opt,name=isPrivate,proto3" json:"isPrivate,omitempty"`          // изображение видно только пользователям с доступом да/нет
 }
 
-func (x *GetBlockedListResponse) Reset() {
-	*x = GetBlockedListResponse{}
+func (x *ImageUpdatePrivacyRequest) Reset() {
+	*x = ImageUpdatePrivacyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[46]
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockedListResponse) String() string {
+func (x *ImageUpdatePrivacyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockedListResponse) ProtoMessage() {}
+func (*ImageUpdatePrivacyRequest) ProtoMessage() {}
 
-func (x *GetBlockedListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[46]
+func (x *ImageUpdatePrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,83 +3492,42 @@ func (x *GetBlockedListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockedListResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockedListResponse) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ImageUpdatePrivacyRequest.ProtoReflect.Descriptor instead.
+func (*ImageUpdatePrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetBlockedListResponse) GetContent() []*BlockedListItemResponse {
+func (x *ImageUpdatePrivacyRequest) GetTelegramUserId() string {
 	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-type UnblockRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TelegramUserId        string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`               // id пользователя в телеграм
-	BlockedTelegramUserId string `protobuf:"bytes,2,opt,name=blockedTelegramUserId,proto3" json:"blockedTelegramUserId,omitempty"` // id пользователя в телеграм которого следует разблокировать
-}
-
-func (x *UnblockRequest) Reset() {
-	*x = UnblockRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *UnblockRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UnblockRequest) ProtoMessage() {}
-
-func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TelegramUserId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
-func (*UnblockRequest) Descriptor() ([]byte, []int) {
-	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{47}
+	return ""
 }
 
-func (x *UnblockRequest) GetTelegramUserId() string {
+func (x *ImageUpdatePrivacyRequest) GetImageId() uint64 {
 	if x != nil {
-		return x.TelegramUserId
+		return x.ImageId
 	}
-	return ""
+	return 0
 }
 
-func (x *UnblockRequest) GetBlockedTelegramUserId() string {
+func (x *ImageUpdatePrivacyRequest) GetIsPrivate() bool {
 	if x != nil {
-		return x.BlockedTelegramUserId
+		return x.IsPrivate
 	}
-	return ""
+	return false
 }
 
-type UnblockResponse struct {
+type ImageUpdatePrivacyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно разблокирован да/нет
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // приватность изображения изменена да/нет
 }
 
-func (x *UnblockResponse) Reset() {
-	*x = UnblockResponse{}
+func (x *ImageUpdatePrivacyResponse) Reset() {
+	*x = ImageUpdatePrivacyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3502,13 +3535,13 @@ func (x *UnblockResponse) Reset() {
 	}
 }
 
-func (x *UnblockResponse) String() string {
+func (x *ImageUpdatePrivacyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockResponse) ProtoMessage() {}
+func (*ImageUpdatePrivacyResponse) ProtoMessage() {}
 
-func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
+func (x *ImageUpdatePrivacyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3520,30 +3553,29 @@ func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockResponse.ProtoReflect.Descriptor instead.
-func (*UnblockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageUpdatePrivacyResponse.ProtoReflect.Descriptor instead.
+func (*ImageUpdatePrivacyResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UnblockResponse) GetSuccess() bool {
+func (x *ImageUpdatePrivacyResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type LikeAddRequest struct {
+type ImageGrantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId      string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`           // id пользователя в телеграм
-	LikedTelegramUserId string `protobuf:"bytes,2,opt,name=likedTelegramUserId,proto3" json:"likedTelegramUserId,omitempty"` // id пользователя который понравился
-	Locale              string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                           // локаль пользователя
+	TelegramUserId       string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`             // id владельца изображений в телеграм
+	ViewerTelegramUserId string `protobuf:"bytes,2,opt,name=viewerTelegramUserId,proto3" json:"viewerTelegramUserId,omitempty"` // id пользователя, которому открыт доступ к приватным изображениям
 }
 
-func (x *LikeAddRequest) Reset() {
-	*x = LikeAddRequest{}
+func (x *ImageGrantRequest) Reset() {
+	*x = ImageGrantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3551,13 +3583,13 @@ func (x *LikeAddRequest) Reset() {
 	}
 }
 
-func (x *LikeAddRequest) String() string {
+func (x *ImageGrantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeAddRequest) ProtoMessage() {}
+func (*ImageGrantRequest) ProtoMessage() {}
 
-func (x *LikeAddRequest) ProtoReflect() protoreflect.Message {
+func (x *ImageGrantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3569,42 +3601,35 @@ func (x *LikeAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeAddRequest.ProtoReflect.Descriptor instead.
-func (*LikeAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageGrantRequest.ProtoReflect.Descriptor instead.
+func (*ImageGrantRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *LikeAddRequest) GetTelegramUserId() string {
+func (x *ImageGrantRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-func (x *LikeAddRequest) GetLikedTelegramUserId() string {
-	if x != nil {
-		return x.LikedTelegramUserId
-	}
-	return ""
-}
-
-func (x *LikeAddRequest) GetLocale() string {
+func (x *ImageGrantRequest) GetViewerTelegramUserId() string {
 	if x != nil {
-		return x.Locale
+		return x.ViewerTelegramUserId
 	}
 	return ""
 }
 
-type LikeAddResponse struct {
+type ImageGrantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно добавлен да/нет
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // доступ изменен да/нет
 }
 
-func (x *LikeAddResponse) Reset() {
-	*x = LikeAddResponse{}
+func (x *ImageGrantResponse) Reset() {
+	*x = ImageGrantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3612,13 +3637,13 @@ func (x *LikeAddResponse) Reset() {
 	}
 }
 
-func (x *LikeAddResponse) String() string {
+func (x *ImageGrantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeAddResponse) ProtoMessage() {}
+func (*ImageGrantResponse) ProtoMessage() {}
 
-func (x *LikeAddResponse) ProtoReflect() protoreflect.Message {
+func (x *ImageGrantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3630,30 +3655,28 @@ func (x *LikeAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeAddResponse.ProtoReflect.Descriptor instead.
-func (*LikeAddResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageGrantResponse.ProtoReflect.Descriptor instead.
+func (*ImageGrantResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *LikeAddResponse) GetSuccess() bool {
+func (x *ImageGrantResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type LikeUpdateRequest struct {
+type ImageGrantListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                        // id блока
-	TelegramUserId string `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
-	IsLiked        bool   `protobuf:"varint,3,opt,name=isLiked,proto3" json:"isLiked,omitempty"`              // есть лайк да/нет
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id владельца изображений в телеграм
 }
 
-func (x *LikeUpdateRequest) Reset() {
-	*x = LikeUpdateRequest{}
+func (x *ImageGrantListRequest) Reset() {
+	*x = ImageGrantListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3661,13 +3684,13 @@ func (x *LikeUpdateRequest) Reset() {
 	}
 }
 
-func (x *LikeUpdateRequest) String() string {
+func (x *ImageGrantListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeUpdateRequest) ProtoMessage() {}
+func (*ImageGrantListRequest) ProtoMessage() {}
 
-func (x *LikeUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *ImageGrantListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3679,42 +3702,29 @@ func (x *LikeUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeUpdateRequest.ProtoReflect.Descriptor instead.
-func (*LikeUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageGrantListRequest.ProtoReflect.Descriptor instead.
+func (*ImageGrantListRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *LikeUpdateRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *LikeUpdateRequest) GetTelegramUserId() string {
+func (x *ImageGrantListRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-func (x *LikeUpdateRequest) GetIsLiked() bool {
-	if x != nil {
-		return x.IsLiked
-	}
-	return false
-}
-
-type LikeUpdateResponse struct {
+type ImageGrantItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно обновлен да/нет
+	ViewerTelegramUserId string               `protobuf:"bytes,1,opt,name=viewerTelegramUserId,proto3" json:"viewerTelegramUserId,omitempty"` // id пользователя с доступом к приватным изображениям
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                       // время выдачи доступа
 }
 
-func (x *LikeUpdateResponse) Reset() {
-	*x = LikeUpdateResponse{}
+func (x *ImageGrantItem) Reset() {
+	*x = ImageGrantItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3722,13 +3732,13 @@ func (x *LikeUpdateResponse) Reset() {
 	}
 }
 
-func (x *LikeUpdateResponse) String() string {
+func (x *ImageGrantItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeUpdateResponse) ProtoMessage() {}
+func (*ImageGrantItem) ProtoMessage() {}
 
-func (x *LikeUpdateResponse) ProtoReflect() protoreflect.Message {
+func (x *ImageGrantItem) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3740,28 +3750,35 @@ func (x *LikeUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeUpdateResponse.ProtoReflect.Descriptor instead.
-func (*LikeUpdateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageGrantItem.ProtoReflect.Descriptor instead.
+func (*ImageGrantItem) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *LikeUpdateResponse) GetSuccess() bool {
+func (x *ImageGrantItem) GetViewerTelegramUserId() string {
 	if x != nil {
-		return x.Success
+		return x.ViewerTelegramUserId
 	}
-	return false
+	return ""
 }
 
-type LikeGetLastRequest struct {
+func (x *ImageGrantItem) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ImageGrantListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя
+	Content []*ImageGrantItem `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *LikeGetLastRequest) Reset() {
-	*x = LikeGetLastRequest{}
+func (x *ImageGrantListResponse) Reset() {
+	*x = ImageGrantListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3769,13 +3786,13 @@ func (x *LikeGetLastRequest) Reset() {
 	}
 }
 
-func (x *LikeGetLastRequest) String() string {
+func (x *ImageGrantListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeGetLastRequest) ProtoMessage() {}
+func (*ImageGrantListResponse) ProtoMessage() {}
 
-func (x *LikeGetLastRequest) ProtoReflect() protoreflect.Message {
+func (x *ImageGrantListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3787,28 +3804,28 @@ func (x *LikeGetLastRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeGetLastRequest.ProtoReflect.Descriptor instead.
-func (*LikeGetLastRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImageGrantListResponse.ProtoReflect.Descriptor instead.
+func (*ImageGrantListResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *LikeGetLastRequest) GetTelegramUserId() string {
+func (x *ImageGrantListResponse) GetContent() []*ImageGrantItem {
 	if x != nil {
-		return x.TelegramUserId
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-type LikeGetLastResponse struct {
+type FilterGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Like *LikeEntity `protobuf:"bytes,1,opt,name=like,proto3" json:"like,omitempty"` // лайк пользователя
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
 }
 
-func (x *LikeGetLastResponse) Reset() {
-	*x = LikeGetLastResponse{}
+func (x *FilterGetRequest) Reset() {
+	*x = FilterGetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3816,13 +3833,13 @@ func (x *LikeGetLastResponse) Reset() {
 	}
 }
 
-func (x *LikeGetLastResponse) String() string {
+func (x *FilterGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeGetLastResponse) ProtoMessage() {}
+func (*FilterGetRequest) ProtoMessage() {}
 
-func (x *LikeGetLastResponse) ProtoReflect() protoreflect.Message {
+func (x *FilterGetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3834,31 +3851,34 @@ func (x *LikeGetLastResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeGetLastResponse.ProtoReflect.Descriptor instead.
-func (*LikeGetLastResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilterGetRequest.ProtoReflect.Descriptor instead.
+func (*FilterGetRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *LikeGetLastResponse) GetLike() *LikeEntity {
+func (x *FilterGetRequest) GetTelegramUserId() string {
 	if x != nil {
-		return x.Like
+		return x.TelegramUserId
 	}
-	return nil
+	return ""
 }
 
-type ComplaintAddRequest struct {
+type FilterUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId         string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`                 // id пользователя в телеграм
-	CriminalTelegramUserId string `protobuf:"bytes,2,opt,name=criminalTelegramUserId,proto3" json:"criminalTelegramUserId,omitempty"` // id мошенника
-	Type                   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                     // тип жалобы
-	Description            string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                       // описание жалобы
+	TelegramUserId string  `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	SearchGender   string  `protobuf:"bytes,2,opt,name=searchGender,proto3" json:"searchGender,omitempty"`     // поиск по половому признаку
+	AgeFrom        uint64  `protobuf:"varint,3,opt,name=ageFrom,proto3" json:"ageFrom,omitempty"`              // возраст от
+	AgeTo          uint64  `protobuf:"varint,4,opt,name=ageTo,proto3" json:"ageTo,omitempty"`                  // возраст до
+	Distance       float64 `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"`           // дистанция
+	IsLiked        bool    `protobuf:"varint,6,opt,name=isLiked,proto3" json:"isLiked,omitempty"`              // показывать пользователей у которых лайк
+	IsOnline       bool    `protobuf:"varint,7,opt,name=isOnline,proto3" json:"isOnline,omitempty"`            // показывать только онлайн пользователей
 }
 
-func (x *ComplaintAddRequest) Reset() {
-	*x = ComplaintAddRequest{}
+func (x *FilterUpdateRequest) Reset() {
+	*x = FilterUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3866,13 +3886,13 @@ func (x *ComplaintAddRequest) Reset() {
 	}
 }
 
-func (x *ComplaintAddRequest) String() string {
+func (x *FilterUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComplaintAddRequest) ProtoMessage() {}
+func (*FilterUpdateRequest) ProtoMessage() {}
 
-func (x *ComplaintAddRequest) ProtoReflect() protoreflect.Message {
+func (x *FilterUpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3884,49 +3904,70 @@ func (x *ComplaintAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComplaintAddRequest.ProtoReflect.Descriptor instead.
-func (*ComplaintAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FilterUpdateRequest.ProtoReflect.Descriptor instead.
+func (*FilterUpdateRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ComplaintAddRequest) GetTelegramUserId() string {
+func (x *FilterUpdateRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-func (x *ComplaintAddRequest) GetCriminalTelegramUserId() string {
+func (x *FilterUpdateRequest) GetSearchGender() string {
 	if x != nil {
-		return x.CriminalTelegramUserId
+		return x.SearchGender
 	}
 	return ""
 }
 
-func (x *ComplaintAddRequest) GetType() string {
+func (x *FilterUpdateRequest) GetAgeFrom() uint64 {
 	if x != nil {
-		return x.Type
+		return x.AgeFrom
 	}
-	return ""
+	return 0
 }
 
-func (x *ComplaintAddRequest) GetDescription() string {
+func (x *FilterUpdateRequest) GetAgeTo() uint64 {
 	if x != nil {
-		return x.Description
+		return x.AgeTo
 	}
-	return ""
+	return 0
 }
 
-type ComplaintAddResponse struct {
+func (x *FilterUpdateRequest) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *FilterUpdateRequest) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *FilterUpdateRequest) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+type TelegramGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно заблокирован да/нет
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
 }
 
-func (x *ComplaintAddResponse) Reset() {
-	*x = ComplaintAddResponse{}
+func (x *TelegramGetRequest) Reset() {
+	*x = TelegramGetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3934,13 +3975,13 @@ func (x *ComplaintAddResponse) Reset() {
 	}
 }
 
-func (x *ComplaintAddResponse) String() string {
+func (x *TelegramGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComplaintAddResponse) ProtoMessage() {}
+func (*TelegramGetRequest) ProtoMessage() {}
 
-func (x *ComplaintAddResponse) ProtoReflect() protoreflect.Message {
+func (x *TelegramGetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3952,28 +3993,29 @@ func (x *ComplaintAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComplaintAddResponse.ProtoReflect.Descriptor instead.
-func (*ComplaintAddResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TelegramGetRequest.ProtoReflect.Descriptor instead.
+func (*TelegramGetRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ComplaintAddResponse) GetSuccess() bool {
+func (x *TelegramGetRequest) GetTelegramUserId() string {
 	if x != nil {
-		return x.Success
+		return x.TelegramUserId
 	}
-	return false
+	return ""
 }
 
-type GetStatusByTelegramUserIdRequest struct {
+type BlockAddRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	TelegramUserId        string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`               // id пользователя в телеграм
+	BlockedTelegramUserId string `protobuf:"bytes,2,opt,name=blockedTelegramUserId,proto3" json:"blockedTelegramUserId,omitempty"` // id того, кого блокируем
 }
 
-func (x *GetStatusByTelegramUserIdRequest) Reset() {
-	*x = GetStatusByTelegramUserIdRequest{}
+func (x *BlockAddRequest) Reset() {
+	*x = BlockAddRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3981,13 +4023,13 @@ func (x *GetStatusByTelegramUserIdRequest) Reset() {
 	}
 }
 
-func (x *GetStatusByTelegramUserIdRequest) String() string {
+func (x *BlockAddRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusByTelegramUserIdRequest) ProtoMessage() {}
+func (*BlockAddRequest) ProtoMessage() {}
 
-func (x *GetStatusByTelegramUserIdRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockAddRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3999,31 +4041,35 @@ func (x *GetStatusByTelegramUserIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusByTelegramUserIdRequest.ProtoReflect.Descriptor instead.
-func (*GetStatusByTelegramUserIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockAddRequest.ProtoReflect.Descriptor instead.
+func (*BlockAddRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetStatusByTelegramUserIdRequest) GetTelegramUserId() string {
+func (x *BlockAddRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-type PaymentAddRequest struct {
+func (x *BlockAddRequest) GetBlockedTelegramUserId() string {
+	if x != nil {
+		return x.BlockedTelegramUserId
+	}
+	return ""
+}
+
+type BlockAddResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
-	Price          string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`                   // стоимость платежа
-	Currency       string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`             // валюта
-	Tariff         string `protobuf:"bytes,4,opt,name=tariff,proto3" json:"tariff,omitempty"`                 // тариф
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно заблокирован да/нет
 }
 
-func (x *PaymentAddRequest) Reset() {
-	*x = PaymentAddRequest{}
+func (x *BlockAddResponse) Reset() {
+	*x = BlockAddResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4031,13 +4077,13 @@ func (x *PaymentAddRequest) Reset() {
 	}
 }
 
-func (x *PaymentAddRequest) String() string {
+func (x *BlockAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentAddRequest) ProtoMessage() {}
+func (*BlockAddResponse) ProtoMessage() {}
 
-func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockAddResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4049,49 +4095,28 @@ func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
-func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockAddResponse.ProtoReflect.Descriptor instead.
+func (*BlockAddResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *PaymentAddRequest) GetTelegramUserId() string {
-	if x != nil {
-		return x.TelegramUserId
-	}
-	return ""
-}
-
-func (x *PaymentAddRequest) GetPrice() string {
-	if x != nil {
-		return x.Price
-	}
-	return ""
-}
-
-func (x *PaymentAddRequest) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
-}
-
-func (x *PaymentAddRequest) GetTariff() string {
+func (x *BlockAddResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Tariff
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type PaymentAddResponse struct {
+type GetBlockedListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно оплачено да/нет
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
 }
 
-func (x *PaymentAddResponse) Reset() {
-	*x = PaymentAddResponse{}
+func (x *GetBlockedListRequest) Reset() {
+	*x = GetBlockedListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4099,13 +4124,13 @@ func (x *PaymentAddResponse) Reset() {
 	}
 }
 
-func (x *PaymentAddResponse) String() string {
+func (x *GetBlockedListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentAddResponse) ProtoMessage() {}
+func (*GetBlockedListRequest) ProtoMessage() {}
 
-func (x *PaymentAddResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockedListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4117,28 +4142,29 @@ func (x *PaymentAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentAddResponse.ProtoReflect.Descriptor instead.
-func (*PaymentAddResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockedListRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockedListRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PaymentAddResponse) GetSuccess() bool {
+func (x *GetBlockedListRequest) GetTelegramUserId() string {
 	if x != nil {
-		return x.Success
+		return x.TelegramUserId
 	}
-	return false
+	return ""
 }
 
-type CheckPremiumRequest struct {
+type BlockedListItemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	BlockedTelegramUserId string `protobuf:"bytes,1,opt,name=blockedTelegramUserId,proto3" json:"blockedTelegramUserId,omitempty"` // id пользователя в телеграм
+	Url                   string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                     // url изображения
 }
 
-func (x *CheckPremiumRequest) Reset() {
-	*x = CheckPremiumRequest{}
+func (x *BlockedListItemResponse) Reset() {
+	*x = BlockedListItemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4146,13 +4172,13 @@ func (x *CheckPremiumRequest) Reset() {
 	}
 }
 
-func (x *CheckPremiumRequest) String() string {
+func (x *BlockedListItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPremiumRequest) ProtoMessage() {}
+func (*BlockedListItemResponse) ProtoMessage() {}
 
-func (x *CheckPremiumRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockedListItemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4164,29 +4190,35 @@ func (x *CheckPremiumRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPremiumRequest.ProtoReflect.Descriptor instead.
-func (*CheckPremiumRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockedListItemResponse.ProtoReflect.Descriptor instead.
+func (*BlockedListItemResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *CheckPremiumRequest) GetTelegramUserId() string {
+func (x *BlockedListItemResponse) GetBlockedTelegramUserId() string {
 	if x != nil {
-		return x.TelegramUserId
+		return x.BlockedTelegramUserId
 	}
 	return ""
 }
 
-type CheckPremiumResponse struct {
+func (x *BlockedListItemResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetBlockedListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsPremium      bool                 `protobuf:"varint,1,opt,name=isPremium,proto3" json:"isPremium,omitempty"`          // активирован премиум аккаунт да/нет
-	AvailableUntil *timestamp.Timestamp `protobuf:"bytes,2,opt,name=availableUntil,proto3" json:"availableUntil,omitempty"` // премиум действует до
+	Content []*BlockedListItemResponse `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"` // список заблокированных пользователей
 }
 
-func (x *CheckPremiumResponse) Reset() {
-	*x = CheckPremiumResponse{}
+func (x *GetBlockedListResponse) Reset() {
+	*x = GetBlockedListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4194,13 +4226,13 @@ func (x *CheckPremiumResponse) Reset() {
 	}
 }
 
-func (x *CheckPremiumResponse) String() string {
+func (x *GetBlockedListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPremiumResponse) ProtoMessage() {}
+func (*GetBlockedListResponse) ProtoMessage() {}
 
-func (x *CheckPremiumResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockedListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4212,40 +4244,29 @@ func (x *CheckPremiumResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPremiumResponse.ProtoReflect.Descriptor instead.
-func (*CheckPremiumResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockedListResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockedListResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CheckPremiumResponse) GetIsPremium() bool {
-	if x != nil {
-		return x.IsPremium
-	}
-	return false
-}
-
-func (x *CheckPremiumResponse) GetAvailableUntil() *timestamp.Timestamp {
+func (x *GetBlockedListResponse) GetContent() []*BlockedListItemResponse {
 	if x != nil {
-		return x.AvailableUntil
+		return x.Content
 	}
 	return nil
 }
 
-type NavigatorUpdateRequest struct {
+type UnblockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string  `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
-	CountryCode    *string `protobuf:"bytes,2,opt,name=countryCode,proto3,oneof" json:"countryCode,omitempty"` // код страны
-	CountryName    *string `protobuf:"bytes,3,opt,name=countryName,proto3,oneof" json:"countryName,omitempty"` // название страны
-	City           *string `protobuf:"bytes,4,opt,name=city,proto3,oneof" json:"city,omitempty"`               // название города
-	Latitude       float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`           // широта
-	Longitude      float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`         // долгота
+	TelegramUserId        string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`               // id пользователя в телеграм
+	BlockedTelegramUserId string `protobuf:"bytes,2,opt,name=blockedTelegramUserId,proto3" json:"blockedTelegramUserId,omitempty"` // id пользователя в телеграм которого следует разблокировать
 }
 
-func (x *NavigatorUpdateRequest) Reset() {
-	*x = NavigatorUpdateRequest{}
+func (x *UnblockRequest) Reset() {
+	*x = UnblockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4253,13 +4274,13 @@ func (x *NavigatorUpdateRequest) Reset() {
 	}
 }
 
-func (x *NavigatorUpdateRequest) String() string {
+func (x *UnblockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NavigatorUpdateRequest) ProtoMessage() {}
+func (*UnblockRequest) ProtoMessage() {}
 
-func (x *NavigatorUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4271,63 +4292,35 @@ func (x *NavigatorUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NavigatorUpdateRequest.ProtoReflect.Descriptor instead.
-func (*NavigatorUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *NavigatorUpdateRequest) GetTelegramUserId() string {
+func (x *UnblockRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-func (x *NavigatorUpdateRequest) GetCountryCode() string {
-	if x != nil && x.CountryCode != nil {
-		return *x.CountryCode
-	}
-	return ""
-}
-
-func (x *NavigatorUpdateRequest) GetCountryName() string {
-	if x != nil && x.CountryName != nil {
-		return *x.CountryName
-	}
-	return ""
-}
-
-func (x *NavigatorUpdateRequest) GetCity() string {
-	if x != nil && x.City != nil {
-		return *x.City
-	}
-	return ""
-}
-
-func (x *NavigatorUpdateRequest) GetLatitude() float64 {
-	if x != nil {
-		return x.Latitude
-	}
-	return 0
-}
-
-func (x *NavigatorUpdateRequest) GetLongitude() float64 {
+func (x *UnblockRequest) GetBlockedTelegramUserId() string {
 	if x != nil {
-		return x.Longitude
+		return x.BlockedTelegramUserId
 	}
-	return 0
+	return ""
 }
 
-type NavigatorUpdateResponse struct {
+type UnblockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно обновлено да/нет
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно разблокирован да/нет
 }
 
-func (x *NavigatorUpdateResponse) Reset() {
-	*x = NavigatorUpdateResponse{}
+func (x *UnblockResponse) Reset() {
+	*x = UnblockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4335,13 +4328,13 @@ func (x *NavigatorUpdateResponse) Reset() {
 	}
 }
 
-func (x *NavigatorUpdateResponse) String() string {
+func (x *UnblockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NavigatorUpdateResponse) ProtoMessage() {}
+func (*UnblockResponse) ProtoMessage() {}
 
-func (x *NavigatorUpdateResponse) ProtoReflect() protoreflect.Message {
+func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4353,30 +4346,30 @@ func (x *NavigatorUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NavigatorUpdateResponse.ProtoReflect.Descriptor instead.
-func (*NavigatorUpdateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnblockResponse.ProtoReflect.Descriptor instead.
+func (*UnblockResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *NavigatorUpdateResponse) GetSuccess() bool {
+func (x *UnblockResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type UpdateSettingsRequest struct {
+type LikeAddRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
-	IsHiddenAge    bool   `protobuf:"varint,2,opt,name=isHiddenAge,proto3" json:"isHiddenAge,omitempty"`      // показывать возраст до текущего пользователя да/нет
-	Measurement    string `protobuf:"bytes,3,opt,name=measurement,proto3" json:"measurement,omitempty"`       // единицы измерения метрическая/американская
+	TelegramUserId      string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`           // id пользователя в телеграм
+	LikedTelegramUserId string `protobuf:"bytes,2,opt,name=likedTelegramUserId,proto3" json:"likedTelegramUserId,omitempty"` // id пользователя который понравился
+	Locale              string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                           // локаль пользователя
 }
 
-func (x *UpdateSettingsRequest) Reset() {
-	*x = UpdateSettingsRequest{}
+func (x *LikeAddRequest) Reset() {
+	*x = LikeAddRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4384,13 +4377,13 @@ func (x *UpdateSettingsRequest) Reset() {
 	}
 }
 
-func (x *UpdateSettingsRequest) String() string {
+func (x *LikeAddRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsRequest) ProtoMessage() {}
+func (*LikeAddRequest) ProtoMessage() {}
 
-func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *LikeAddRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4402,42 +4395,43 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LikeAddRequest.ProtoReflect.Descriptor instead.
+func (*LikeAddRequest) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *UpdateSettingsRequest) GetTelegramUserId() string {
+func (x *LikeAddRequest) GetTelegramUserId() string {
 	if x != nil {
 		return x.TelegramUserId
 	}
 	return ""
 }
 
-func (x *UpdateSettingsRequest) GetIsHiddenAge() bool {
+func (x *LikeAddRequest) GetLikedTelegramUserId() string {
 	if x != nil {
-		return x.IsHiddenAge
+		return x.LikedTelegramUserId
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateSettingsRequest) GetMeasurement() string {
+func (x *LikeAddRequest) GetLocale() string {
 	if x != nil {
-		return x.Measurement
+		return x.Locale
 	}
 	return ""
 }
 
-type UpdateSettingsResponse struct {
+type LikeAddResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно обновлено да/нет
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно добавлен да/нет
+	IsMatch bool `protobuf:"varint,2,opt,name=isMatch,proto3" json:"isMatch,omitempty"` // образовалась взаимная симпатия да/нет
 }
 
-func (x *UpdateSettingsResponse) Reset() {
-	*x = UpdateSettingsResponse{}
+func (x *LikeAddResponse) Reset() {
+	*x = LikeAddResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4445,13 +4439,13 @@ func (x *UpdateSettingsResponse) Reset() {
 	}
 }
 
-func (x *UpdateSettingsResponse) String() string {
+func (x *LikeAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettingsResponse) ProtoMessage() {}
+func (*LikeAddResponse) ProtoMessage() {}
 
-func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+func (x *LikeAddResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4463,993 +4457,5673 @@ func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LikeAddResponse.ProtoReflect.Descriptor instead.
+func (*LikeAddResponse) Descriptor() ([]byte, []int) {
 	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UpdateSettingsResponse) GetSuccess() bool {
+func (x *LikeAddResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-var File_contracts_proto_profiles_profile_proto protoreflect.FileDescriptor
-
-var file_contracts_proto_profiles_profile_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6f, 0x0a,
-	0x13, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x6d,
-	0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x41, 0x0a,
-	0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x22, 0x40, 0x0a, 0x11, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f,
-	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x48, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
-	0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
-	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73,
-	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a,
-	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49,
-	0x64, 0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x69, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x2a,
-	0x0a, 0x10, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64,
-	0x65, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73,
-	0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x22, 0x34, 0x0a, 0x10,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x22, 0x72, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13,
-	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6b, 0x65, 0x64,
-	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf4, 0x07, 0x0a,
-	0x11, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x22,
-	0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x72,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50,
-	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d,
-	0x12, 0x28, 0x0a, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01,
-	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c,
-	0x69, 0x6b, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69,
-	0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x19, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0xf3, 0x07, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
-	0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x38, 0x0a, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c,
-	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x48,
-	0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x15,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x32, 0x0a,
-	0x16, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x3e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x31, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
-	0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c,
-	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f,
-	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
-	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x22, 0xaf, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x6e,
-	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x76,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65,
-	0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88,
-	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xa0, 0x04, 0x0a, 0x15, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x61,
-	0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
-	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x6e, 0x61,
-	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x06,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x44, 0x0a,
-	0x1a, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0xd6, 0x02, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53,
-	0x68, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a,
-	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a,
-	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x12, 0x42, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74,
-	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55,
-	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xae, 0x02, 0x0a,
-	0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
-	0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
-	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69,
-	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
-	0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
-	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69,
-	0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xd7, 0x01,
-	0x0a, 0x17, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x3a,
-	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
-	0x6c, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1a, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x1d,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c,
-	0x65, 0x22, 0x4d, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x73,
-	0x74, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a,
-	0x13, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3a,
-	0x0a, 0x10, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x13, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73,
-	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c,
-	0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
-	0x22, 0x3c, 0x0a, 0x12, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6f,
-	0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x2c, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x61,
-	0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x22, 0x55, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x55, 0x6e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x64,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x30, 0x0a, 0x13, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c,
-	0x69, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x2b, 0x0a, 0x0f, 0x4c, 0x69,
-	0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x2e,
-	0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3c,
-	0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x13,
-	0x4c, 0x69, 0x6b, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0xab, 0x01,
-	0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a,
-	0x16, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63,
-	0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4a, 0x0a,
-	0x20, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66,
-	0x66, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x3d, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x78, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72,
-	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50,
-	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x42, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x8a, 0x02, 0x0a, 0x16, 0x4e,
-	0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
-	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63,
-	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74,
-	0x79, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07,
-	0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x17, 0x4e, 0x61, 0x76, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x83, 0x01, 0x0a,
-	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
-	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x32, 0xb0, 0x12, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x46, 0x72, 0x65, 0x65, 0x7a,
-	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x72,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68,
-	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79,
-	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61,
-	0x73, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x55,
-	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x6e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
-	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4c,
-	0x69, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c,
-	0x69, 0x6b, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b,
-	0x65, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74,
-	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x54, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6f, 0x72,
-	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a,
-	0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72,
-	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x45, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79, 0x42,
-	0x75, 0x64, 0x61, 0x65, 0x76, 0x2f, 0x74, 0x67, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2d, 0x67,
-	0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *LikeAddResponse) GetIsMatch() bool {
+	if x != nil {
+		return x.IsMatch
+	}
+	return false
 }
 
-var (
-	file_contracts_proto_profiles_profile_proto_rawDescOnce sync.Once
-	file_contracts_proto_profiles_profile_proto_rawDescData = file_contracts_proto_profiles_profile_proto_rawDesc
-)
+type LikeUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_contracts_proto_profiles_profile_proto_rawDescGZIP() []byte {
-	file_contracts_proto_profiles_profile_proto_rawDescOnce.Do(func() {
-		file_contracts_proto_profiles_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_contracts_proto_profiles_profile_proto_rawDescData)
-	})
-	return file_contracts_proto_profiles_profile_proto_rawDescData
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                        // id блока
+	TelegramUserId string `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	IsLiked        bool   `protobuf:"varint,3,opt,name=isLiked,proto3" json:"isLiked,omitempty"`              // есть лайк да/нет
 }
 
-var file_contracts_proto_profiles_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
-var file_contracts_proto_profiles_profile_proto_goTypes = []interface{}{
-	(*FileMetadata)(nil),                        // 0: protobuf.FileMetadata
-	(*ImageStatusResponse)(nil),                 // 1: protobuf.ImageStatusResponse
-	(*ImageResponse)(nil),                       // 2: protobuf.ImageResponse
-	(*Point)(nil),                               // 3: protobuf.Point
-	(*NavigatorResponse)(nil),                   // 4: protobuf.NavigatorResponse
-	(*NavigatorDetailResponse)(nil),             // 5: protobuf.NavigatorDetailResponse
-	(*FilterResponse)(nil),                      // 6: protobuf.FilterResponse
-	(*TelegramResponse)(nil),                    // 7: protobuf.TelegramResponse
-	(*StatusResponse)(nil),                      // 8: protobuf.StatusResponse
-	(*SettingsResponse)(nil),                    // 9: protobuf.SettingsResponse
-	(*BlockResponse)(nil),                       // 10: protobuf.BlockResponse
-	(*LikeResponse)(nil),                        // 11: protobuf.LikeResponse
-	(*LikeEntity)(nil),                          // 12: protobuf.LikeEntity
-	(*ProfileAddRequest)(nil),                   // 13: protobuf.ProfileAddRequest
-	(*ProfileAddResponse)(nil),                  // 14: protobuf.ProfileAddResponse
-	(*ProfileUpdateRequest)(nil),                // 15: protobuf.ProfileUpdateRequest
-	(*ProfileFreezeRequest)(nil),                // 16: protobuf.ProfileFreezeRequest
-	(*ProfileFreezeResponse)(nil),               // 17: protobuf.ProfileFreezeResponse
-	(*ProfileRestoreRequest)(nil),               // 18: protobuf.ProfileRestoreRequest
-	(*ProfileRestoreResponse)(nil),              // 19: protobuf.ProfileRestoreResponse
-	(*ProfileDeleteRequest)(nil),                // 20: protobuf.ProfileDeleteRequest
-	(*ProfileDeleteResponse)(nil),               // 21: protobuf.ProfileDeleteResponse
-	(*ProfileGetRequest)(nil),                   // 22: protobuf.ProfileGetRequest
-	(*ProfileResponse)(nil),                     // 23: protobuf.ProfileResponse
-	(*ProfileGetDetailRequest)(nil),             // 24: protobuf.ProfileGetDetailRequest
-	(*ProfileDetailResponse)(nil),               // 25: protobuf.ProfileDetailResponse
-	(*ProfileGetShortInfoRequest)(nil),          // 26: protobuf.ProfileGetShortInfoRequest
-	(*ProfileShortInfoResponse)(nil),            // 27: protobuf.ProfileShortInfoResponse
-	(*ProfileGetListRequest)(nil),               // 28: protobuf.ProfileGetListRequest
-	(*ProfileListItemResponse)(nil),             // 29: protobuf.ProfileListItemResponse
-	(*ProfileListResponse)(nil),                 // 30: protobuf.ProfileListResponse
-	(*CheckProfileExistsRequest)(nil),           // 31: protobuf.CheckProfileExistsRequest
-	(*CheckProfileExistsResponse)(nil),          // 32: protobuf.CheckProfileExistsResponse
-	(*GetImageByTelegramUserIdRequest)(nil),     // 33: protobuf.GetImageByTelegramUserIdRequest
-	(*ImageByTelegramUserIdResponse)(nil),       // 34: protobuf.ImageByTelegramUserIdResponse
-	(*GetImageLastByTelegramUserIdRequest)(nil), // 35: protobuf.GetImageLastByTelegramUserIdRequest
-	(*GetImageByIdRequest)(nil),                 // 36: protobuf.GetImageByIdRequest
-	(*ImageDeleteRequest)(nil),                  // 37: protobuf.ImageDeleteRequest
-	(*ImageDeleteResponse)(nil),                 // 38: protobuf.ImageDeleteResponse
-	(*FilterGetRequest)(nil),                    // 39: protobuf.FilterGetRequest
-	(*FilterUpdateRequest)(nil),                 // 40: protobuf.FilterUpdateRequest
-	(*TelegramGetRequest)(nil),                  // 41: protobuf.TelegramGetRequest
-	(*BlockAddRequest)(nil),                     // 42: protobuf.BlockAddRequest
-	(*BlockAddResponse)(nil),                    // 43: protobuf.BlockAddResponse
-	(*GetBlockedListRequest)(nil),               // 44: protobuf.GetBlockedListRequest
-	(*BlockedListItemResponse)(nil),             // 45: protobuf.BlockedListItemResponse
-	(*GetBlockedListResponse)(nil),              // 46: protobuf.GetBlockedListResponse
-	(*UnblockRequest)(nil),                      // 47: protobuf.UnblockRequest
-	(*UnblockResponse)(nil),                     // 48: protobuf.UnblockResponse
-	(*LikeAddRequest)(nil),                      // 49: protobuf.LikeAddRequest
-	(*LikeAddResponse)(nil),                     // 50: protobuf.LikeAddResponse
-	(*LikeUpdateRequest)(nil),                   // 51: protobuf.LikeUpdateRequest
-	(*LikeUpdateResponse)(nil),                  // 52: protobuf.LikeUpdateResponse
-	(*LikeGetLastRequest)(nil),                  // 53: protobuf.LikeGetLastRequest
-	(*LikeGetLastResponse)(nil),                 // 54: protobuf.LikeGetLastResponse
-	(*ComplaintAddRequest)(nil),                 // 55: protobuf.ComplaintAddRequest
-	(*ComplaintAddResponse)(nil),                // 56: protobuf.ComplaintAddResponse
-	(*GetStatusByTelegramUserIdRequest)(nil),    // 57: protobuf.GetStatusByTelegramUserIdRequest
-	(*PaymentAddRequest)(nil),                   // 58: protobuf.PaymentAddRequest
-	(*PaymentAddResponse)(nil),                  // 59: protobuf.PaymentAddResponse
-	(*CheckPremiumRequest)(nil),                 // 60: protobuf.CheckPremiumRequest
-	(*CheckPremiumResponse)(nil),                // 61: protobuf.CheckPremiumResponse
-	(*NavigatorUpdateRequest)(nil),              // 62: protobuf.NavigatorUpdateRequest
-	(*NavigatorUpdateResponse)(nil),             // 63: protobuf.NavigatorUpdateResponse
-	(*UpdateSettingsRequest)(nil),               // 64: protobuf.UpdateSettingsRequest
-	(*UpdateSettingsResponse)(nil),              // 65: protobuf.UpdateSettingsResponse
-	(*timestamp.Timestamp)(nil),                 // 66: google.protobuf.Timestamp
+func (x *LikeUpdateRequest) Reset() {
+	*x = LikeUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_contracts_proto_profiles_profile_proto_depIdxs = []int32{
-	3,  // 0: protobuf.NavigatorResponse.location:type_name -> protobuf.Point
-	66, // 1: protobuf.LikeResponse.updatedAt:type_name -> google.protobuf.Timestamp
-	66, // 2: protobuf.LikeEntity.createdAt:type_name -> google.protobuf.Timestamp
-	66, // 3: protobuf.LikeEntity.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 4: protobuf.ProfileAddRequest.files:type_name -> protobuf.FileMetadata
-	0,  // 5: protobuf.ProfileUpdateRequest.files:type_name -> protobuf.FileMetadata
-	4,  // 6: protobuf.ProfileResponse.navigator:type_name -> protobuf.NavigatorResponse
-	6,  // 7: protobuf.ProfileResponse.filter:type_name -> protobuf.FilterResponse
-	8,  // 8: protobuf.ProfileResponse.status:type_name -> protobuf.StatusResponse
-	9,  // 9: protobuf.ProfileResponse.settings:type_name -> protobuf.SettingsResponse
-	2,  // 10: protobuf.ProfileResponse.images:type_name -> protobuf.ImageResponse
-	66, // 11: protobuf.ProfileDetailResponse.lastOnline:type_name -> google.protobuf.Timestamp
-	5,  // 12: protobuf.ProfileDetailResponse.navigator:type_name -> protobuf.NavigatorDetailResponse
-	8,  // 13: protobuf.ProfileDetailResponse.status:type_name -> protobuf.StatusResponse
-	9,  // 14: protobuf.ProfileDetailResponse.settings:type_name -> protobuf.SettingsResponse
-	10, // 15: protobuf.ProfileDetailResponse.block:type_name -> protobuf.BlockResponse
-	11, // 16: protobuf.ProfileDetailResponse.like:type_name -> protobuf.LikeResponse
-	2,  // 17: protobuf.ProfileDetailResponse.images:type_name -> protobuf.ImageResponse
-	66, // 18: protobuf.ProfileShortInfoResponse.availableUntil:type_name -> google.protobuf.Timestamp
-	6,  // 19: protobuf.ProfileShortInfoResponse.filter:type_name -> protobuf.FilterResponse
-	66, // 20: protobuf.ProfileListItemResponse.lastOnline:type_name -> google.protobuf.Timestamp
-	29, // 21: protobuf.ProfileListResponse.content:type_name -> protobuf.ProfileListItemResponse
-	45, // 22: protobuf.GetBlockedListResponse.content:type_name -> protobuf.BlockedListItemResponse
-	12, // 23: protobuf.LikeGetLastResponse.like:type_name -> protobuf.LikeEntity
-	66, // 24: protobuf.CheckPremiumResponse.availableUntil:type_name -> google.protobuf.Timestamp
-	13, // 25: protobuf.Profile.AddProfile:input_type -> protobuf.ProfileAddRequest
-	15, // 26: protobuf.Profile.UpdateProfile:input_type -> protobuf.ProfileUpdateRequest
-	16, // 27: protobuf.Profile.FreezeProfile:input_type -> protobuf.ProfileFreezeRequest
-	18, // 28: protobuf.Profile.RestoreProfile:input_type -> protobuf.ProfileRestoreRequest
-	20, // 29: protobuf.Profile.DeleteProfile:input_type -> protobuf.ProfileDeleteRequest
-	22, // 30: protobuf.Profile.GetProfile:input_type -> protobuf.ProfileGetRequest
-	24, // 31: protobuf.Profile.GetProfileDetail:input_type -> protobuf.ProfileGetDetailRequest
-	26, // 32: protobuf.Profile.GetProfileShortInfo:input_type -> protobuf.ProfileGetShortInfoRequest
-	28, // 33: protobuf.Profile.GetProfileList:input_type -> protobuf.ProfileGetListRequest
-	31, // 34: protobuf.Profile.CheckProfileExists:input_type -> protobuf.CheckProfileExistsRequest
-	33, // 35: protobuf.Profile.GetImageByTelegramUserId:input_type -> protobuf.GetImageByTelegramUserIdRequest
-	35, // 36: protobuf.Profile.GetImageLastByTelegramUserId:input_type -> protobuf.GetImageLastByTelegramUserIdRequest
-	36, // 37: protobuf.Profile.GetImageById:input_type -> protobuf.GetImageByIdRequest
-	37, // 38: protobuf.Profile.DeleteImage:input_type -> protobuf.ImageDeleteRequest
-	39, // 39: protobuf.Profile.GetFilter:input_type -> protobuf.FilterGetRequest
-	40, // 40: protobuf.Profile.UpdateFilter:input_type -> protobuf.FilterUpdateRequest
-	41, // 41: protobuf.Profile.GetTelegram:input_type -> protobuf.TelegramGetRequest
-	42, // 42: protobuf.Profile.AddBlock:input_type -> protobuf.BlockAddRequest
-	44, // 43: protobuf.Profile.GetBlockedList:input_type -> protobuf.GetBlockedListRequest
-	47, // 44: protobuf.Profile.Unblock:input_type -> protobuf.UnblockRequest
-	49, // 45: protobuf.Profile.AddLike:input_type -> protobuf.LikeAddRequest
-	51, // 46: protobuf.Profile.UpdateLike:input_type -> protobuf.LikeUpdateRequest
-	53, // 47: protobuf.Profile.GetLastLike:input_type -> protobuf.LikeGetLastRequest
-	55, // 48: protobuf.Profile.AddComplaint:input_type -> protobuf.ComplaintAddRequest
-	57, // 49: protobuf.Profile.GetStatusByTelegramUserId:input_type -> protobuf.GetStatusByTelegramUserIdRequest
-	62, // 50: protobuf.Profile.UpdateCoordinates:input_type -> protobuf.NavigatorUpdateRequest
-	58, // 51: protobuf.Profile.AddPayment:input_type -> protobuf.PaymentAddRequest
-	60, // 52: protobuf.Profile.CheckPremium:input_type -> protobuf.CheckPremiumRequest
-	64, // 53: protobuf.Profile.UpdateSettings:input_type -> protobuf.UpdateSettingsRequest
-	14, // 54: protobuf.Profile.AddProfile:output_type -> protobuf.ProfileAddResponse
-	23, // 55: protobuf.Profile.UpdateProfile:output_type -> protobuf.ProfileResponse
-	17, // 56: protobuf.Profile.FreezeProfile:output_type -> protobuf.ProfileFreezeResponse
-	19, // 57: protobuf.Profile.RestoreProfile:output_type -> protobuf.ProfileRestoreResponse
-	21, // 58: protobuf.Profile.DeleteProfile:output_type -> protobuf.ProfileDeleteResponse
-	23, // 59: protobuf.Profile.GetProfile:output_type -> protobuf.ProfileResponse
-	25, // 60: protobuf.Profile.GetProfileDetail:output_type -> protobuf.ProfileDetailResponse
-	27, // 61: protobuf.Profile.GetProfileShortInfo:output_type -> protobuf.ProfileShortInfoResponse
-	30, // 62: protobuf.Profile.GetProfileList:output_type -> protobuf.ProfileListResponse
-	32, // 63: protobuf.Profile.CheckProfileExists:output_type -> protobuf.CheckProfileExistsResponse
-	34, // 64: protobuf.Profile.GetImageByTelegramUserId:output_type -> protobuf.ImageByTelegramUserIdResponse
-	2,  // 65: protobuf.Profile.GetImageLastByTelegramUserId:output_type -> protobuf.ImageResponse
-	2,  // 66: protobuf.Profile.GetImageById:output_type -> protobuf.ImageResponse
-	38, // 67: protobuf.Profile.DeleteImage:output_type -> protobuf.ImageDeleteResponse
-	6,  // 68: protobuf.Profile.GetFilter:output_type -> protobuf.FilterResponse
-	6,  // 69: protobuf.Profile.UpdateFilter:output_type -> protobuf.FilterResponse
-	7,  // 70: protobuf.Profile.GetTelegram:output_type -> protobuf.TelegramResponse
-	43, // 71: protobuf.Profile.AddBlock:output_type -> protobuf.BlockAddResponse
-	46, // 72: protobuf.Profile.GetBlockedList:output_type -> protobuf.GetBlockedListResponse
-	48, // 73: protobuf.Profile.Unblock:output_type -> protobuf.UnblockResponse
-	50, // 74: protobuf.Profile.AddLike:output_type -> protobuf.LikeAddResponse
-	52, // 75: protobuf.Profile.UpdateLike:output_type -> protobuf.LikeUpdateResponse
-	54, // 76: protobuf.Profile.GetLastLike:output_type -> protobuf.LikeGetLastResponse
-	56, // 77: protobuf.Profile.AddComplaint:output_type -> protobuf.ComplaintAddResponse
-	8,  // 78: protobuf.Profile.GetStatusByTelegramUserId:output_type -> protobuf.StatusResponse
-	63, // 79: protobuf.Profile.UpdateCoordinates:output_type -> protobuf.NavigatorUpdateResponse
-	59, // 80: protobuf.Profile.AddPayment:output_type -> protobuf.PaymentAddResponse
-	61, // 81: protobuf.Profile.CheckPremium:output_type -> protobuf.CheckPremiumResponse
-	65, // 82: protobuf.Profile.UpdateSettings:output_type -> protobuf.UpdateSettingsResponse
-	54, // [54:83] is the sub-list for method output_type
-	25, // [25:54] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+
+func (x *LikeUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_contracts_proto_profiles_profile_proto_init() }
-func file_contracts_proto_profiles_profile_proto_init() {
-	if File_contracts_proto_profiles_profile_proto != nil {
-		return
+func (*LikeUpdateRequest) ProtoMessage() {}
+
+func (x *LikeUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_contracts_proto_profiles_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileMetadata); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeUpdateRequest.ProtoReflect.Descriptor instead.
+func (*LikeUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LikeUpdateRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LikeUpdateRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *LikeUpdateRequest) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+type LikeUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно обновлен да/нет
+	IsMatch bool `protobuf:"varint,2,opt,name=isMatch,proto3" json:"isMatch,omitempty"` // образовалась взаимная симпатия да/нет
+}
+
+func (x *LikeUpdateResponse) Reset() {
+	*x = LikeUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeUpdateResponse) ProtoMessage() {}
+
+func (x *LikeUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeUpdateResponse.ProtoReflect.Descriptor instead.
+func (*LikeUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LikeUpdateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LikeUpdateResponse) GetIsMatch() bool {
+	if x != nil {
+		return x.IsMatch
+	}
+	return false
+}
+
+type LikeGetLastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя
+}
+
+func (x *LikeGetLastRequest) Reset() {
+	*x = LikeGetLastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeGetLastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeGetLastRequest) ProtoMessage() {}
+
+func (x *LikeGetLastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeGetLastRequest.ProtoReflect.Descriptor instead.
+func (*LikeGetLastRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LikeGetLastRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type LikeGetLastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Like *LikeEntity `protobuf:"bytes,1,opt,name=like,proto3" json:"like,omitempty"` // лайк пользователя
+}
+
+func (x *LikeGetLastResponse) Reset() {
+	*x = LikeGetLastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeGetLastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeGetLastResponse) ProtoMessage() {}
+
+func (x *LikeGetLastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeGetLastResponse.ProtoReflect.Descriptor instead.
+func (*LikeGetLastResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LikeGetLastResponse) GetLike() *LikeEntity {
+	if x != nil {
+		return x.Like
+	}
+	return nil
+}
+
+type GetLikeListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+}
+
+func (x *GetLikeListRequest) Reset() {
+	*x = GetLikeListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLikeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeListRequest) ProtoMessage() {}
+
+func (x *GetLikeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeListRequest.ProtoReflect.Descriptor instead.
+func (*GetLikeListRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetLikeListRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type LikeListItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string               `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм, который поставил лайк
+	DisplayName    string               `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`       // имя для отображения
+	Username       string               `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`             // username пользователя в телеграм
+	Url            string               `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                       // url изображения
+	UpdatedAt      *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`           // дата лайка
+}
+
+func (x *LikeListItemResponse) Reset() {
+	*x = LikeListItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikeListItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeListItemResponse) ProtoMessage() {}
+
+func (x *LikeListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeListItemResponse.ProtoReflect.Descriptor instead.
+func (*LikeListItemResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LikeListItemResponse) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *LikeListItemResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *LikeListItemResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LikeListItemResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LikeListItemResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetLikeListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []*LikeListItemResponse `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"` // список последних лайков
+}
+
+func (x *GetLikeListResponse) Reset() {
+	*x = GetLikeListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLikeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikeListResponse) ProtoMessage() {}
+
+func (x *GetLikeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikeListResponse.ProtoReflect.Descriptor instead.
+func (*GetLikeListResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetLikeListResponse) GetContent() []*LikeListItemResponse {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetMatchListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+}
+
+func (x *GetMatchListRequest) Reset() {
+	*x = GetMatchListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchListRequest) ProtoMessage() {}
+
+func (x *GetMatchListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchListRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchListRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetMatchListRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type MatchListItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchedTelegramUserId string               `protobuf:"bytes,1,opt,name=matchedTelegramUserId,proto3" json:"matchedTelegramUserId,omitempty"` // id пользователя в телеграм, с которым взаимная симпатия
+	DisplayName           string               `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`                     // имя для отображения
+	Username              string               `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`                           // username пользователя в телеграм
+	Url                   string               `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                     // url изображения
+	CreatedAt             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                         // дата создания
+}
+
+func (x *MatchListItemResponse) Reset() {
+	*x = MatchListItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MatchListItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchListItemResponse) ProtoMessage() {}
+
+func (x *MatchListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchListItemResponse.ProtoReflect.Descriptor instead.
+func (*MatchListItemResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *MatchListItemResponse) GetMatchedTelegramUserId() string {
+	if x != nil {
+		return x.MatchedTelegramUserId
+	}
+	return ""
+}
+
+func (x *MatchListItemResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MatchListItemResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MatchListItemResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MatchListItemResponse) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetMatchListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []*MatchListItemResponse `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"` // список взаимных симпатий
+}
+
+func (x *GetMatchListResponse) Reset() {
+	*x = GetMatchListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMatchListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchListResponse) ProtoMessage() {}
+
+func (x *GetMatchListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchListResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchListResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetMatchListResponse) GetContent() []*MatchListItemResponse {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UnmatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId        string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`               // id пользователя в телеграм
+	MatchedTelegramUserId string `protobuf:"bytes,2,opt,name=matchedTelegramUserId,proto3" json:"matchedTelegramUserId,omitempty"` // id пользователя в телеграм, с которым разрываем симпатию
+}
+
+func (x *UnmatchRequest) Reset() {
+	*x = UnmatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchRequest) ProtoMessage() {}
+
+func (x *UnmatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchRequest.ProtoReflect.Descriptor instead.
+func (*UnmatchRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UnmatchRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *UnmatchRequest) GetMatchedTelegramUserId() string {
+	if x != nil {
+		return x.MatchedTelegramUserId
+	}
+	return ""
+}
+
+type UnmatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно удалена взаимная симпатия да/нет
+}
+
+func (x *UnmatchResponse) Reset() {
+	*x = UnmatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchResponse) ProtoMessage() {}
+
+func (x *UnmatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchResponse.ProtoReflect.Descriptor instead.
+func (*UnmatchResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UnmatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // id сообщения
+	ConversationId          uint64               `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`                  // id диалога
+	SenderTelegramUserId    string               `protobuf:"bytes,3,opt,name=senderTelegramUserId,proto3" json:"senderTelegramUserId,omitempty"`       // id отправителя в телеграм
+	RecipientTelegramUserId string               `protobuf:"bytes,4,opt,name=recipientTelegramUserId,proto3" json:"recipientTelegramUserId,omitempty"` // id получателя в телеграм
+	Text                    string               `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`                                       // текст сообщения
+	IsRead                  bool                 `protobuf:"varint,6,opt,name=isRead,proto3" json:"isRead,omitempty"`                                  // прочитано да/нет
+	CreatedAt               *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                             // дата создания
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MessageResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageResponse) GetConversationId() uint64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MessageResponse) GetSenderTelegramUserId() string {
+	if x != nil {
+		return x.SenderTelegramUserId
+	}
+	return ""
+}
+
+func (x *MessageResponse) GetRecipientTelegramUserId() string {
+	if x != nil {
+		return x.RecipientTelegramUserId
+	}
+	return ""
+}
+
+func (x *MessageResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MessageResponse) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *MessageResponse) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SendMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId          string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`                   // id пользователя в телеграм
+	RecipientTelegramUserId string `protobuf:"bytes,2,opt,name=recipientTelegramUserId,proto3" json:"recipientTelegramUserId,omitempty"` // id получателя в телеграм
+	Text                    string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                                       // текст сообщения
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SendMessageRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetRecipientTelegramUserId() string {
+	if x != nil {
+		return x.RecipientTelegramUserId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type GetConversationListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+}
+
+func (x *GetConversationListRequest) Reset() {
+	*x = GetConversationListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationListRequest) ProtoMessage() {}
+
+func (x *GetConversationListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationListRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationListRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetConversationListRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type ConversationListItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // id диалога
+	PeerTelegramUserId string               `protobuf:"bytes,2,opt,name=peerTelegramUserId,proto3" json:"peerTelegramUserId,omitempty"` // id собеседника в телеграм
+	DisplayName        string               `protobuf:"bytes,3,opt,name=displayName,proto3" json:"displayName,omitempty"`               // имя для отображения
+	Url                string               `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                               // url изображения
+	LastMessage        string               `protobuf:"bytes,5,opt,name=lastMessage,proto3" json:"lastMessage,omitempty"`               // текст последнего сообщения
+	LastMessageAt      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=lastMessageAt,proto3" json:"lastMessageAt,omitempty"`           // дата последнего сообщения
+	UnreadCount        uint64               `protobuf:"varint,7,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"`              // количество непрочитанных сообщений
+}
+
+func (x *ConversationListItemResponse) Reset() {
+	*x = ConversationListItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversationListItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationListItemResponse) ProtoMessage() {}
+
+func (x *ConversationListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationListItemResponse.ProtoReflect.Descriptor instead.
+func (*ConversationListItemResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ConversationListItemResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConversationListItemResponse) GetPeerTelegramUserId() string {
+	if x != nil {
+		return x.PeerTelegramUserId
+	}
+	return ""
+}
+
+func (x *ConversationListItemResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ConversationListItemResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ConversationListItemResponse) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *ConversationListItemResponse) GetLastMessageAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return nil
+}
+
+func (x *ConversationListItemResponse) GetUnreadCount() uint64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type GetConversationListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content []*ConversationListItemResponse `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"` // список диалогов
+}
+
+func (x *GetConversationListResponse) Reset() {
+	*x = GetConversationListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationListResponse) ProtoMessage() {}
+
+func (x *GetConversationListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationListResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationListResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetConversationListResponse) GetContent() []*ConversationListItemResponse {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetMessageListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`  // id пользователя в телеграм
+	ConversationId uint64 `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"` // id диалога
+	Cursor         string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`                  // курсор следующей страницы
+	Limit          uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                   // количество сообщений на странице
+}
+
+func (x *GetMessageListRequest) Reset() {
+	*x = GetMessageListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessageListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageListRequest) ProtoMessage() {}
+
+func (x *GetMessageListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageListRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageListRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetMessageListRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *GetMessageListRequest) GetConversationId() uint64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *GetMessageListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetMessageListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMessageListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content    []*MessageResponse `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"`       // список сообщений, от новых к старым
+	NextCursor string             `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // курсор следующей страницы
+	HasNext    bool               `protobuf:"varint,3,opt,name=hasNext,proto3" json:"hasNext,omitempty"`      // есть следующая страница да/нет
+}
+
+func (x *GetMessageListResponse) Reset() {
+	*x = GetMessageListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMessageListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageListResponse) ProtoMessage() {}
+
+func (x *GetMessageListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageListResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageListResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetMessageListResponse) GetContent() []*MessageResponse {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetMessageListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetMessageListResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+type MarkReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId    string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`        // id пользователя в телеграм
+	ConversationId    uint64 `protobuf:"varint,2,opt,name=conversationId,proto3" json:"conversationId,omitempty"`       // id диалога
+	LastReadMessageId uint64 `protobuf:"varint,3,opt,name=lastReadMessageId,proto3" json:"lastReadMessageId,omitempty"` // id последнего прочитанного сообщения, 0 - все сообщения
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MarkReadRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *MarkReadRequest) GetConversationId() uint64 {
+	if x != nil {
+		return x.ConversationId
+	}
+	return 0
+}
+
+func (x *MarkReadRequest) GetLastReadMessageId() uint64 {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return 0
+}
+
+type MarkReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно отмечены прочитанными да/нет
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MarkReadResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ComplaintAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId         string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`                 // id пользователя в телеграм
+	CriminalTelegramUserId string `protobuf:"bytes,2,opt,name=criminalTelegramUserId,proto3" json:"criminalTelegramUserId,omitempty"` // id мошенника
+	Type                   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                     // тип жалобы
+	Description            string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                       // описание жалобы
+}
+
+func (x *ComplaintAddRequest) Reset() {
+	*x = ComplaintAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplaintAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplaintAddRequest) ProtoMessage() {}
+
+func (x *ComplaintAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplaintAddRequest.ProtoReflect.Descriptor instead.
+func (*ComplaintAddRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ComplaintAddRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *ComplaintAddRequest) GetCriminalTelegramUserId() string {
+	if x != nil {
+		return x.CriminalTelegramUserId
+	}
+	return ""
+}
+
+func (x *ComplaintAddRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ComplaintAddRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ComplaintAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно заблокирован да/нет
+}
+
+func (x *ComplaintAddResponse) Reset() {
+	*x = ComplaintAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplaintAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplaintAddResponse) ProtoMessage() {}
+
+func (x *ComplaintAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplaintAddResponse.ProtoReflect.Descriptor instead.
+func (*ComplaintAddResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ComplaintAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ComplaintAppealAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id заблокированного пользователя в телеграм
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`       // текст апелляции
+}
+
+func (x *ComplaintAppealAddRequest) Reset() {
+	*x = ComplaintAppealAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplaintAppealAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplaintAppealAddRequest) ProtoMessage() {}
+
+func (x *ComplaintAppealAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplaintAppealAddRequest.ProtoReflect.Descriptor instead.
+func (*ComplaintAppealAddRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ComplaintAppealAddRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *ComplaintAppealAddRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ComplaintAppealAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // апелляция принята к рассмотрению да/нет
+}
+
+func (x *ComplaintAppealAddResponse) Reset() {
+	*x = ComplaintAppealAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplaintAppealAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplaintAppealAddResponse) ProtoMessage() {}
+
+func (x *ComplaintAppealAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplaintAppealAddResponse.ProtoReflect.Descriptor instead.
+func (*ComplaintAppealAddResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ComplaintAppealAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetStatusByTelegramUserIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+}
+
+func (x *GetStatusByTelegramUserIdRequest) Reset() {
+	*x = GetStatusByTelegramUserIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusByTelegramUserIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusByTelegramUserIdRequest) ProtoMessage() {}
+
+func (x *GetStatusByTelegramUserIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusByTelegramUserIdRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusByTelegramUserIdRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetStatusByTelegramUserIdRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type PaymentAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	Price          string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`                   // стоимость платежа
+	Currency       string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`             // валюта
+	Tariff         string `protobuf:"bytes,4,opt,name=tariff,proto3" json:"tariff,omitempty"`                 // тариф
+}
+
+func (x *PaymentAddRequest) Reset() {
+	*x = PaymentAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAddRequest) ProtoMessage() {}
+
+func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
+func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PaymentAddRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetTariff() string {
+	if x != nil {
+		return x.Tariff
+	}
+	return ""
+}
+
+type PaymentAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно оплачено да/нет
+}
+
+func (x *PaymentAddResponse) Reset() {
+	*x = PaymentAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAddResponse) ProtoMessage() {}
+
+func (x *PaymentAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAddResponse.ProtoReflect.Descriptor instead.
+func (*PaymentAddResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PaymentAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CheckPremiumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+}
+
+func (x *CheckPremiumRequest) Reset() {
+	*x = CheckPremiumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPremiumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPremiumRequest) ProtoMessage() {}
+
+func (x *CheckPremiumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPremiumRequest.ProtoReflect.Descriptor instead.
+func (*CheckPremiumRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CheckPremiumRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type CheckPremiumResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsPremium      bool                 `protobuf:"varint,1,opt,name=isPremium,proto3" json:"isPremium,omitempty"`          // активирован премиум аккаунт да/нет
+	AvailableUntil *timestamp.Timestamp `protobuf:"bytes,2,opt,name=availableUntil,proto3" json:"availableUntil,omitempty"` // премиум действует до
+}
+
+func (x *CheckPremiumResponse) Reset() {
+	*x = CheckPremiumResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPremiumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPremiumResponse) ProtoMessage() {}
+
+func (x *CheckPremiumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPremiumResponse.ProtoReflect.Descriptor instead.
+func (*CheckPremiumResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CheckPremiumResponse) GetIsPremium() bool {
+	if x != nil {
+		return x.IsPremium
+	}
+	return false
+}
+
+func (x *CheckPremiumResponse) GetAvailableUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.AvailableUntil
+	}
+	return nil
+}
+
+type NavigatorUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string  `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	CountryCode    *string `protobuf:"bytes,2,opt,name=countryCode,proto3,oneof" json:"countryCode,omitempty"` // код страны
+	CountryName    *string `protobuf:"bytes,3,opt,name=countryName,proto3,oneof" json:"countryName,omitempty"` // название страны
+	City           *string `protobuf:"bytes,4,opt,name=city,proto3,oneof" json:"city,omitempty"`               // название города
+	Latitude       float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`           // широта
+	Longitude      float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`         // долгота
+}
+
+func (x *NavigatorUpdateRequest) Reset() {
+	*x = NavigatorUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NavigatorUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NavigatorUpdateRequest) ProtoMessage() {}
+
+func (x *NavigatorUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NavigatorUpdateRequest.ProtoReflect.Descriptor instead.
+func (*NavigatorUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *NavigatorUpdateRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *NavigatorUpdateRequest) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *NavigatorUpdateRequest) GetCountryName() string {
+	if x != nil && x.CountryName != nil {
+		return *x.CountryName
+	}
+	return ""
+}
+
+func (x *NavigatorUpdateRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *NavigatorUpdateRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *NavigatorUpdateRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type NavigatorUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно обновлено да/нет
+}
+
+func (x *NavigatorUpdateResponse) Reset() {
+	*x = NavigatorUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NavigatorUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NavigatorUpdateResponse) ProtoMessage() {}
+
+func (x *NavigatorUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NavigatorUpdateResponse.ProtoReflect.Descriptor instead.
+func (*NavigatorUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *NavigatorUpdateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpdateSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	IsHiddenAge    bool   `protobuf:"varint,2,opt,name=isHiddenAge,proto3" json:"isHiddenAge,omitempty"`      // показывать возраст до текущего пользователя да/нет
+	Measurement    string `protobuf:"bytes,3,opt,name=measurement,proto3" json:"measurement,omitempty"`       // единицы измерения метрическая/американская
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateSettingsRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *UpdateSettingsRequest) GetIsHiddenAge() bool {
+	if x != nil {
+		return x.IsHiddenAge
+	}
+	return false
+}
+
+func (x *UpdateSettingsRequest) GetMeasurement() string {
+	if x != nil {
+		return x.Measurement
+	}
+	return ""
+}
+
+type UpdateSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно обновлено да/нет
+}
+
+func (x *UpdateSettingsResponse) Reset() {
+	*x = UpdateSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdateSettingsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RefreshTokenAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string               `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм
+	TokenHash      string               `protobuf:"bytes,2,opt,name=tokenHash,proto3" json:"tokenHash,omitempty"`           // sha256 хеш refresh токена
+	ExpiresAt      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`           // дата окончания действия токена
+}
+
+func (x *RefreshTokenAddRequest) Reset() {
+	*x = RefreshTokenAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenAddRequest) ProtoMessage() {}
+
+func (x *RefreshTokenAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenAddRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenAddRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RefreshTokenAddRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *RefreshTokenAddRequest) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *RefreshTokenAddRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RefreshTokenAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно добавлено да/нет
+}
+
+func (x *RefreshTokenAddResponse) Reset() {
+	*x = RefreshTokenAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenAddResponse) ProtoMessage() {}
+
+func (x *RefreshTokenAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenAddResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenAddResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RefreshTokenAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RefreshTokenRotateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenHash    string               `protobuf:"bytes,1,opt,name=tokenHash,proto3" json:"tokenHash,omitempty"`       // sha256 хеш текущего refresh токена
+	NewTokenHash string               `protobuf:"bytes,2,opt,name=newTokenHash,proto3" json:"newTokenHash,omitempty"` // sha256 хеш нового refresh токена
+	ExpiresAt    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`       // дата окончания действия нового токена
+}
+
+func (x *RefreshTokenRotateRequest) Reset() {
+	*x = RefreshTokenRotateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRotateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRotateRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRotateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRotateRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRotateRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RefreshTokenRotateRequest) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+func (x *RefreshTokenRotateRequest) GetNewTokenHash() string {
+	if x != nil {
+		return x.NewTokenHash
+	}
+	return ""
+}
+
+func (x *RefreshTokenRotateRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RefreshTokenRotateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"` // id пользователя в телеграм, которому принадлежит токен
+}
+
+func (x *RefreshTokenRotateResponse) Reset() {
+	*x = RefreshTokenRotateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRotateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRotateResponse) ProtoMessage() {}
+
+func (x *RefreshTokenRotateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRotateResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRotateResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RefreshTokenRotateResponse) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type RefreshTokenRevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenHash string `protobuf:"bytes,1,opt,name=tokenHash,proto3" json:"tokenHash,omitempty"` // sha256 хеш refresh токена
+}
+
+func (x *RefreshTokenRevokeRequest) Reset() {
+	*x = RefreshTokenRevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRevokeRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRevokeRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RefreshTokenRevokeRequest) GetTokenHash() string {
+	if x != nil {
+		return x.TokenHash
+	}
+	return ""
+}
+
+type RefreshTokenRevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно отозвано да/нет
+}
+
+func (x *RefreshTokenRevokeResponse) Reset() {
+	*x = RefreshTokenRevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRevokeResponse) ProtoMessage() {}
+
+func (x *RefreshTokenRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRevokeResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RefreshTokenRevokeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AdminComplaintListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminTelegramUserId    string               `protobuf:"bytes,1,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"`             // id администратора в телеграм
+	TelegramUserId         *string              `protobuf:"bytes,2,opt,name=telegramUserId,proto3,oneof" json:"telegramUserId,omitempty"`                 // фильтр по id пользователя, который пожаловался
+	CriminalTelegramUserId *string              `protobuf:"bytes,3,opt,name=criminalTelegramUserId,proto3,oneof" json:"criminalTelegramUserId,omitempty"` // фильтр по id пользователя, на которого пожаловались
+	Type                   *string              `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`                                     // фильтр по типу жалобы
+	DateFrom               *timestamp.Timestamp `protobuf:"bytes,5,opt,name=dateFrom,proto3,oneof" json:"dateFrom,omitempty"`                             // жалобы, созданные начиная с даты
+	DateTo                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=dateTo,proto3,oneof" json:"dateTo,omitempty"`                                 // жалобы, созданные до даты
+	Page                   uint64               `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`                                          // номер страницы
+	Size                   uint64               `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`                                          // количество элементов на странице
+	Status                 *string              `protobuf:"bytes,9,opt,name=status,proto3,oneof" json:"status,omitempty"`                                 // фильтр по статусу жалобы
+}
+
+func (x *AdminComplaintListRequest) Reset() {
+	*x = AdminComplaintListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminComplaintListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminComplaintListRequest) ProtoMessage() {}
+
+func (x *AdminComplaintListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminComplaintListRequest.ProtoReflect.Descriptor instead.
+func (*AdminComplaintListRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AdminComplaintListRequest) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintListRequest) GetTelegramUserId() string {
+	if x != nil && x.TelegramUserId != nil {
+		return *x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintListRequest) GetCriminalTelegramUserId() string {
+	if x != nil && x.CriminalTelegramUserId != nil {
+		return *x.CriminalTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintListRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *AdminComplaintListRequest) GetDateFrom() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *AdminComplaintListRequest) GetDateTo() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *AdminComplaintListRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminComplaintListRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AdminComplaintListRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type AdminComplaintListItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                        // id жалобы
+	TelegramUserId         string               `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`                 // id пользователя, который пожаловался
+	CriminalTelegramUserId string               `protobuf:"bytes,3,opt,name=criminalTelegramUserId,proto3" json:"criminalTelegramUserId,omitempty"` // id пользователя, на которого пожаловались
+	Type                   string               `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                     // тип жалобы
+	Description            string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                       // описание жалобы
+	CreatedAt              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                           // дата создания
+	Status                 string               `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                 // статус жалобы: open, under_review, upheld, dismissed
+	Weight                 float64              `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`                               // вес жалобы по доверию к пожаловавшемуся пользователю
+}
+
+func (x *AdminComplaintListItemResponse) Reset() {
+	*x = AdminComplaintListItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminComplaintListItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminComplaintListItemResponse) ProtoMessage() {}
+
+func (x *AdminComplaintListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminComplaintListItemResponse.ProtoReflect.Descriptor instead.
+func (*AdminComplaintListItemResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AdminComplaintListItemResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminComplaintListItemResponse) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintListItemResponse) GetCriminalTelegramUserId() string {
+	if x != nil {
+		return x.CriminalTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintListItemResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminComplaintListItemResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AdminComplaintListItemResponse) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AdminComplaintListItemResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminComplaintListItemResponse) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type AdminComplaintListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasPrevious   bool                              `protobuf:"varint,1,opt,name=hasPrevious,proto3" json:"hasPrevious,omitempty"`     // есть ли предыдущая страница
+	HasNext       bool                              `protobuf:"varint,2,opt,name=hasNext,proto3" json:"hasNext,omitempty"`             // есть ли следующая страница
+	Page          uint64                            `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                   // номер страницы
+	Size          uint64                            `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                   // количество элементов на странице
+	TotalEntities uint64                            `protobuf:"varint,5,opt,name=totalEntities,proto3" json:"totalEntities,omitempty"` // общее количество элементов
+	TotalPages    uint64                            `protobuf:"varint,6,opt,name=totalPages,proto3" json:"totalPages,omitempty"`       // общее количество страниц
+	Content       []*AdminComplaintListItemResponse `protobuf:"bytes,7,rep,name=content,proto3" json:"content,omitempty"`              // список жалоб
+}
+
+func (x *AdminComplaintListResponse) Reset() {
+	*x = AdminComplaintListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminComplaintListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminComplaintListResponse) ProtoMessage() {}
+
+func (x *AdminComplaintListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminComplaintListResponse.ProtoReflect.Descriptor instead.
+func (*AdminComplaintListResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AdminComplaintListResponse) GetHasPrevious() bool {
+	if x != nil {
+		return x.HasPrevious
+	}
+	return false
+}
+
+func (x *AdminComplaintListResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *AdminComplaintListResponse) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminComplaintListResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AdminComplaintListResponse) GetTotalEntities() uint64 {
+	if x != nil {
+		return x.TotalEntities
+	}
+	return 0
+}
+
+func (x *AdminComplaintListResponse) GetTotalPages() uint64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *AdminComplaintListResponse) GetContent() []*AdminComplaintListItemResponse {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type AdminProfileGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminTelegramUserId string `protobuf:"bytes,1,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"` // id администратора в телеграм
+	TelegramUserId      string `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`           // id пользователя в телеграм
+}
+
+func (x *AdminProfileGetRequest) Reset() {
+	*x = AdminProfileGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminProfileGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProfileGetRequest) ProtoMessage() {}
+
+func (x *AdminProfileGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProfileGetRequest.ProtoReflect.Descriptor instead.
+func (*AdminProfileGetRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AdminProfileGetRequest) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminProfileGetRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+type AdminImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`               // id изображения
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`            // имя файла
+	Url       string               `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`              // url изображения
+	IsBlocked bool                 `protobuf:"varint,4,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"` // заблокировано да/нет
+	IsPrimary bool                 `protobuf:"varint,5,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"` // основное да/нет
+	IsPrivate bool                 `protobuf:"varint,6,opt,name=isPrivate,proto3" json:"isPrivate,omitempty"` // приватное да/нет
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`  // дата загрузки
+}
+
+func (x *AdminImageResponse) Reset() {
+	*x = AdminImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminImageResponse) ProtoMessage() {}
+
+func (x *AdminImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminImageResponse.ProtoReflect.Descriptor instead.
+func (*AdminImageResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AdminImageResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminImageResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminImageResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminImageResponse) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *AdminImageResponse) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *AdminImageResponse) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *AdminImageResponse) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AdminProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TelegramUserId string                `protobuf:"bytes,1,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`  // id пользователя в телеграм
+	DisplayName    string                `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`        // имя для отображения
+	Age            uint64                `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`                       // возраст
+	Gender         string                `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`                  // пол
+	Description    string                `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`        // описание
+	IsBlocked      bool                  `protobuf:"varint,6,opt,name=isBlocked,proto3" json:"isBlocked,omitempty"`           // заблокирован да/нет
+	IsFrozen       bool                  `protobuf:"varint,7,opt,name=isFrozen,proto3" json:"isFrozen,omitempty"`             // заморожен да/нет
+	ComplaintCount uint64                `protobuf:"varint,8,opt,name=complaintCount,proto3" json:"complaintCount,omitempty"` // количество жалоб на пользователя
+	Images         []*AdminImageResponse `protobuf:"bytes,9,rep,name=images,proto3" json:"images,omitempty"`                  // все изображения, включая приватные и заблокированные
+}
+
+func (x *AdminProfileResponse) Reset() {
+	*x = AdminProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProfileResponse) ProtoMessage() {}
+
+func (x *AdminProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProfileResponse.ProtoReflect.Descriptor instead.
+func (*AdminProfileResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AdminProfileResponse) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminProfileResponse) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AdminProfileResponse) GetAge() uint64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *AdminProfileResponse) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *AdminProfileResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AdminProfileResponse) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *AdminProfileResponse) GetIsFrozen() bool {
+	if x != nil {
+		return x.IsFrozen
+	}
+	return false
+}
+
+func (x *AdminProfileResponse) GetComplaintCount() uint64 {
+	if x != nil {
+		return x.ComplaintCount
+	}
+	return 0
+}
+
+func (x *AdminProfileResponse) GetImages() []*AdminImageResponse {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type AdminProfileBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminTelegramUserId string `protobuf:"bytes,1,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"` // id администратора в телеграм
+	TelegramUserId      string `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`           // id пользователя в телеграм
+	Reason              string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                           // причина
+}
+
+func (x *AdminProfileBlockRequest) Reset() {
+	*x = AdminProfileBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminProfileBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminProfileBlockRequest) ProtoMessage() {}
+
+func (x *AdminProfileBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminProfileBlockRequest.ProtoReflect.Descriptor instead.
+func (*AdminProfileBlockRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AdminProfileBlockRequest) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminProfileBlockRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminProfileBlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminImageBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminTelegramUserId string `protobuf:"bytes,1,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"` // id администратора в телеграм
+	ImageId             uint64 `protobuf:"varint,2,opt,name=imageId,proto3" json:"imageId,omitempty"`                        // id изображения
+	Reason              string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                           // причина
+}
+
+func (x *AdminImageBlockRequest) Reset() {
+	*x = AdminImageBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminImageBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminImageBlockRequest) ProtoMessage() {}
+
+func (x *AdminImageBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminImageBlockRequest.ProtoReflect.Descriptor instead.
+func (*AdminImageBlockRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AdminImageBlockRequest) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminImageBlockRequest) GetImageId() uint64 {
+	if x != nil {
+		return x.ImageId
+	}
+	return 0
+}
+
+func (x *AdminImageBlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminComplaintReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminTelegramUserId string `protobuf:"bytes,1,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"` // id администратора в телеграм
+	TelegramUserId      string `protobuf:"bytes,2,opt,name=telegramUserId,proto3" json:"telegramUserId,omitempty"`           // id пользователя, на которого пожаловались
+	Decision            string `protobuf:"bytes,3,opt,name=decision,proto3" json:"decision,omitempty"`                       // решение: upheld или dismissed
+	Reason              string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                           // причина
+}
+
+func (x *AdminComplaintReviewRequest) Reset() {
+	*x = AdminComplaintReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminComplaintReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminComplaintReviewRequest) ProtoMessage() {}
+
+func (x *AdminComplaintReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminComplaintReviewRequest.ProtoReflect.Descriptor instead.
+func (*AdminComplaintReviewRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AdminComplaintReviewRequest) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintReviewRequest) GetTelegramUserId() string {
+	if x != nil {
+		return x.TelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminComplaintReviewRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *AdminComplaintReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // успешно выполнено да/нет
+}
+
+func (x *AdminActionResponse) Reset() {
+	*x = AdminActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminActionResponse) ProtoMessage() {}
+
+func (x *AdminActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminActionResponse.ProtoReflect.Descriptor instead.
+func (*AdminActionResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AdminActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AdminAuditListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdminTelegramUserId  string  `protobuf:"bytes,1,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"`         // id администратора в телеграм
+	ActorTelegramUserId  *string `protobuf:"bytes,2,opt,name=actorTelegramUserId,proto3,oneof" json:"actorTelegramUserId,omitempty"`   // фильтр по id администратора, выполнившего действие
+	TargetTelegramUserId *string `protobuf:"bytes,3,opt,name=targetTelegramUserId,proto3,oneof" json:"targetTelegramUserId,omitempty"` // фильтр по id пользователя, над которым выполнено действие
+	Page                 uint64  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                      // номер страницы
+	Size                 uint64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                                      // количество элементов на странице
+}
+
+func (x *AdminAuditListRequest) Reset() {
+	*x = AdminAuditListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAuditListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAuditListRequest) ProtoMessage() {}
+
+func (x *AdminAuditListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAuditListRequest.ProtoReflect.Descriptor instead.
+func (*AdminAuditListRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *AdminAuditListRequest) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminAuditListRequest) GetActorTelegramUserId() string {
+	if x != nil && x.ActorTelegramUserId != nil {
+		return *x.ActorTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminAuditListRequest) GetTargetTelegramUserId() string {
+	if x != nil && x.TargetTelegramUserId != nil {
+		return *x.TargetTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminAuditListRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminAuditListRequest) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AdminAuditListItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // id записи
+	AdminTelegramUserId  string               `protobuf:"bytes,2,opt,name=adminTelegramUserId,proto3" json:"adminTelegramUserId,omitempty"`         // id администратора, выполнившего действие
+	Role                 string               `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                                       // роль администратора на момент действия
+	Action               string               `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                                   // действие
+	TargetTelegramUserId *string              `protobuf:"bytes,5,opt,name=targetTelegramUserId,proto3,oneof" json:"targetTelegramUserId,omitempty"` // id пользователя, над которым выполнено действие
+	TargetImageId        *uint64              `protobuf:"varint,6,opt,name=targetImageId,proto3,oneof" json:"targetImageId,omitempty"`              // id изображения, над которым выполнено действие
+	Reason               string               `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`                                   // причина
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`                             // дата действия
+}
+
+func (x *AdminAuditListItemResponse) Reset() {
+	*x = AdminAuditListItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAuditListItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAuditListItemResponse) ProtoMessage() {}
+
+func (x *AdminAuditListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAuditListItemResponse.ProtoReflect.Descriptor instead.
+func (*AdminAuditListItemResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *AdminAuditListItemResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminAuditListItemResponse) GetAdminTelegramUserId() string {
+	if x != nil {
+		return x.AdminTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminAuditListItemResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AdminAuditListItemResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminAuditListItemResponse) GetTargetTelegramUserId() string {
+	if x != nil && x.TargetTelegramUserId != nil {
+		return *x.TargetTelegramUserId
+	}
+	return ""
+}
+
+func (x *AdminAuditListItemResponse) GetTargetImageId() uint64 {
+	if x != nil && x.TargetImageId != nil {
+		return *x.TargetImageId
+	}
+	return 0
+}
+
+func (x *AdminAuditListItemResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminAuditListItemResponse) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AdminAuditListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasPrevious   bool                          `protobuf:"varint,1,opt,name=hasPrevious,proto3" json:"hasPrevious,omitempty"`     // есть ли предыдущая страница
+	HasNext       bool                          `protobuf:"varint,2,opt,name=hasNext,proto3" json:"hasNext,omitempty"`             // есть ли следующая страница
+	Page          uint64                        `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                   // номер страницы
+	Size          uint64                        `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                   // количество элементов на странице
+	TotalEntities uint64                        `protobuf:"varint,5,opt,name=totalEntities,proto3" json:"totalEntities,omitempty"` // общее количество элементов
+	TotalPages    uint64                        `protobuf:"varint,6,opt,name=totalPages,proto3" json:"totalPages,omitempty"`       // общее количество страниц
+	Content       []*AdminAuditListItemResponse `protobuf:"bytes,7,rep,name=content,proto3" json:"content,omitempty"`              // список действий администраторов
+}
+
+func (x *AdminAuditListResponse) Reset() {
+	*x = AdminAuditListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_contracts_proto_profiles_profile_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAuditListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAuditListResponse) ProtoMessage() {}
+
+func (x *AdminAuditListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_profiles_profile_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAuditListResponse.ProtoReflect.Descriptor instead.
+func (*AdminAuditListResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_profiles_profile_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *AdminAuditListResponse) GetHasPrevious() bool {
+	if x != nil {
+		return x.HasPrevious
+	}
+	return false
+}
+
+func (x *AdminAuditListResponse) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *AdminAuditListResponse) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminAuditListResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AdminAuditListResponse) GetTotalEntities() uint64 {
+	if x != nil {
+		return x.TotalEntities
+	}
+	return 0
+}
+
+func (x *AdminAuditListResponse) GetTotalPages() uint64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *AdminAuditListResponse) GetContent() []*AdminAuditListItemResponse {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_contracts_proto_profiles_profile_proto protoreflect.FileDescriptor
+
+var file_contracts_proto_profiles_profile_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6f, 0x0a,
+	0x13, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0xa1,
+	0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x32,
+	0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x22, 0x68, 0x0a, 0x0c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x41, 0x0a, 0x05,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22,
+	0x40, 0x0a, 0x11, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64,
+	0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x2a, 0x0a,
+	0x10, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x48,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x22, 0x34, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x2d, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x22, 0x72, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0a, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x6c,
+	0x69, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf4, 0x07, 0x0a, 0x11,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x72, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x12,
+	0x28, 0x0a, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0xf3, 0x07, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x38, 0x0a, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x17, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x50, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x15, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x16,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x3e, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x31, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x22, 0xaf, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x6e, 0x61,
+	0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x76, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xa0, 0x04, 0x0a, 0x15, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x6e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x76,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2a, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x1a,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xd6, 0x02, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12,
+	0x42, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc6, 0x02, 0x0a, 0x15,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x9f,
+	0x02, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x69, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x52, 0x0b, 0x68, 0x61, 0x73,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x43, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22,
+	0x65, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x1d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42,
+	0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4d, 0x0a, 0x23, 0x47,
+	0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x24, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x69, 0x0a, 0x0f, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x65, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x44, 0x0a, 0x13, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x22, 0x5a, 0x0a, 0x16, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x17,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x59, 0x0a, 0x13, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x14,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7b,
+	0x0a, 0x19, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x36, 0x0a, 0x1a, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x6f, 0x0a, 0x11, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x15, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4c, 0x0a, 0x16, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xe3, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x47, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x67, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x3c, 0x0a, 0x12, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x3f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x17, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x55, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a,
+	0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a,
+	0x0f, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x4c,
+	0x69, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x45, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x65, 0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x48, 0x0a,
+	0x12, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x3c, 0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x47,
+	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x13, 0x4c, 0x69, 0x6b, 0x65, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x6c, 0x69, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xc8, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x4f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x3d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xd7, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x0e,
+	0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0f,
+	0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0f, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x98, 0x02, 0x0a,
+	0x1c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x12, 0x70, 0x65, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x65, 0x65, 0x72, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x10,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x72,
+	0x69, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x19, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x36, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4a, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x22, 0x2e, 0x0a,
+	0x12, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a,
+	0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x14,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x12, 0x42, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x8a, 0x02, 0x0a, 0x16, 0x4e, 0x61, 0x76, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63,
+	0x69, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x17, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73,
+	0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x32,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x33, 0x0a,
+	0x17, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22,
+	0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x1a,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x39, 0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x36, 0x0a,
+	0x1a, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xe5, 0x03, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x16, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x16, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x04, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x63, 0x72, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb0, 0x02,
+	0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x63, 0x72, 0x69, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x8a, 0x02, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x72, 0x0a,
+	0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xde, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xc4, 0x02, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7c, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x13, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x14, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xeb, 0x02, 0x0a, 0x1a, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x14, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x32, 0x94, 0x1e,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x41, 0x64, 0x64,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x0d, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47,
+	0x65, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x23,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x73,
+	0x74, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49,
+	0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x52,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x6e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b,
+	0x65, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69,
+	0x6b, 0x65, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x08, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x79, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4e, 0x61, 0x76, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0a, 0x41, 0x64, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xab, 0x05, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x5d,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x45, 0x76, 0x67, 0x65, 0x6e, 0x69, 0x79, 0x42, 0x75, 0x64, 0x61, 0x65, 0x76, 0x2f, 0x74,
+	0x67, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_contracts_proto_profiles_profile_proto_rawDescOnce sync.Once
+	file_contracts_proto_profiles_profile_proto_rawDescData = file_contracts_proto_profiles_profile_proto_rawDesc
+)
+
+func file_contracts_proto_profiles_profile_proto_rawDescGZIP() []byte {
+	file_contracts_proto_profiles_profile_proto_rawDescOnce.Do(func() {
+		file_contracts_proto_profiles_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_contracts_proto_profiles_profile_proto_rawDescData)
+	})
+	return file_contracts_proto_profiles_profile_proto_rawDescData
+}
+
+var file_contracts_proto_profiles_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
+var file_contracts_proto_profiles_profile_proto_goTypes = []interface{}{
+	(*FileMetadata)(nil),                        // 0: protobuf.FileMetadata
+	(*ImageStatusResponse)(nil),                 // 1: protobuf.ImageStatusResponse
+	(*ImageResponse)(nil),                       // 2: protobuf.ImageResponse
+	(*ImageVariant)(nil),                        // 3: protobuf.ImageVariant
+	(*Point)(nil),                               // 4: protobuf.Point
+	(*NavigatorResponse)(nil),                   // 5: protobuf.NavigatorResponse
+	(*NavigatorDetailResponse)(nil),             // 6: protobuf.NavigatorDetailResponse
+	(*FilterResponse)(nil),                      // 7: protobuf.FilterResponse
+	(*TelegramResponse)(nil),                    // 8: protobuf.TelegramResponse
+	(*StatusResponse)(nil),                      // 9: protobuf.StatusResponse
+	(*SettingsResponse)(nil),                    // 10: protobuf.SettingsResponse
+	(*BlockResponse)(nil),                       // 11: protobuf.BlockResponse
+	(*LikeResponse)(nil),                        // 12: protobuf.LikeResponse
+	(*LikeEntity)(nil),                          // 13: protobuf.LikeEntity
+	(*ProfileAddRequest)(nil),                   // 14: protobuf.ProfileAddRequest
+	(*ProfileAddResponse)(nil),                  // 15: protobuf.ProfileAddResponse
+	(*ProfileUpdateRequest)(nil),                // 16: protobuf.ProfileUpdateRequest
+	(*ProfileFreezeRequest)(nil),                // 17: protobuf.ProfileFreezeRequest
+	(*ProfileFreezeResponse)(nil),               // 18: protobuf.ProfileFreezeResponse
+	(*ProfileRestoreRequest)(nil),               // 19: protobuf.ProfileRestoreRequest
+	(*ProfileRestoreResponse)(nil),              // 20: protobuf.ProfileRestoreResponse
+	(*ProfileDeleteRequest)(nil),                // 21: protobuf.ProfileDeleteRequest
+	(*ProfileDeleteResponse)(nil),               // 22: protobuf.ProfileDeleteResponse
+	(*ProfileGetRequest)(nil),                   // 23: protobuf.ProfileGetRequest
+	(*ProfileResponse)(nil),                     // 24: protobuf.ProfileResponse
+	(*ProfileGetDetailRequest)(nil),             // 25: protobuf.ProfileGetDetailRequest
+	(*ProfileDetailResponse)(nil),               // 26: protobuf.ProfileDetailResponse
+	(*ProfileGetShortInfoRequest)(nil),          // 27: protobuf.ProfileGetShortInfoRequest
+	(*ProfileShortInfoResponse)(nil),            // 28: protobuf.ProfileShortInfoResponse
+	(*ProfileGetListRequest)(nil),               // 29: protobuf.ProfileGetListRequest
+	(*ProfileListItemResponse)(nil),             // 30: protobuf.ProfileListItemResponse
+	(*ProfileListResponse)(nil),                 // 31: protobuf.ProfileListResponse
+	(*CheckProfileExistsRequest)(nil),           // 32: protobuf.CheckProfileExistsRequest
+	(*CheckProfileExistsResponse)(nil),          // 33: protobuf.CheckProfileExistsResponse
+	(*GetImageByTelegramUserIdRequest)(nil),     // 34: protobuf.GetImageByTelegramUserIdRequest
+	(*ImageByTelegramUserIdResponse)(nil),       // 35: protobuf.ImageByTelegramUserIdResponse
+	(*GetImageLastByTelegramUserIdRequest)(nil), // 36: protobuf.GetImageLastByTelegramUserIdRequest
+	(*GetImageByIdRequest)(nil),                 // 37: protobuf.GetImageByIdRequest
+	(*ImageDeleteRequest)(nil),                  // 38: protobuf.ImageDeleteRequest
+	(*ImageDeleteResponse)(nil),                 // 39: protobuf.ImageDeleteResponse
+	(*ImageUploadInfo)(nil),                     // 40: protobuf.ImageUploadInfo
+	(*ImageUploadRequest)(nil),                  // 41: protobuf.ImageUploadRequest
+	(*ImageUploadResponse)(nil),                 // 42: protobuf.ImageUploadResponse
+	(*ImageSetPrimaryRequest)(nil),              // 43: protobuf.ImageSetPrimaryRequest
+	(*ImageSetPrimaryResponse)(nil),             // 44: protobuf.ImageSetPrimaryResponse
+	(*ImageReorderRequest)(nil),                 // 45: protobuf.ImageReorderRequest
+	(*ImageReorderResponse)(nil),                // 46: protobuf.ImageReorderResponse
+	(*ImageUpdatePrivacyRequest)(nil),           // 47: protobuf.ImageUpdatePrivacyRequest
+	(*ImageUpdatePrivacyResponse)(nil),          // 48: protobuf.ImageUpdatePrivacyResponse
+	(*ImageGrantRequest)(nil),                   // 49: protobuf.ImageGrantRequest
+	(*ImageGrantResponse)(nil),                  // 50: protobuf.ImageGrantResponse
+	(*ImageGrantListRequest)(nil),               // 51: protobuf.ImageGrantListRequest
+	(*ImageGrantItem)(nil),                      // 52: protobuf.ImageGrantItem
+	(*ImageGrantListResponse)(nil),              // 53: protobuf.ImageGrantListResponse
+	(*FilterGetRequest)(nil),                    // 54: protobuf.FilterGetRequest
+	(*FilterUpdateRequest)(nil),                 // 55: protobuf.FilterUpdateRequest
+	(*TelegramGetRequest)(nil),                  // 56: protobuf.TelegramGetRequest
+	(*BlockAddRequest)(nil),                     // 57: protobuf.BlockAddRequest
+	(*BlockAddResponse)(nil),                    // 58: protobuf.BlockAddResponse
+	(*GetBlockedListRequest)(nil),               // 59: protobuf.GetBlockedListRequest
+	(*BlockedListItemResponse)(nil),             // 60: protobuf.BlockedListItemResponse
+	(*GetBlockedListResponse)(nil),              // 61: protobuf.GetBlockedListResponse
+	(*UnblockRequest)(nil),                      // 62: protobuf.UnblockRequest
+	(*UnblockResponse)(nil),                     // 63: protobuf.UnblockResponse
+	(*LikeAddRequest)(nil),                      // 64: protobuf.LikeAddRequest
+	(*LikeAddResponse)(nil),                     // 65: protobuf.LikeAddResponse
+	(*LikeUpdateRequest)(nil),                   // 66: protobuf.LikeUpdateRequest
+	(*LikeUpdateResponse)(nil),                  // 67: protobuf.LikeUpdateResponse
+	(*LikeGetLastRequest)(nil),                  // 68: protobuf.LikeGetLastRequest
+	(*LikeGetLastResponse)(nil),                 // 69: protobuf.LikeGetLastResponse
+	(*GetLikeListRequest)(nil),                  // 70: protobuf.GetLikeListRequest
+	(*LikeListItemResponse)(nil),                // 71: protobuf.LikeListItemResponse
+	(*GetLikeListResponse)(nil),                 // 72: protobuf.GetLikeListResponse
+	(*GetMatchListRequest)(nil),                 // 73: protobuf.GetMatchListRequest
+	(*MatchListItemResponse)(nil),               // 74: protobuf.MatchListItemResponse
+	(*GetMatchListResponse)(nil),                // 75: protobuf.GetMatchListResponse
+	(*UnmatchRequest)(nil),                      // 76: protobuf.UnmatchRequest
+	(*UnmatchResponse)(nil),                     // 77: protobuf.UnmatchResponse
+	(*MessageResponse)(nil),                     // 78: protobuf.MessageResponse
+	(*SendMessageRequest)(nil),                  // 79: protobuf.SendMessageRequest
+	(*GetConversationListRequest)(nil),          // 80: protobuf.GetConversationListRequest
+	(*ConversationListItemResponse)(nil),        // 81: protobuf.ConversationListItemResponse
+	(*GetConversationListResponse)(nil),         // 82: protobuf.GetConversationListResponse
+	(*GetMessageListRequest)(nil),               // 83: protobuf.GetMessageListRequest
+	(*GetMessageListResponse)(nil),              // 84: protobuf.GetMessageListResponse
+	(*MarkReadRequest)(nil),                     // 85: protobuf.MarkReadRequest
+	(*MarkReadResponse)(nil),                    // 86: protobuf.MarkReadResponse
+	(*ComplaintAddRequest)(nil),                 // 87: protobuf.ComplaintAddRequest
+	(*ComplaintAddResponse)(nil),                // 88: protobuf.ComplaintAddResponse
+	(*ComplaintAppealAddRequest)(nil),           // 89: protobuf.ComplaintAppealAddRequest
+	(*ComplaintAppealAddResponse)(nil),          // 90: protobuf.ComplaintAppealAddResponse
+	(*GetStatusByTelegramUserIdRequest)(nil),    // 91: protobuf.GetStatusByTelegramUserIdRequest
+	(*PaymentAddRequest)(nil),                   // 92: protobuf.PaymentAddRequest
+	(*PaymentAddResponse)(nil),                  // 93: protobuf.PaymentAddResponse
+	(*CheckPremiumRequest)(nil),                 // 94: protobuf.CheckPremiumRequest
+	(*CheckPremiumResponse)(nil),                // 95: protobuf.CheckPremiumResponse
+	(*NavigatorUpdateRequest)(nil),              // 96: protobuf.NavigatorUpdateRequest
+	(*NavigatorUpdateResponse)(nil),             // 97: protobuf.NavigatorUpdateResponse
+	(*UpdateSettingsRequest)(nil),               // 98: protobuf.UpdateSettingsRequest
+	(*UpdateSettingsResponse)(nil),              // 99: protobuf.UpdateSettingsResponse
+	(*RefreshTokenAddRequest)(nil),              // 100: protobuf.RefreshTokenAddRequest
+	(*RefreshTokenAddResponse)(nil),             // 101: protobuf.RefreshTokenAddResponse
+	(*RefreshTokenRotateRequest)(nil),           // 102: protobuf.RefreshTokenRotateRequest
+	(*RefreshTokenRotateResponse)(nil),          // 103: protobuf.RefreshTokenRotateResponse
+	(*RefreshTokenRevokeRequest)(nil),           // 104: protobuf.RefreshTokenRevokeRequest
+	(*RefreshTokenRevokeResponse)(nil),          // 105: protobuf.RefreshTokenRevokeResponse
+	(*AdminComplaintListRequest)(nil),           // 106: protobuf.AdminComplaintListRequest
+	(*AdminComplaintListItemResponse)(nil),      // 107: protobuf.AdminComplaintListItemResponse
+	(*AdminComplaintListResponse)(nil),          // 108: protobuf.AdminComplaintListResponse
+	(*AdminProfileGetRequest)(nil),              // 109: protobuf.AdminProfileGetRequest
+	(*AdminImageResponse)(nil),                  // 110: protobuf.AdminImageResponse
+	(*AdminProfileResponse)(nil),                // 111: protobuf.AdminProfileResponse
+	(*AdminProfileBlockRequest)(nil),            // 112: protobuf.AdminProfileBlockRequest
+	(*AdminImageBlockRequest)(nil),              // 113: protobuf.AdminImageBlockRequest
+	(*AdminComplaintReviewRequest)(nil),         // 114: protobuf.AdminComplaintReviewRequest
+	(*AdminActionResponse)(nil),                 // 115: protobuf.AdminActionResponse
+	(*AdminAuditListRequest)(nil),               // 116: protobuf.AdminAuditListRequest
+	(*AdminAuditListItemResponse)(nil),          // 117: protobuf.AdminAuditListItemResponse
+	(*AdminAuditListResponse)(nil),              // 118: protobuf.AdminAuditListResponse
+	(*timestamp.Timestamp)(nil),                 // 119: google.protobuf.Timestamp
+}
+var file_contracts_proto_profiles_profile_proto_depIdxs = []int32{
+	3,   // 0: protobuf.ImageResponse.variants:type_name -> protobuf.ImageVariant
+	4,   // 1: protobuf.NavigatorResponse.location:type_name -> protobuf.Point
+	119, // 2: protobuf.LikeResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	119, // 3: protobuf.LikeEntity.createdAt:type_name -> google.protobuf.Timestamp
+	119, // 4: protobuf.LikeEntity.updatedAt:type_name -> google.protobuf.Timestamp
+	0,   // 5: protobuf.ProfileAddRequest.files:type_name -> protobuf.FileMetadata
+	0,   // 6: protobuf.ProfileUpdateRequest.files:type_name -> protobuf.FileMetadata
+	5,   // 7: protobuf.ProfileResponse.navigator:type_name -> protobuf.NavigatorResponse
+	7,   // 8: protobuf.ProfileResponse.filter:type_name -> protobuf.FilterResponse
+	9,   // 9: protobuf.ProfileResponse.status:type_name -> protobuf.StatusResponse
+	10,  // 10: protobuf.ProfileResponse.settings:type_name -> protobuf.SettingsResponse
+	2,   // 11: protobuf.ProfileResponse.images:type_name -> protobuf.ImageResponse
+	119, // 12: protobuf.ProfileDetailResponse.lastOnline:type_name -> google.protobuf.Timestamp
+	6,   // 13: protobuf.ProfileDetailResponse.navigator:type_name -> protobuf.NavigatorDetailResponse
+	9,   // 14: protobuf.ProfileDetailResponse.status:type_name -> protobuf.StatusResponse
+	10,  // 15: protobuf.ProfileDetailResponse.settings:type_name -> protobuf.SettingsResponse
+	11,  // 16: protobuf.ProfileDetailResponse.block:type_name -> protobuf.BlockResponse
+	12,  // 17: protobuf.ProfileDetailResponse.like:type_name -> protobuf.LikeResponse
+	2,   // 18: protobuf.ProfileDetailResponse.images:type_name -> protobuf.ImageResponse
+	119, // 19: protobuf.ProfileShortInfoResponse.availableUntil:type_name -> google.protobuf.Timestamp
+	7,   // 20: protobuf.ProfileShortInfoResponse.filter:type_name -> protobuf.FilterResponse
+	119, // 21: protobuf.ProfileListItemResponse.lastOnline:type_name -> google.protobuf.Timestamp
+	30,  // 22: protobuf.ProfileListResponse.content:type_name -> protobuf.ProfileListItemResponse
+	40,  // 23: protobuf.ImageUploadRequest.info:type_name -> protobuf.ImageUploadInfo
+	2,   // 24: protobuf.ImageUploadResponse.image:type_name -> protobuf.ImageResponse
+	119, // 25: protobuf.ImageGrantItem.createdAt:type_name -> google.protobuf.Timestamp
+	52,  // 26: protobuf.ImageGrantListResponse.content:type_name -> protobuf.ImageGrantItem
+	60,  // 27: protobuf.GetBlockedListResponse.content:type_name -> protobuf.BlockedListItemResponse
+	13,  // 28: protobuf.LikeGetLastResponse.like:type_name -> protobuf.LikeEntity
+	119, // 29: protobuf.LikeListItemResponse.updatedAt:type_name -> google.protobuf.Timestamp
+	71,  // 30: protobuf.GetLikeListResponse.content:type_name -> protobuf.LikeListItemResponse
+	119, // 31: protobuf.MatchListItemResponse.createdAt:type_name -> google.protobuf.Timestamp
+	74,  // 32: protobuf.GetMatchListResponse.content:type_name -> protobuf.MatchListItemResponse
+	119, // 33: protobuf.MessageResponse.createdAt:type_name -> google.protobuf.Timestamp
+	119, // 34: protobuf.ConversationListItemResponse.lastMessageAt:type_name -> google.protobuf.Timestamp
+	81,  // 35: protobuf.GetConversationListResponse.content:type_name -> protobuf.ConversationListItemResponse
+	78,  // 36: protobuf.GetMessageListResponse.content:type_name -> protobuf.MessageResponse
+	119, // 37: protobuf.CheckPremiumResponse.availableUntil:type_name -> google.protobuf.Timestamp
+	119, // 38: protobuf.RefreshTokenAddRequest.expiresAt:type_name -> google.protobuf.Timestamp
+	119, // 39: protobuf.RefreshTokenRotateRequest.expiresAt:type_name -> google.protobuf.Timestamp
+	119, // 40: protobuf.AdminComplaintListRequest.dateFrom:type_name -> google.protobuf.Timestamp
+	119, // 41: protobuf.AdminComplaintListRequest.dateTo:type_name -> google.protobuf.Timestamp
+	119, // 42: protobuf.AdminComplaintListItemResponse.createdAt:type_name -> google.protobuf.Timestamp
+	107, // 43: protobuf.AdminComplaintListResponse.content:type_name -> protobuf.AdminComplaintListItemResponse
+	119, // 44: protobuf.AdminImageResponse.createdAt:type_name -> google.protobuf.Timestamp
+	110, // 45: protobuf.AdminProfileResponse.images:type_name -> protobuf.AdminImageResponse
+	119, // 46: protobuf.AdminAuditListItemResponse.createdAt:type_name -> google.protobuf.Timestamp
+	117, // 47: protobuf.AdminAuditListResponse.content:type_name -> protobuf.AdminAuditListItemResponse
+	14,  // 48: protobuf.Profile.AddProfile:input_type -> protobuf.ProfileAddRequest
+	16,  // 49: protobuf.Profile.UpdateProfile:input_type -> protobuf.ProfileUpdateRequest
+	17,  // 50: protobuf.Profile.FreezeProfile:input_type -> protobuf.ProfileFreezeRequest
+	19,  // 51: protobuf.Profile.RestoreProfile:input_type -> protobuf.ProfileRestoreRequest
+	21,  // 52: protobuf.Profile.DeleteProfile:input_type -> protobuf.ProfileDeleteRequest
+	23,  // 53: protobuf.Profile.GetProfile:input_type -> protobuf.ProfileGetRequest
+	25,  // 54: protobuf.Profile.GetProfileDetail:input_type -> protobuf.ProfileGetDetailRequest
+	27,  // 55: protobuf.Profile.GetProfileShortInfo:input_type -> protobuf.ProfileGetShortInfoRequest
+	29,  // 56: protobuf.Profile.GetProfileList:input_type -> protobuf.ProfileGetListRequest
+	32,  // 57: protobuf.Profile.CheckProfileExists:input_type -> protobuf.CheckProfileExistsRequest
+	34,  // 58: protobuf.Profile.GetImageByTelegramUserId:input_type -> protobuf.GetImageByTelegramUserIdRequest
+	36,  // 59: protobuf.Profile.GetImageLastByTelegramUserId:input_type -> protobuf.GetImageLastByTelegramUserIdRequest
+	37,  // 60: protobuf.Profile.GetImageById:input_type -> protobuf.GetImageByIdRequest
+	38,  // 61: protobuf.Profile.DeleteImage:input_type -> protobuf.ImageDeleteRequest
+	41,  // 62: protobuf.Profile.UploadImage:input_type -> protobuf.ImageUploadRequest
+	43,  // 63: protobuf.Profile.SetPrimaryImage:input_type -> protobuf.ImageSetPrimaryRequest
+	45,  // 64: protobuf.Profile.ReorderImages:input_type -> protobuf.ImageReorderRequest
+	47,  // 65: protobuf.Profile.UpdateImagePrivacy:input_type -> protobuf.ImageUpdatePrivacyRequest
+	49,  // 66: protobuf.Profile.AddImageGrant:input_type -> protobuf.ImageGrantRequest
+	49,  // 67: protobuf.Profile.DeleteImageGrant:input_type -> protobuf.ImageGrantRequest
+	51,  // 68: protobuf.Profile.GetImageGrantList:input_type -> protobuf.ImageGrantListRequest
+	54,  // 69: protobuf.Profile.GetFilter:input_type -> protobuf.FilterGetRequest
+	55,  // 70: protobuf.Profile.UpdateFilter:input_type -> protobuf.FilterUpdateRequest
+	56,  // 71: protobuf.Profile.GetTelegram:input_type -> protobuf.TelegramGetRequest
+	57,  // 72: protobuf.Profile.AddBlock:input_type -> protobuf.BlockAddRequest
+	59,  // 73: protobuf.Profile.GetBlockedList:input_type -> protobuf.GetBlockedListRequest
+	62,  // 74: protobuf.Profile.Unblock:input_type -> protobuf.UnblockRequest
+	64,  // 75: protobuf.Profile.AddLike:input_type -> protobuf.LikeAddRequest
+	66,  // 76: protobuf.Profile.UpdateLike:input_type -> protobuf.LikeUpdateRequest
+	68,  // 77: protobuf.Profile.GetLastLike:input_type -> protobuf.LikeGetLastRequest
+	70,  // 78: protobuf.Profile.GetLikeList:input_type -> protobuf.GetLikeListRequest
+	73,  // 79: protobuf.Profile.GetMatchList:input_type -> protobuf.GetMatchListRequest
+	76,  // 80: protobuf.Profile.Unmatch:input_type -> protobuf.UnmatchRequest
+	79,  // 81: protobuf.Profile.SendMessage:input_type -> protobuf.SendMessageRequest
+	80,  // 82: protobuf.Profile.GetConversationList:input_type -> protobuf.GetConversationListRequest
+	83,  // 83: protobuf.Profile.GetMessageList:input_type -> protobuf.GetMessageListRequest
+	85,  // 84: protobuf.Profile.MarkRead:input_type -> protobuf.MarkReadRequest
+	87,  // 85: protobuf.Profile.AddComplaint:input_type -> protobuf.ComplaintAddRequest
+	89,  // 86: protobuf.Profile.AddComplaintAppeal:input_type -> protobuf.ComplaintAppealAddRequest
+	91,  // 87: protobuf.Profile.GetStatusByTelegramUserId:input_type -> protobuf.GetStatusByTelegramUserIdRequest
+	96,  // 88: protobuf.Profile.UpdateCoordinates:input_type -> protobuf.NavigatorUpdateRequest
+	92,  // 89: protobuf.Profile.AddPayment:input_type -> protobuf.PaymentAddRequest
+	94,  // 90: protobuf.Profile.CheckPremium:input_type -> protobuf.CheckPremiumRequest
+	98,  // 91: protobuf.Profile.UpdateSettings:input_type -> protobuf.UpdateSettingsRequest
+	100, // 92: protobuf.Profile.AddRefreshToken:input_type -> protobuf.RefreshTokenAddRequest
+	102, // 93: protobuf.Profile.RotateRefreshToken:input_type -> protobuf.RefreshTokenRotateRequest
+	104, // 94: protobuf.Profile.RevokeRefreshToken:input_type -> protobuf.RefreshTokenRevokeRequest
+	106, // 95: protobuf.Admin.GetComplaintList:input_type -> protobuf.AdminComplaintListRequest
+	109, // 96: protobuf.Admin.GetProfile:input_type -> protobuf.AdminProfileGetRequest
+	112, // 97: protobuf.Admin.BlockProfile:input_type -> protobuf.AdminProfileBlockRequest
+	112, // 98: protobuf.Admin.UnblockProfile:input_type -> protobuf.AdminProfileBlockRequest
+	113, // 99: protobuf.Admin.BlockImage:input_type -> protobuf.AdminImageBlockRequest
+	113, // 100: protobuf.Admin.UnblockImage:input_type -> protobuf.AdminImageBlockRequest
+	114, // 101: protobuf.Admin.ReviewComplaints:input_type -> protobuf.AdminComplaintReviewRequest
+	116, // 102: protobuf.Admin.GetAuditList:input_type -> protobuf.AdminAuditListRequest
+	15,  // 103: protobuf.Profile.AddProfile:output_type -> protobuf.ProfileAddResponse
+	24,  // 104: protobuf.Profile.UpdateProfile:output_type -> protobuf.ProfileResponse
+	18,  // 105: protobuf.Profile.FreezeProfile:output_type -> protobuf.ProfileFreezeResponse
+	20,  // 106: protobuf.Profile.RestoreProfile:output_type -> protobuf.ProfileRestoreResponse
+	22,  // 107: protobuf.Profile.DeleteProfile:output_type -> protobuf.ProfileDeleteResponse
+	24,  // 108: protobuf.Profile.GetProfile:output_type -> protobuf.ProfileResponse
+	26,  // 109: protobuf.Profile.GetProfileDetail:output_type -> protobuf.ProfileDetailResponse
+	28,  // 110: protobuf.Profile.GetProfileShortInfo:output_type -> protobuf.ProfileShortInfoResponse
+	31,  // 111: protobuf.Profile.GetProfileList:output_type -> protobuf.ProfileListResponse
+	33,  // 112: protobuf.Profile.CheckProfileExists:output_type -> protobuf.CheckProfileExistsResponse
+	35,  // 113: protobuf.Profile.GetImageByTelegramUserId:output_type -> protobuf.ImageByTelegramUserIdResponse
+	2,   // 114: protobuf.Profile.GetImageLastByTelegramUserId:output_type -> protobuf.ImageResponse
+	2,   // 115: protobuf.Profile.GetImageById:output_type -> protobuf.ImageResponse
+	39,  // 116: protobuf.Profile.DeleteImage:output_type -> protobuf.ImageDeleteResponse
+	42,  // 117: protobuf.Profile.UploadImage:output_type -> protobuf.ImageUploadResponse
+	44,  // 118: protobuf.Profile.SetPrimaryImage:output_type -> protobuf.ImageSetPrimaryResponse
+	46,  // 119: protobuf.Profile.ReorderImages:output_type -> protobuf.ImageReorderResponse
+	48,  // 120: protobuf.Profile.UpdateImagePrivacy:output_type -> protobuf.ImageUpdatePrivacyResponse
+	50,  // 121: protobuf.Profile.AddImageGrant:output_type -> protobuf.ImageGrantResponse
+	50,  // 122: protobuf.Profile.DeleteImageGrant:output_type -> protobuf.ImageGrantResponse
+	53,  // 123: protobuf.Profile.GetImageGrantList:output_type -> protobuf.ImageGrantListResponse
+	7,   // 124: protobuf.Profile.GetFilter:output_type -> protobuf.FilterResponse
+	7,   // 125: protobuf.Profile.UpdateFilter:output_type -> protobuf.FilterResponse
+	8,   // 126: protobuf.Profile.GetTelegram:output_type -> protobuf.TelegramResponse
+	58,  // 127: protobuf.Profile.AddBlock:output_type -> protobuf.BlockAddResponse
+	61,  // 128: protobuf.Profile.GetBlockedList:output_type -> protobuf.GetBlockedListResponse
+	63,  // 129: protobuf.Profile.Unblock:output_type -> protobuf.UnblockResponse
+	65,  // 130: protobuf.Profile.AddLike:output_type -> protobuf.LikeAddResponse
+	67,  // 131: protobuf.Profile.UpdateLike:output_type -> protobuf.LikeUpdateResponse
+	69,  // 132: protobuf.Profile.GetLastLike:output_type -> protobuf.LikeGetLastResponse
+	72,  // 133: protobuf.Profile.GetLikeList:output_type -> protobuf.GetLikeListResponse
+	75,  // 134: protobuf.Profile.GetMatchList:output_type -> protobuf.GetMatchListResponse
+	77,  // 135: protobuf.Profile.Unmatch:output_type -> protobuf.UnmatchResponse
+	78,  // 136: protobuf.Profile.SendMessage:output_type -> protobuf.MessageResponse
+	82,  // 137: protobuf.Profile.GetConversationList:output_type -> protobuf.GetConversationListResponse
+	84,  // 138: protobuf.Profile.GetMessageList:output_type -> protobuf.GetMessageListResponse
+	86,  // 139: protobuf.Profile.MarkRead:output_type -> protobuf.MarkReadResponse
+	88,  // 140: protobuf.Profile.AddComplaint:output_type -> protobuf.ComplaintAddResponse
+	90,  // 141: protobuf.Profile.AddComplaintAppeal:output_type -> protobuf.ComplaintAppealAddResponse
+	9,   // 142: protobuf.Profile.GetStatusByTelegramUserId:output_type -> protobuf.StatusResponse
+	97,  // 143: protobuf.Profile.UpdateCoordinates:output_type -> protobuf.NavigatorUpdateResponse
+	93,  // 144: protobuf.Profile.AddPayment:output_type -> protobuf.PaymentAddResponse
+	95,  // 145: protobuf.Profile.CheckPremium:output_type -> protobuf.CheckPremiumResponse
+	99,  // 146: protobuf.Profile.UpdateSettings:output_type -> protobuf.UpdateSettingsResponse
+	101, // 147: protobuf.Profile.AddRefreshToken:output_type -> protobuf.RefreshTokenAddResponse
+	103, // 148: protobuf.Profile.RotateRefreshToken:output_type -> protobuf.RefreshTokenRotateResponse
+	105, // 149: protobuf.Profile.RevokeRefreshToken:output_type -> protobuf.RefreshTokenRevokeResponse
+	108, // 150: protobuf.Admin.GetComplaintList:output_type -> protobuf.AdminComplaintListResponse
+	111, // 151: protobuf.Admin.GetProfile:output_type -> protobuf.AdminProfileResponse
+	115, // 152: protobuf.Admin.BlockProfile:output_type -> protobuf.AdminActionResponse
+	115, // 153: protobuf.Admin.UnblockProfile:output_type -> protobuf.AdminActionResponse
+	115, // 154: protobuf.Admin.BlockImage:output_type -> protobuf.AdminActionResponse
+	115, // 155: protobuf.Admin.UnblockImage:output_type -> protobuf.AdminActionResponse
+	115, // 156: protobuf.Admin.ReviewComplaints:output_type -> protobuf.AdminActionResponse
+	118, // 157: protobuf.Admin.GetAuditList:output_type -> protobuf.AdminAuditListResponse
+	103, // [103:158] is the sub-list for method output_type
+	48,  // [48:103] is the sub-list for method input_type
+	48,  // [48:48] is the sub-list for extension type_name
+	48,  // [48:48] is the sub-list for extension extendee
+	0,   // [0:48] is the sub-list for field type_name
+}
+
+func init() { file_contracts_proto_profiles_profile_proto_init() }
+func file_contracts_proto_profiles_profile_proto_init() {
+	if File_contracts_proto_profiles_profile_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_contracts_proto_profiles_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageVariant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NavigatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NavigatorDetailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileFreezeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileFreezeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileRestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileRestoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileGetDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileDetailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileGetShortInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileShortInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileGetListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileListItemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckProfileExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckProfileExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageByTelegramUserIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageByTelegramUserIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageLastByTelegramUserIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageUploadInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageSetPrimaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageSetPrimaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageReorderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageReorderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5460,8 +10134,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageStatusResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageUpdatePrivacyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5472,8 +10146,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageUpdatePrivacyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5484,8 +10158,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Point); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageGrantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5496,8 +10170,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavigatorResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageGrantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5508,8 +10182,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavigatorDetailResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageGrantListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5520,8 +10194,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageGrantItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5532,8 +10206,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TelegramResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageGrantListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5544,8 +10218,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5556,8 +10230,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SettingsResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5568,8 +10242,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5580,8 +10254,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5592,8 +10266,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeEntity); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5604,8 +10278,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileAddRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockedListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5616,8 +10290,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileAddResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockedListItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5628,8 +10302,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileUpdateRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockedListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5640,8 +10314,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileFreezeRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5652,8 +10326,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileFreezeResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5664,8 +10338,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileRestoreRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5676,8 +10350,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileRestoreResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5688,8 +10362,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileDeleteRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5700,8 +10374,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileDeleteResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5712,8 +10386,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileGetRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeGetLastRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5724,8 +10398,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeGetLastResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5736,8 +10410,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileGetDetailRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLikeListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5748,8 +10422,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileDetailResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikeListItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5760,8 +10434,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileGetShortInfoRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLikeListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5772,8 +10446,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileShortInfoResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5784,8 +10458,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileGetListRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchListItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5796,8 +10470,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileListItemResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMatchListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5808,8 +10482,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileListResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5820,8 +10494,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckProfileExistsRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5832,8 +10506,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckProfileExistsResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5844,8 +10518,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageByTelegramUserIdRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5856,8 +10530,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageByTelegramUserIdResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5868,8 +10542,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageLastByTelegramUserIdRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversationListItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5880,8 +10554,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageByIdRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConversationListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5892,8 +10566,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageDeleteRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5904,8 +10578,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImageDeleteResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMessageListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5916,8 +10590,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterGetRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5928,8 +10602,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterUpdateRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5940,8 +10614,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TelegramGetRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplaintAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5952,8 +10626,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockAddRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplaintAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5964,8 +10638,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockAddResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplaintAppealAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5976,8 +10650,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockedListRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplaintAppealAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5988,8 +10662,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockedListItemResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusByTelegramUserIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6000,8 +10674,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockedListResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6012,8 +10686,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnblockRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6024,8 +10698,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnblockResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPremiumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6036,8 +10710,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeAddRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPremiumResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6048,8 +10722,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeAddResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NavigatorUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6060,8 +10734,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeUpdateRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NavigatorUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6072,8 +10746,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeUpdateResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6084,8 +10758,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeGetLastRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6096,8 +10770,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikeGetLastResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6108,8 +10782,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComplaintAddRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6120,8 +10794,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ComplaintAddResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRotateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6132,8 +10806,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusByTelegramUserIdRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRotateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6144,8 +10818,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentAddRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRevokeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6156,8 +10830,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentAddResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRevokeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6168,8 +10842,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckPremiumRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminComplaintListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6180,8 +10854,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckPremiumResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminComplaintListItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6192,8 +10866,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavigatorUpdateRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminComplaintListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6204,8 +10878,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NavigatorUpdateResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProfileGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6216,8 +10890,8 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSettingsRequest); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminImageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6228,8 +10902,92 @@ func file_contracts_proto_profiles_profile_proto_init() {
 				return nil
 			}
 		}
-		file_contracts_proto_profiles_profile_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSettingsResponse); i {
+		file_contracts_proto_profiles_profile_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminProfileBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminImageBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminComplaintReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditListItemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_contracts_proto_profiles_profile_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAuditListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6241,23 +10999,30 @@ func file_contracts_proto_profiles_profile_proto_init() {
 			}
 		}
 	}
-	file_contracts_proto_profiles_profile_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_contracts_proto_profiles_profile_proto_msgTypes[13].OneofWrappers = []interface{}{}
-	file_contracts_proto_profiles_profile_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_contracts_proto_profiles_profile_proto_msgTypes[22].OneofWrappers = []interface{}{}
-	file_contracts_proto_profiles_profile_proto_msgTypes[24].OneofWrappers = []interface{}{}
-	file_contracts_proto_profiles_profile_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[25].OneofWrappers = []interface{}{}
 	file_contracts_proto_profiles_profile_proto_msgTypes[29].OneofWrappers = []interface{}{}
-	file_contracts_proto_profiles_profile_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*ImageUploadRequest_Info)(nil),
+		(*ImageUploadRequest_Chunk)(nil),
+	}
+	file_contracts_proto_profiles_profile_proto_msgTypes[96].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[106].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[116].OneofWrappers = []interface{}{}
+	file_contracts_proto_profiles_profile_proto_msgTypes[117].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_contracts_proto_profiles_profile_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   66,
+			NumMessages:   119,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_contracts_proto_profiles_profile_proto_goTypes,
 		DependencyIndexes: file_contracts_proto_profiles_profile_proto_depIdxs,
@@ -33,6 +33,13 @@ const (
 	Profile_GetImageLastByTelegramUserId_FullMethodName = "/protobuf.Profile/GetImageLastByTelegramUserId"
 	Profile_GetImageById_FullMethodName                 = "/protobuf.Profile/GetImageById"
 	Profile_DeleteImage_FullMethodName                  = "/protobuf.Profile/DeleteImage"
+	Profile_UploadImage_FullMethodName                  = "/protobuf.Profile/UploadImage"
+	Profile_SetPrimaryImage_FullMethodName              = "/protobuf.Profile/SetPrimaryImage"
+	Profile_ReorderImages_FullMethodName                = "/protobuf.Profile/ReorderImages"
+	Profile_UpdateImagePrivacy_FullMethodName           = "/protobuf.Profile/UpdateImagePrivacy"
+	Profile_AddImageGrant_FullMethodName                = "/protobuf.Profile/AddImageGrant"
+	Profile_DeleteImageGrant_FullMethodName             = "/protobuf.Profile/DeleteImageGrant"
+	Profile_GetImageGrantList_FullMethodName            = "/protobuf.Profile/GetImageGrantList"
 	Profile_GetFilter_FullMethodName                    = "/protobuf.Profile/GetFilter"
 	Profile_UpdateFilter_FullMethodName                 = "/protobuf.Profile/UpdateFilter"
 	Profile_GetTelegram_FullMethodName                  = "/protobuf.Profile/GetTelegram"
@@ -42,12 +49,23 @@ const (
 	Profile_AddLike_FullMethodName                      = "/protobuf.Profile/AddLike"
 	Profile_UpdateLike_FullMethodName                   = "/protobuf.Profile/UpdateLike"
 	Profile_GetLastLike_FullMethodName                  = "/protobuf.Profile/GetLastLike"
+	Profile_GetLikeList_FullMethodName                  = "/protobuf.Profile/GetLikeList"
+	Profile_GetMatchList_FullMethodName                 = "/protobuf.Profile/GetMatchList"
+	Profile_Unmatch_FullMethodName                      = "/protobuf.Profile/Unmatch"
+	Profile_SendMessage_FullMethodName                  = "/protobuf.Profile/SendMessage"
+	Profile_GetConversationList_FullMethodName          = "/protobuf.Profile/GetConversationList"
+	Profile_GetMessageList_FullMethodName               = "/protobuf.Profile/GetMessageList"
+	Profile_MarkRead_FullMethodName                     = "/protobuf.Profile/MarkRead"
 	Profile_AddComplaint_FullMethodName                 = "/protobuf.Profile/AddComplaint"
+	Profile_AddComplaintAppeal_FullMethodName           = "/protobuf.Profile/AddComplaintAppeal"
 	Profile_GetStatusByTelegramUserId_FullMethodName    = "/protobuf.Profile/GetStatusByTelegramUserId"
 	Profile_UpdateCoordinates_FullMethodName            = "/protobuf.Profile/UpdateCoordinates"
 	Profile_AddPayment_FullMethodName                   = "/protobuf.Profile/AddPayment"
 	Profile_CheckPremium_FullMethodName                 = "/protobuf.Profile/CheckPremium"
 	Profile_UpdateSettings_FullMethodName               = "/protobuf.Profile/UpdateSettings"
+	Profile_AddRefreshToken_FullMethodName              = "/protobuf.Profile/AddRefreshToken"
+	Profile_RotateRefreshToken_FullMethodName           = "/protobuf.Profile/RotateRefreshToken"
+	Profile_RevokeRefreshToken_FullMethodName           = "/protobuf.Profile/RevokeRefreshToken"
 )
 
 // ProfileClient is the client API for Profile service.
@@ -68,6 +86,13 @@ type ProfileClient interface {
 	GetImageLastByTelegramUserId(ctx context.Context, in *GetImageLastByTelegramUserIdRequest, opts ...grpc.CallOption) (*ImageResponse, error)
 	GetImageById(ctx context.Context, in *GetImageByIdRequest, opts ...grpc.CallOption) (*ImageResponse, error)
 	DeleteImage(ctx context.Context, in *ImageDeleteRequest, opts ...grpc.CallOption) (*ImageDeleteResponse, error)
+	UploadImage(ctx context.Context, opts ...grpc.CallOption) (Profile_UploadImageClient, error)
+	SetPrimaryImage(ctx context.Context, in *ImageSetPrimaryRequest, opts ...grpc.CallOption) (*ImageSetPrimaryResponse, error)
+	ReorderImages(ctx context.Context, in *ImageReorderRequest, opts ...grpc.CallOption) (*ImageReorderResponse, error)
+	UpdateImagePrivacy(ctx context.Context, in *ImageUpdatePrivacyRequest, opts ...grpc.CallOption) (*ImageUpdatePrivacyResponse, error)
+	AddImageGrant(ctx context.Context, in *ImageGrantRequest, opts ...grpc.CallOption) (*ImageGrantResponse, error)
+	DeleteImageGrant(ctx context.Context, in *ImageGrantRequest, opts ...grpc.CallOption) (*ImageGrantResponse, error)
+	GetImageGrantList(ctx context.Context, in *ImageGrantListRequest, opts ...grpc.CallOption) (*ImageGrantListResponse, error)
 	GetFilter(ctx context.Context, in *FilterGetRequest, opts ...grpc.CallOption) (*FilterResponse, error)
 	UpdateFilter(ctx context.Context, in *FilterUpdateRequest, opts ...grpc.CallOption) (*FilterResponse, error)
 	GetTelegram(ctx context.Context, in *TelegramGetRequest, opts ...grpc.CallOption) (*TelegramResponse, error)
@@ -77,12 +102,23 @@ type ProfileClient interface {
 	AddLike(ctx context.Context, in *LikeAddRequest, opts ...grpc.CallOption) (*LikeAddResponse, error)
 	UpdateLike(ctx context.Context, in *LikeUpdateRequest, opts ...grpc.CallOption) (*LikeUpdateResponse, error)
 	GetLastLike(ctx context.Context, in *LikeGetLastRequest, opts ...grpc.CallOption) (*LikeGetLastResponse, error)
+	GetLikeList(ctx context.Context, in *GetLikeListRequest, opts ...grpc.CallOption) (*GetLikeListResponse, error)
+	GetMatchList(ctx context.Context, in *GetMatchListRequest, opts ...grpc.CallOption) (*GetMatchListResponse, error)
+	Unmatch(ctx context.Context, in *UnmatchRequest, opts ...grpc.CallOption) (*UnmatchResponse, error)
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	GetConversationList(ctx context.Context, in *GetConversationListRequest, opts ...grpc.CallOption) (*GetConversationListResponse, error)
+	GetMessageList(ctx context.Context, in *GetMessageListRequest, opts ...grpc.CallOption) (*GetMessageListResponse, error)
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
 	AddComplaint(ctx context.Context, in *ComplaintAddRequest, opts ...grpc.CallOption) (*ComplaintAddResponse, error)
+	AddComplaintAppeal(ctx context.Context, in *ComplaintAppealAddRequest, opts ...grpc.CallOption) (*ComplaintAppealAddResponse, error)
 	GetStatusByTelegramUserId(ctx context.Context, in *GetStatusByTelegramUserIdRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	UpdateCoordinates(ctx context.Context, in *NavigatorUpdateRequest, opts ...grpc.CallOption) (*NavigatorUpdateResponse, error)
 	AddPayment(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*PaymentAddResponse, error)
 	CheckPremium(ctx context.Context, in *CheckPremiumRequest, opts ...grpc.CallOption) (*CheckPremiumResponse, error)
 	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*UpdateSettingsResponse, error)
+	AddRefreshToken(ctx context.Context, in *RefreshTokenAddRequest, opts ...grpc.CallOption) (*RefreshTokenAddResponse, error)
+	RotateRefreshToken(ctx context.Context, in *RefreshTokenRotateRequest, opts ...grpc.CallOption) (*RefreshTokenRotateResponse, error)
+	RevokeRefreshToken(ctx context.Context, in *RefreshTokenRevokeRequest, opts ...grpc.CallOption) (*RefreshTokenRevokeResponse, error)
 }
 
 type profileClient struct {
@@ -219,6 +255,94 @@ func (c *profileClient) DeleteImage(ctx context.Context, in *ImageDeleteRequest,
 	return out, nil
 }
 
+func (c *profileClient) UploadImage(ctx context.Context, opts ...grpc.CallOption) (Profile_UploadImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Profile_ServiceDesc.Streams[0], Profile_UploadImage_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileUploadImageClient{stream}
+	return x, nil
+}
+
+type Profile_UploadImageClient interface {
+	Send(*ImageUploadRequest) error
+	CloseAndRecv() (*ImageUploadResponse, error)
+	grpc.ClientStream
+}
+
+type profileUploadImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileUploadImageClient) Send(m *ImageUploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *profileUploadImageClient) CloseAndRecv() (*ImageUploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImageUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *profileClient) SetPrimaryImage(ctx context.Context, in *ImageSetPrimaryRequest, opts ...grpc.CallOption) (*ImageSetPrimaryResponse, error) {
+	out := new(ImageSetPrimaryResponse)
+	err := c.cc.Invoke(ctx, Profile_SetPrimaryImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) ReorderImages(ctx context.Context, in *ImageReorderRequest, opts ...grpc.CallOption) (*ImageReorderResponse, error) {
+	out := new(ImageReorderResponse)
+	err := c.cc.Invoke(ctx, Profile_ReorderImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) UpdateImagePrivacy(ctx context.Context, in *ImageUpdatePrivacyRequest, opts ...grpc.CallOption) (*ImageUpdatePrivacyResponse, error) {
+	out := new(ImageUpdatePrivacyResponse)
+	err := c.cc.Invoke(ctx, Profile_UpdateImagePrivacy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) AddImageGrant(ctx context.Context, in *ImageGrantRequest, opts ...grpc.CallOption) (*ImageGrantResponse, error) {
+	out := new(ImageGrantResponse)
+	err := c.cc.Invoke(ctx, Profile_AddImageGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) DeleteImageGrant(ctx context.Context, in *ImageGrantRequest, opts ...grpc.CallOption) (*ImageGrantResponse, error) {
+	out := new(ImageGrantResponse)
+	err := c.cc.Invoke(ctx, Profile_DeleteImageGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetImageGrantList(ctx context.Context, in *ImageGrantListRequest, opts ...grpc.CallOption) (*ImageGrantListResponse, error) {
+	out := new(ImageGrantListResponse)
+	err := c.cc.Invoke(ctx, Profile_GetImageGrantList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileClient) GetFilter(ctx context.Context, in *FilterGetRequest, opts ...grpc.CallOption) (*FilterResponse, error) {
 	out := new(FilterResponse)
 	err := c.cc.Invoke(ctx, Profile_GetFilter_FullMethodName, in, out, opts...)
@@ -300,6 +424,69 @@ func (c *profileClient) GetLastLike(ctx context.Context, in *LikeGetLastRequest,
 	return out, nil
 }
 
+func (c *profileClient) GetLikeList(ctx context.Context, in *GetLikeListRequest, opts ...grpc.CallOption) (*GetLikeListResponse, error) {
+	out := new(GetLikeListResponse)
+	err := c.cc.Invoke(ctx, Profile_GetLikeList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetMatchList(ctx context.Context, in *GetMatchListRequest, opts ...grpc.CallOption) (*GetMatchListResponse, error) {
+	out := new(GetMatchListResponse)
+	err := c.cc.Invoke(ctx, Profile_GetMatchList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) Unmatch(ctx context.Context, in *UnmatchRequest, opts ...grpc.CallOption) (*UnmatchResponse, error) {
+	out := new(UnmatchResponse)
+	err := c.cc.Invoke(ctx, Profile_Unmatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, Profile_SendMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetConversationList(ctx context.Context, in *GetConversationListRequest, opts ...grpc.CallOption) (*GetConversationListResponse, error) {
+	out := new(GetConversationListResponse)
+	err := c.cc.Invoke(ctx, Profile_GetConversationList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetMessageList(ctx context.Context, in *GetMessageListRequest, opts ...grpc.CallOption) (*GetMessageListResponse, error) {
+	out := new(GetMessageListResponse)
+	err := c.cc.Invoke(ctx, Profile_GetMessageList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error) {
+	out := new(MarkReadResponse)
+	err := c.cc.Invoke(ctx, Profile_MarkRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileClient) AddComplaint(ctx context.Context, in *ComplaintAddRequest, opts ...grpc.CallOption) (*ComplaintAddResponse, error) {
 	out := new(ComplaintAddResponse)
 	err := c.cc.Invoke(ctx, Profile_AddComplaint_FullMethodName, in, out, opts...)
@@ -309,6 +496,15 @@ func (c *profileClient) AddComplaint(ctx context.Context, in *ComplaintAddReques
 	return out, nil
 }
 
+func (c *profileClient) AddComplaintAppeal(ctx context.Context, in *ComplaintAppealAddRequest, opts ...grpc.CallOption) (*ComplaintAppealAddResponse, error) {
+	out := new(ComplaintAppealAddResponse)
+	err := c.cc.Invoke(ctx, Profile_AddComplaintAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profileClient) GetStatusByTelegramUserId(ctx context.Context, in *GetStatusByTelegramUserIdRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
 	err := c.cc.Invoke(ctx, Profile_GetStatusByTelegramUserId_FullMethodName, in, out, opts...)
@@ -354,6 +550,33 @@ func (c *profileClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRe
 	return out, nil
 }
 
+func (c *profileClient) AddRefreshToken(ctx context.Context, in *RefreshTokenAddRequest, opts ...grpc.CallOption) (*RefreshTokenAddResponse, error) {
+	out := new(RefreshTokenAddResponse)
+	err := c.cc.Invoke(ctx, Profile_AddRefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) RotateRefreshToken(ctx context.Context, in *RefreshTokenRotateRequest, opts ...grpc.CallOption) (*RefreshTokenRotateResponse, error) {
+	out := new(RefreshTokenRotateResponse)
+	err := c.cc.Invoke(ctx, Profile_RotateRefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) RevokeRefreshToken(ctx context.Context, in *RefreshTokenRevokeRequest, opts ...grpc.CallOption) (*RefreshTokenRevokeResponse, error) {
+	out := new(RefreshTokenRevokeResponse)
+	err := c.cc.Invoke(ctx, Profile_RevokeRefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServer is the server API for Profile service.
 // All implementations must embed UnimplementedProfileServer
 // for forward compatibility
@@ -372,6 +595,13 @@ type ProfileServer interface {
 	GetImageLastByTelegramUserId(context.Context, *GetImageLastByTelegramUserIdRequest) (*ImageResponse, error)
 	GetImageById(context.Context, *GetImageByIdRequest) (*ImageResponse, error)
 	DeleteImage(context.Context, *ImageDeleteRequest) (*ImageDeleteResponse, error)
+	UploadImage(Profile_UploadImageServer) error
+	SetPrimaryImage(context.Context, *ImageSetPrimaryRequest) (*ImageSetPrimaryResponse, error)
+	ReorderImages(context.Context, *ImageReorderRequest) (*ImageReorderResponse, error)
+	UpdateImagePrivacy(context.Context, *ImageUpdatePrivacyRequest) (*ImageUpdatePrivacyResponse, error)
+	AddImageGrant(context.Context, *ImageGrantRequest) (*ImageGrantResponse, error)
+	DeleteImageGrant(context.Context, *ImageGrantRequest) (*ImageGrantResponse, error)
+	GetImageGrantList(context.Context, *ImageGrantListRequest) (*ImageGrantListResponse, error)
 	GetFilter(context.Context, *FilterGetRequest) (*FilterResponse, error)
 	UpdateFilter(context.Context, *FilterUpdateRequest) (*FilterResponse, error)
 	GetTelegram(context.Context, *TelegramGetRequest) (*TelegramResponse, error)
@@ -381,12 +611,23 @@ type ProfileServer interface {
 	AddLike(context.Context, *LikeAddRequest) (*LikeAddResponse, error)
 	UpdateLike(context.Context, *LikeUpdateRequest) (*LikeUpdateResponse, error)
 	GetLastLike(context.Context, *LikeGetLastRequest) (*LikeGetLastResponse, error)
+	GetLikeList(context.Context, *GetLikeListRequest) (*GetLikeListResponse, error)
+	GetMatchList(context.Context, *GetMatchListRequest) (*GetMatchListResponse, error)
+	Unmatch(context.Context, *UnmatchRequest) (*UnmatchResponse, error)
+	SendMessage(context.Context, *SendMessageRequest) (*MessageResponse, error)
+	GetConversationList(context.Context, *GetConversationListRequest) (*GetConversationListResponse, error)
+	GetMessageList(context.Context, *GetMessageListRequest) (*GetMessageListResponse, error)
+	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
 	AddComplaint(context.Context, *ComplaintAddRequest) (*ComplaintAddResponse, error)
+	AddComplaintAppeal(context.Context, *ComplaintAppealAddRequest) (*ComplaintAppealAddResponse, error)
 	GetStatusByTelegramUserId(context.Context, *GetStatusByTelegramUserIdRequest) (*StatusResponse, error)
 	UpdateCoordinates(context.Context, *NavigatorUpdateRequest) (*NavigatorUpdateResponse, error)
 	AddPayment(context.Context, *PaymentAddRequest) (*PaymentAddResponse, error)
 	CheckPremium(context.Context, *CheckPremiumRequest) (*CheckPremiumResponse, error)
 	UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error)
+	AddRefreshToken(context.Context, *RefreshTokenAddRequest) (*RefreshTokenAddResponse, error)
+	RotateRefreshToken(context.Context, *RefreshTokenRotateRequest) (*RefreshTokenRotateResponse, error)
+	RevokeRefreshToken(context.Context, *RefreshTokenRevokeRequest) (*RefreshTokenRevokeResponse, error)
 	mustEmbedUnimplementedProfileServer()
 }
 
@@ -436,6 +677,27 @@ func (UnimplementedProfileServer) GetImageById(context.Context, *GetImageByIdReq
 func (UnimplementedProfileServer) DeleteImage(context.Context, *ImageDeleteRequest) (*ImageDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
 }
+func (UnimplementedProfileServer) UploadImage(Profile_UploadImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedProfileServer) SetPrimaryImage(context.Context, *ImageSetPrimaryRequest) (*ImageSetPrimaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryImage not implemented")
+}
+func (UnimplementedProfileServer) ReorderImages(context.Context, *ImageReorderRequest) (*ImageReorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderImages not implemented")
+}
+func (UnimplementedProfileServer) UpdateImagePrivacy(context.Context, *ImageUpdatePrivacyRequest) (*ImageUpdatePrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateImagePrivacy not implemented")
+}
+func (UnimplementedProfileServer) AddImageGrant(context.Context, *ImageGrantRequest) (*ImageGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddImageGrant not implemented")
+}
+func (UnimplementedProfileServer) DeleteImageGrant(context.Context, *ImageGrantRequest) (*ImageGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImageGrant not implemented")
+}
+func (UnimplementedProfileServer) GetImageGrantList(context.Context, *ImageGrantListRequest) (*ImageGrantListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageGrantList not implemented")
+}
 func (UnimplementedProfileServer) GetFilter(context.Context, *FilterGetRequest) (*FilterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFilter not implemented")
 }
@@ -463,9 +725,33 @@ func (UnimplementedProfileServer) UpdateLike(context.Context, *LikeUpdateRequest
 func (UnimplementedProfileServer) GetLastLike(context.Context, *LikeGetLastRequest) (*LikeGetLastResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLastLike not implemented")
 }
+func (UnimplementedProfileServer) GetLikeList(context.Context, *GetLikeListRequest) (*GetLikeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikeList not implemented")
+}
+func (UnimplementedProfileServer) GetMatchList(context.Context, *GetMatchListRequest) (*GetMatchListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchList not implemented")
+}
+func (UnimplementedProfileServer) Unmatch(context.Context, *UnmatchRequest) (*UnmatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmatch not implemented")
+}
+func (UnimplementedProfileServer) SendMessage(context.Context, *SendMessageRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedProfileServer) GetConversationList(context.Context, *GetConversationListRequest) (*GetConversationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationList not implemented")
+}
+func (UnimplementedProfileServer) GetMessageList(context.Context, *GetMessageListRequest) (*GetMessageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageList not implemented")
+}
+func (UnimplementedProfileServer) MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
 func (UnimplementedProfileServer) AddComplaint(context.Context, *ComplaintAddRequest) (*ComplaintAddResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComplaint not implemented")
 }
+func (UnimplementedProfileServer) AddComplaintAppeal(context.Context, *ComplaintAppealAddRequest) (*ComplaintAppealAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComplaintAppeal not implemented")
+}
 func (UnimplementedProfileServer) GetStatusByTelegramUserId(context.Context, *GetStatusByTelegramUserIdRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatusByTelegramUserId not implemented")
 }
@@ -481,6 +767,15 @@ func (UnimplementedProfileServer) CheckPremium(context.Context, *CheckPremiumReq
 func (UnimplementedProfileServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*UpdateSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
 }
+func (UnimplementedProfileServer) AddRefreshToken(context.Context, *RefreshTokenAddRequest) (*RefreshTokenAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRefreshToken not implemented")
+}
+func (UnimplementedProfileServer) RotateRefreshToken(context.Context, *RefreshTokenRotateRequest) (*RefreshTokenRotateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRefreshToken not implemented")
+}
+func (UnimplementedProfileServer) RevokeRefreshToken(context.Context, *RefreshTokenRevokeRequest) (*RefreshTokenRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRefreshToken not implemented")
+}
 func (UnimplementedProfileServer) mustEmbedUnimplementedProfileServer() {}
 
 // UnsafeProfileServer may be embedded to opt out of forward compatibility for this service.
@@ -746,6 +1041,140 @@ func _Profile_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Profile_UploadImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProfileServer).UploadImage(&profileUploadImageServer{stream})
+}
+
+type Profile_UploadImageServer interface {
+	SendAndClose(*ImageUploadResponse) error
+	Recv() (*ImageUploadRequest, error)
+	grpc.ServerStream
+}
+
+type profileUploadImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileUploadImageServer) SendAndClose(m *ImageUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *profileUploadImageServer) Recv() (*ImageUploadRequest, error) {
+	m := new(ImageUploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Profile_SetPrimaryImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageSetPrimaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).SetPrimaryImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_SetPrimaryImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).SetPrimaryImage(ctx, req.(*ImageSetPrimaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_ReorderImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageReorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ReorderImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_ReorderImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ReorderImages(ctx, req.(*ImageReorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UpdateImagePrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageUpdatePrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UpdateImagePrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UpdateImagePrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UpdateImagePrivacy(ctx, req.(*ImageUpdatePrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddImageGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddImageGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_AddImageGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddImageGrant(ctx, req.(*ImageGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DeleteImageGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DeleteImageGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_DeleteImageGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DeleteImageGrant(ctx, req.(*ImageGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetImageGrantList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageGrantListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetImageGrantList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_GetImageGrantList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetImageGrantList(ctx, req.(*ImageGrantListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Profile_GetFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FilterGetRequest)
 	if err := dec(in); err != nil {
@@ -908,107 +1337,251 @@ func _Profile_GetLastLike_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AddComplaint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ComplaintAddRequest)
+func _Profile_GetLikeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikeListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AddComplaint(ctx, in)
+		return srv.(ProfileServer).GetLikeList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Profile_AddComplaint_FullMethodName,
+		FullMethod: Profile_GetLikeList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AddComplaint(ctx, req.(*ComplaintAddRequest))
+		return srv.(ProfileServer).GetLikeList(ctx, req.(*GetLikeListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_GetStatusByTelegramUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStatusByTelegramUserIdRequest)
+func _Profile_GetMatchList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).GetStatusByTelegramUserId(ctx, in)
+		return srv.(ProfileServer).GetMatchList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Profile_GetStatusByTelegramUserId_FullMethodName,
+		FullMethod: Profile_GetMatchList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).GetStatusByTelegramUserId(ctx, req.(*GetStatusByTelegramUserIdRequest))
+		return srv.(ProfileServer).GetMatchList(ctx, req.(*GetMatchListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_UpdateCoordinates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NavigatorUpdateRequest)
+func _Profile_Unmatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).UpdateCoordinates(ctx, in)
+		return srv.(ProfileServer).Unmatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Profile_UpdateCoordinates_FullMethodName,
+		FullMethod: Profile_Unmatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).UpdateCoordinates(ctx, req.(*NavigatorUpdateRequest))
+		return srv.(ProfileServer).Unmatch(ctx, req.(*UnmatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_AddPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentAddRequest)
+func _Profile_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).AddPayment(ctx, in)
+		return srv.(ProfileServer).SendMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Profile_AddPayment_FullMethodName,
+		FullMethod: Profile_SendMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).AddPayment(ctx, req.(*PaymentAddRequest))
+		return srv.(ProfileServer).SendMessage(ctx, req.(*SendMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_CheckPremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckPremiumRequest)
+func _Profile_GetConversationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).CheckPremium(ctx, in)
+		return srv.(ProfileServer).GetConversationList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Profile_CheckPremium_FullMethodName,
+		FullMethod: Profile_GetConversationList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfileServer).CheckPremium(ctx, req.(*CheckPremiumRequest))
+		return srv.(ProfileServer).GetConversationList(ctx, req.(*GetConversationListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Profile_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSettingsRequest)
+func _Profile_GetMessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfileServer).UpdateSettings(ctx, in)
+		return srv.(ProfileServer).GetMessageList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Profile_UpdateSettings_FullMethodName,
+		FullMethod: Profile_GetMessageList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetMessageList(ctx, req.(*GetMessageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddComplaint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplaintAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddComplaint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_AddComplaint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddComplaint(ctx, req.(*ComplaintAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddComplaintAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplaintAppealAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddComplaintAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_AddComplaintAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddComplaintAppeal(ctx, req.(*ComplaintAppealAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetStatusByTelegramUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusByTelegramUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).GetStatusByTelegramUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_GetStatusByTelegramUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).GetStatusByTelegramUserId(ctx, req.(*GetStatusByTelegramUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UpdateCoordinates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NavigatorUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UpdateCoordinates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UpdateCoordinates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UpdateCoordinates(ctx, req.(*NavigatorUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_AddPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_AddPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddPayment(ctx, req.(*PaymentAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_CheckPremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPremiumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).CheckPremium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_CheckPremium_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).CheckPremium(ctx, req.(*CheckPremiumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UpdateSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ProfileServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
@@ -1016,6 +1589,60 @@ func _Profile_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Profile_AddRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).AddRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_AddRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).AddRefreshToken(ctx, req.(*RefreshTokenAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_RotateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).RotateRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_RotateRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).RotateRefreshToken(ctx, req.(*RefreshTokenRotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_RevokeRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).RevokeRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_RevokeRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).RevokeRefreshToken(ctx, req.(*RefreshTokenRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Profile_ServiceDesc is the grpc.ServiceDesc for Profile service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1079,6 +1706,30 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteImage",
 			Handler:    _Profile_DeleteImage_Handler,
 		},
+		{
+			MethodName: "SetPrimaryImage",
+			Handler:    _Profile_SetPrimaryImage_Handler,
+		},
+		{
+			MethodName: "ReorderImages",
+			Handler:    _Profile_ReorderImages_Handler,
+		},
+		{
+			MethodName: "UpdateImagePrivacy",
+			Handler:    _Profile_UpdateImagePrivacy_Handler,
+		},
+		{
+			MethodName: "AddImageGrant",
+			Handler:    _Profile_AddImageGrant_Handler,
+		},
+		{
+			MethodName: "DeleteImageGrant",
+			Handler:    _Profile_DeleteImageGrant_Handler,
+		},
+		{
+			MethodName: "GetImageGrantList",
+			Handler:    _Profile_GetImageGrantList_Handler,
+		},
 		{
 			MethodName: "GetFilter",
 			Handler:    _Profile_GetFilter_Handler,
@@ -1115,10 +1766,42 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLastLike",
 			Handler:    _Profile_GetLastLike_Handler,
 		},
+		{
+			MethodName: "GetLikeList",
+			Handler:    _Profile_GetLikeList_Handler,
+		},
+		{
+			MethodName: "GetMatchList",
+			Handler:    _Profile_GetMatchList_Handler,
+		},
+		{
+			MethodName: "Unmatch",
+			Handler:    _Profile_Unmatch_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _Profile_SendMessage_Handler,
+		},
+		{
+			MethodName: "GetConversationList",
+			Handler:    _Profile_GetConversationList_Handler,
+		},
+		{
+			MethodName: "GetMessageList",
+			Handler:    _Profile_GetMessageList_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _Profile_MarkRead_Handler,
+		},
 		{
 			MethodName: "AddComplaint",
 			Handler:    _Profile_AddComplaint_Handler,
 		},
+		{
+			MethodName: "AddComplaintAppeal",
+			Handler:    _Profile_AddComplaintAppeal_Handler,
+		},
 		{
 			MethodName: "GetStatusByTelegramUserId",
 			Handler:    _Profile_GetStatusByTelegramUserId_Handler,
@@ -1139,6 +1822,373 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSettings",
 			Handler:    _Profile_UpdateSettings_Handler,
 		},
+		{
+			MethodName: "AddRefreshToken",
+			Handler:    _Profile_AddRefreshToken_Handler,
+		},
+		{
+			MethodName: "RotateRefreshToken",
+			Handler:    _Profile_RotateRefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeRefreshToken",
+			Handler:    _Profile_RevokeRefreshToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadImage",
+			Handler:       _Profile_UploadImage_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "contracts/proto/profiles/profile.proto",
+}
+
+const (
+	Admin_GetComplaintList_FullMethodName = "/protobuf.Admin/GetComplaintList"
+	Admin_GetProfile_FullMethodName       = "/protobuf.Admin/GetProfile"
+	Admin_BlockProfile_FullMethodName     = "/protobuf.Admin/BlockProfile"
+	Admin_UnblockProfile_FullMethodName   = "/protobuf.Admin/UnblockProfile"
+	Admin_BlockImage_FullMethodName       = "/protobuf.Admin/BlockImage"
+	Admin_UnblockImage_FullMethodName     = "/protobuf.Admin/UnblockImage"
+	Admin_ReviewComplaints_FullMethodName = "/protobuf.Admin/ReviewComplaints"
+	Admin_GetAuditList_FullMethodName     = "/protobuf.Admin/GetAuditList"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	GetComplaintList(ctx context.Context, in *AdminComplaintListRequest, opts ...grpc.CallOption) (*AdminComplaintListResponse, error)
+	GetProfile(ctx context.Context, in *AdminProfileGetRequest, opts ...grpc.CallOption) (*AdminProfileResponse, error)
+	BlockProfile(ctx context.Context, in *AdminProfileBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error)
+	UnblockProfile(ctx context.Context, in *AdminProfileBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error)
+	BlockImage(ctx context.Context, in *AdminImageBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error)
+	UnblockImage(ctx context.Context, in *AdminImageBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error)
+	ReviewComplaints(ctx context.Context, in *AdminComplaintReviewRequest, opts ...grpc.CallOption) (*AdminActionResponse, error)
+	GetAuditList(ctx context.Context, in *AdminAuditListRequest, opts ...grpc.CallOption) (*AdminAuditListResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetComplaintList(ctx context.Context, in *AdminComplaintListRequest, opts ...grpc.CallOption) (*AdminComplaintListResponse, error) {
+	out := new(AdminComplaintListResponse)
+	err := c.cc.Invoke(ctx, Admin_GetComplaintList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetProfile(ctx context.Context, in *AdminProfileGetRequest, opts ...grpc.CallOption) (*AdminProfileResponse, error) {
+	out := new(AdminProfileResponse)
+	err := c.cc.Invoke(ctx, Admin_GetProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) BlockProfile(ctx context.Context, in *AdminProfileBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error) {
+	out := new(AdminActionResponse)
+	err := c.cc.Invoke(ctx, Admin_BlockProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UnblockProfile(ctx context.Context, in *AdminProfileBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error) {
+	out := new(AdminActionResponse)
+	err := c.cc.Invoke(ctx, Admin_UnblockProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) BlockImage(ctx context.Context, in *AdminImageBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error) {
+	out := new(AdminActionResponse)
+	err := c.cc.Invoke(ctx, Admin_BlockImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UnblockImage(ctx context.Context, in *AdminImageBlockRequest, opts ...grpc.CallOption) (*AdminActionResponse, error) {
+	out := new(AdminActionResponse)
+	err := c.cc.Invoke(ctx, Admin_UnblockImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReviewComplaints(ctx context.Context, in *AdminComplaintReviewRequest, opts ...grpc.CallOption) (*AdminActionResponse, error) {
+	out := new(AdminActionResponse)
+	err := c.cc.Invoke(ctx, Admin_ReviewComplaints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetAuditList(ctx context.Context, in *AdminAuditListRequest, opts ...grpc.CallOption) (*AdminAuditListResponse, error) {
+	out := new(AdminAuditListResponse)
+	err := c.cc.Invoke(ctx, Admin_GetAuditList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	GetComplaintList(context.Context, *AdminComplaintListRequest) (*AdminComplaintListResponse, error)
+	GetProfile(context.Context, *AdminProfileGetRequest) (*AdminProfileResponse, error)
+	BlockProfile(context.Context, *AdminProfileBlockRequest) (*AdminActionResponse, error)
+	UnblockProfile(context.Context, *AdminProfileBlockRequest) (*AdminActionResponse, error)
+	BlockImage(context.Context, *AdminImageBlockRequest) (*AdminActionResponse, error)
+	UnblockImage(context.Context, *AdminImageBlockRequest) (*AdminActionResponse, error)
+	ReviewComplaints(context.Context, *AdminComplaintReviewRequest) (*AdminActionResponse, error)
+	GetAuditList(context.Context, *AdminAuditListRequest) (*AdminAuditListResponse, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) GetComplaintList(context.Context, *AdminComplaintListRequest) (*AdminComplaintListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComplaintList not implemented")
+}
+func (UnimplementedAdminServer) GetProfile(context.Context, *AdminProfileGetRequest) (*AdminProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedAdminServer) BlockProfile(context.Context, *AdminProfileBlockRequest) (*AdminActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProfile not implemented")
+}
+func (UnimplementedAdminServer) UnblockProfile(context.Context, *AdminProfileBlockRequest) (*AdminActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockProfile not implemented")
+}
+func (UnimplementedAdminServer) BlockImage(context.Context, *AdminImageBlockRequest) (*AdminActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockImage not implemented")
+}
+func (UnimplementedAdminServer) UnblockImage(context.Context, *AdminImageBlockRequest) (*AdminActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockImage not implemented")
+}
+func (UnimplementedAdminServer) ReviewComplaints(context.Context, *AdminComplaintReviewRequest) (*AdminActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewComplaints not implemented")
+}
+func (UnimplementedAdminServer) GetAuditList(context.Context, *AdminAuditListRequest) (*AdminAuditListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditList not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_GetComplaintList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminComplaintListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetComplaintList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetComplaintList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetComplaintList(ctx, req.(*AdminComplaintListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProfileGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetProfile(ctx, req.(*AdminProfileGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_BlockProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProfileBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).BlockProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_BlockProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).BlockProfile(ctx, req.(*AdminProfileBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UnblockProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminProfileBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UnblockProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UnblockProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UnblockProfile(ctx, req.(*AdminProfileBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_BlockImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminImageBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).BlockImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_BlockImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).BlockImage(ctx, req.(*AdminImageBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UnblockImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminImageBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UnblockImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_UnblockImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UnblockImage(ctx, req.(*AdminImageBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReviewComplaints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminComplaintReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReviewComplaints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ReviewComplaints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReviewComplaints(ctx, req.(*AdminComplaintReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetAuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetAuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetAuditList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetAuditList(ctx, req.(*AdminAuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protobuf.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetComplaintList",
+			Handler:    _Admin_GetComplaintList_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _Admin_GetProfile_Handler,
+		},
+		{
+			MethodName: "BlockProfile",
+			Handler:    _Admin_BlockProfile_Handler,
+		},
+		{
+			MethodName: "UnblockProfile",
+			Handler:    _Admin_UnblockProfile_Handler,
+		},
+		{
+			MethodName: "BlockImage",
+			Handler:    _Admin_BlockImage_Handler,
+		},
+		{
+			MethodName: "UnblockImage",
+			Handler:    _Admin_UnblockImage_Handler,
+		},
+		{
+			MethodName: "ReviewComplaints",
+			Handler:    _Admin_ReviewComplaints_Handler,
+		},
+		{
+			MethodName: "GetAuditList",
+			Handler:    _Admin_GetAuditList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "contracts/proto/profiles/profile.proto",
@@ -2,9 +2,12 @@ package app
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/config"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/hub"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -13,6 +16,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"os"
 	"sync"
 	"time"
 )
@@ -26,7 +30,9 @@ type App struct {
 	Logger      logger.Logger
 	config      *config.Config
 	fiber       *fiber.App
-	kafkaWriter *kafka.Writer
+	hub         *hub.Hub
+	kafkaReader *kafka.Reader
+	tokenIssuer *auth.TokenIssuer
 }
 
 // New - create new application
@@ -66,15 +72,37 @@ func New() *App {
 	}))
 
 	// Kafka
-	w := &kafka.Writer{
-		Addr:         kafka.TCP(cfg.Kafka1, cfg.Kafka2, cfg.Kafka3),
-		Topic:        "like_topic",
-		Balancer:     &kafka.LeastBytes{},
-		BatchSize:    1048576,
-		BatchTimeout: 1000,
-		Compression:  kafka.Gzip,
-		RequiredAcks: kafka.RequireOne,
+	// Every gateway instance reads all the events for its own websocket connections,
+	// so each of them has its own consumer group
+	hostname, err := os.Hostname()
+	if err != nil {
+		errorMessage := getErrorMessage("New", "os.Hostname", errorFilePathApp)
+		defaultLogger.Fatal(errorMessage, zap.Error(err))
+	}
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     []string{cfg.Kafka1, cfg.Kafka2, cfg.Kafka3},
+		GroupID:     "gateway-hub-" + hostname,
+		Topic:       "like_topic",
+		StartOffset: kafka.LastOffset,
+	})
+
+	// Session tokens
+	var privateKey ed25519.PrivateKey
+	if cfg.JwtPrivateKey != "" {
+		privateKey, err = auth.ParsePrivateKey(cfg.JwtPrivateKey)
+		if err != nil {
+			errorMessage := getErrorMessage("New", "auth.ParsePrivateKey", errorFilePathApp)
+			defaultLogger.Fatal(errorMessage, zap.Error(err))
+		}
+	} else {
+		loggerLevel.Warn("JWT_PRIVATE_KEY is not set, the session tokens become invalid on restart")
+		privateKey, err = auth.GeneratePrivateKey()
+		if err != nil {
+			errorMessage := getErrorMessage("New", "auth.GeneratePrivateKey", errorFilePathApp)
+			defaultLogger.Fatal(errorMessage, zap.Error(err))
+		}
 	}
+	tokenIssuer := auth.NewTokenIssuer(privateKey, cfg.AccessTokenTtl, cfg.RefreshTokenTtl)
 
 	// CORS
 	f.Use(cors.New(cors.Config{
@@ -87,7 +115,9 @@ func New() *App {
 		config:      cfg,
 		Logger:      loggerLevel,
 		fiber:       f,
-		kafkaWriter: w,
+		hub:         hub.NewHub(loggerLevel),
+		kafkaReader: r,
+		tokenIssuer: tokenIssuer,
 	}
 }
 
@@ -101,12 +131,26 @@ func (app *App) Run(ctx context.Context) {
 	}
 	defer conn.Close()
 	c := pb.NewProfileClient(conn)
+	ac := pb.NewAdminClient(conn)
 	app.Logger.Info("Listening gRPC server on host: ", zap.String("host", addr))
 
 	var wg sync.WaitGroup
-	wg.Add(1)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if err := app.hub.Run(ctx, app.kafkaReader); err != nil {
+			errorMessage := getErrorMessage("Run", "hub.Run",
+				errorFilePathApp)
+			app.Logger.Error(errorMessage, zap.Error(err))
+		}
+		if err := app.kafkaReader.Close(); err != nil {
+			errorMessage := getErrorMessage("Run", "kafkaReader.Close",
+				errorFilePathApp)
+			app.Logger.Error(errorMessage, zap.Error(err))
+		}
+	}()
 	go func() {
-		if err := app.StartHTTPServer(ctx, c); err != nil {
+		if err := app.StartHTTPServer(ctx, c, ac); err != nil {
 			errorMessage := getErrorMessage("Run", "StartHTTPServer",
 				errorFilePathApp)
 			app.Logger.Fatal(errorMessage, zap.Error(err))
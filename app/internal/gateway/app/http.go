@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	proto "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/middlewares"
 	"go.uber.org/zap"
@@ -12,11 +13,18 @@ const (
 	errorFilePathHttp = "internal/app/gRPC.go"
 )
 
-func (app *App) StartHTTPServer(ctx context.Context, proto proto.ProfileClient) error {
+func (app *App) StartHTTPServer(
+	ctx context.Context, proto proto.ProfileClient, adminProto proto.AdminClient) error {
 	app.fiber.Static("/static", "./static")
-	profileController := controller.NewProfileController(app.Logger, app.kafkaWriter, proto)
+	initDataValidator := auth.NewInitDataValidator(app.config.TelegramBotToken, app.config.InitDataMaxAge)
+	authController := controller.NewAuthController(
+		app.Logger, proto, initDataValidator, app.tokenIssuer, app.config.CryptoSecretKey)
+	profileController := controller.NewProfileController(app.Logger, proto)
+	adminController := controller.NewAdminController(app.Logger, adminProto)
+	webSocketController := controller.NewWebSocketController(app.Logger, app.hub)
 	middlewares.InitFiberMiddlewares(
-		app.fiber, app.config, app.Logger, profileController, InitPublicRoutes, InitProtectedRoutes)
+		app.fiber, app.Logger, app.tokenIssuer, authController, profileController, adminController,
+		webSocketController, InitRoutes)
 	go func() {
 		app.Logger.Info("Starting Gateway service on host: ", zap.String("host", app.config.GatewayHost))
 		if err := app.fiber.Listen(app.config.GatewayHost); err != nil {
@@ -2,39 +2,85 @@ package app
 
 import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/middlewares"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
 	"github.com/gofiber/fiber/v2"
 )
 
 var prefix = "/api/v1"
 
-func InitPublicRoutes(app *fiber.App, profileController *controller.ProfileController) {
+// InitRoutes - every route declares its policy: public, authenticated or self-only.
+// A self-only route is allowed only to the user whose telegramUserId is in the request.
+// The admin routes are authenticated here, the role of the admin is checked by the profiles service
+func InitRoutes(
+	app *fiber.App,
+	policy middlewares.Policy,
+	webSocketMiddleware fiber.Handler,
+	authController *controller.AuthController,
+	profileController *controller.ProfileController,
+	adminController *controller.AdminController,
+	webSocketController *controller.WebSocketController,
+) {
+	public := policy(enum.RoutePolicyPublic)
+	authenticated := policy(enum.RoutePolicyAuthenticated)
+	selfOnly := policy(enum.RoutePolicySelfOnly)
 	router := app.Group(prefix)
-	router.Get("/profiles/telegram/:telegramUserId", profileController.GetProfile())
-	router.Get("/profiles/detail/:viewedTelegramUserId", profileController.GetProfileDetail())
-	router.Get("/profiles/short/:telegramUserId", profileController.GetProfileShortInfo())
-	router.Get("/profiles/list", profileController.GetProfileList())
-	router.Get("/profiles/:telegramUserId/check", profileController.CheckProfileExists())
-	router.Get("/profiles/filters/:telegramUserId", profileController.GetFilter())
-	router.Get("/profiles/:telegramUserId/premium/check", profileController.CheckPremium())
-	router.Get("/profiles/:telegramUserId/blocks/list", profileController.GetBlockedList())
-	router.Put("/profiles/navigators", profileController.UpdateCoordinates())
-}
 
-func InitProtectedRoutes(app *fiber.App, profileController *controller.ProfileController) {
-	router := app.Group(prefix)
-	router.Post("/profiles", profileController.AddProfile())
-	router.Put("/profiles", profileController.UpdateProfile())
-	router.Post("/profiles/freeze", profileController.FreezeProfile())
-	router.Post("/profiles/restore", profileController.RestoreProfile())
-	router.Delete("/profiles", profileController.DeleteProfile())
-	router.Delete("/profiles/images/:id", profileController.DeleteImage())
-	router.Put("/profiles/filters", profileController.UpdateFilter())
-	router.Post("/profiles/blocks", profileController.AddBlock())
-	router.Put("/profiles/unblock", profileController.Unblock())
-	router.Post("/profiles/likes", profileController.AddLike())
-	router.Put("/profiles/likes", profileController.UpdateLike())
-	router.Post("/profiles/likes/last", profileController.GetLastLike())
-	router.Post("/profiles/complaints", profileController.AddComplaint())
-	router.Post("/profiles/payments", profileController.AddPayment())
-	router.Put("/profiles/settings", profileController.UpdateSettings())
+	router.Post("/auth/session", public, authController.CreateSession())
+	router.Post("/auth/refresh", public, authController.RefreshSession())
+	router.Delete("/auth/session", public, authController.DeleteSession())
+
+	router.Get("/ws", webSocketMiddleware, webSocketController.Connect())
+
+	router.Post("/profiles", selfOnly, profileController.AddProfile())
+	router.Put("/profiles", selfOnly, profileController.UpdateProfile())
+	router.Delete("/profiles", selfOnly, profileController.DeleteProfile())
+	router.Get("/profiles/telegram/:telegramUserId", selfOnly, profileController.GetProfile())
+	router.Get("/profiles/detail/:viewedTelegramUserId", selfOnly, profileController.GetProfileDetail())
+	// the short info holds the filters, the statuses and the language of the user, so it is self-only
+	router.Get("/profiles/short/:telegramUserId", selfOnly, profileController.GetProfileShortInfo())
+	router.Get("/profiles/list", selfOnly, profileController.GetProfileList())
+	router.Get("/profiles/:telegramUserId/check", selfOnly, profileController.CheckProfileExists())
+	router.Post("/profiles/freeze", selfOnly, profileController.FreezeProfile())
+	router.Post("/profiles/restore", selfOnly, profileController.RestoreProfile())
+	router.Put("/profiles/navigators", selfOnly, profileController.UpdateCoordinates())
+	router.Put("/profiles/settings", selfOnly, profileController.UpdateSettings())
+	router.Get("/profiles/:telegramUserId/premium/check", selfOnly, profileController.CheckPremium())
+	router.Post("/profiles/payments", selfOnly, profileController.AddPayment())
+	// registered before "/profiles/images/:id", otherwise "grants" would be taken for an image id
+	router.Delete("/profiles/images/grants", selfOnly, profileController.DeleteImageGrant())
+	// the owner of the image is checked by the handler after the image is loaded
+	router.Delete("/profiles/images/:id", authenticated, profileController.DeleteImage())
+	router.Post("/profiles/images", selfOnly, profileController.UploadImage())
+	router.Put("/profiles/images/primary", selfOnly, profileController.SetPrimaryImage())
+	router.Put("/profiles/images/order", selfOnly, profileController.ReorderImages())
+	router.Put("/profiles/images/privacy", selfOnly, profileController.UpdateImagePrivacy())
+	router.Post("/profiles/images/grants", selfOnly, profileController.AddImageGrant())
+	router.Get("/profiles/:telegramUserId/images/grants", selfOnly, profileController.GetImageGrantList())
+	router.Get("/profiles/filters/:telegramUserId", selfOnly, profileController.GetFilter())
+	router.Put("/profiles/filters", selfOnly, profileController.UpdateFilter())
+	router.Post("/profiles/blocks", selfOnly, profileController.AddBlock())
+	router.Get("/profiles/:telegramUserId/blocks/list", selfOnly, profileController.GetBlockedList())
+	router.Put("/profiles/unblock", selfOnly, profileController.Unblock())
+	router.Post("/profiles/likes", selfOnly, profileController.AddLike())
+	router.Put("/profiles/likes", selfOnly, profileController.UpdateLike())
+	router.Post("/profiles/likes/last", selfOnly, profileController.GetLastLike())
+	router.Get("/profiles/matches", selfOnly, profileController.GetMatchList())
+	router.Delete("/profiles/matches", selfOnly, profileController.Unmatch())
+	router.Post("/profiles/messages", selfOnly, profileController.SendMessage())
+	router.Get("/profiles/conversations", selfOnly, profileController.GetConversationList())
+	router.Get("/profiles/conversations/:conversationId/messages", selfOnly, profileController.GetMessageList())
+	router.Put("/profiles/conversations/:conversationId/read", selfOnly, profileController.MarkRead())
+	router.Post("/profiles/complaints", selfOnly, profileController.AddComplaint())
+	router.Post("/profiles/complaints/appeal", selfOnly, profileController.AddComplaintAppeal())
+
+	router.Get("/admin/complaints", authenticated, adminController.GetComplaintList())
+	router.Get("/admin/profiles/:telegramUserId", authenticated, adminController.GetProfile())
+	router.Post("/admin/profiles/:telegramUserId/block", authenticated, adminController.BlockProfile())
+	router.Post("/admin/profiles/:telegramUserId/unblock", authenticated, adminController.UnblockProfile())
+	router.Post("/admin/profiles/:telegramUserId/complaints/review", authenticated,
+		adminController.ReviewComplaints())
+	router.Post("/admin/images/:id/block", authenticated, adminController.BlockImage())
+	router.Post("/admin/images/:id/unblock", authenticated, adminController.UnblockImage())
+	router.Get("/admin/audit", authenticated, adminController.GetAuditList())
 }
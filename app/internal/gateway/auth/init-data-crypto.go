@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"github.com/Luzifer/go-openssl/v4"
+)
+
+// DecryptInitData - decrypts the init data encrypted by the Mini App with the shared secret key
+func DecryptInitData(encryptedString, secretKey string) (string, error) {
+	o := openssl.New()
+	key := openssl.BytesToKeyMD5
+	dec, err := o.DecryptBytes(secretKey, []byte(encryptedString), key)
+	if err != nil {
+		return "", err
+	}
+	return string(dec), nil
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"container/list"
+	"errors"
+	initdata "github.com/telegram-mini-apps/init-data-golang"
+	"sync"
+	"time"
+)
+
+const (
+	// authDateMaxSkew - the allowed difference between the clocks of Telegram and the gateway
+	authDateMaxSkew = time.Minute
+	// replayCacheMaxSize - the hard limit of the remembered init data, the oldest ones are evicted first
+	replayCacheMaxSize = 100000
+	// replayCacheDefaultTTL - how long the init data is remembered when its age is not limited
+	replayCacheDefaultTTL = 24 * time.Hour
+)
+
+var (
+	ErrInitDataMalformed    = errors.New("init data is malformed")
+	ErrInitDataBadSignature = errors.New("init data signature is invalid")
+	ErrInitDataExpired      = errors.New("init data is expired")
+	ErrInitDataReplayed     = errors.New("init data has already been used")
+)
+
+// usedInitData - the key of the used init data and the time after which it is expired anyway
+type usedInitData struct {
+	key       string
+	expiresAt time.Time
+}
+
+// InitDataValidator - validates the init data signed by Telegram with the bot token.
+// The init data is exchanged for a session once, so every init data is single-use: its query_id,
+// or its hash when there is no query_id, is remembered until the init data expires
+type InitDataValidator struct {
+	botToken string
+	maxAge   time.Duration
+	mu       sync.Mutex
+	used     map[string]*list.Element
+	order    *list.List // the used init data from the oldest one
+}
+
+func NewInitDataValidator(botToken string, maxAge time.Duration) *InitDataValidator {
+	return &InitDataValidator{
+		botToken: botToken,
+		maxAge:   maxAge,
+		used:     make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Validate - checks the signature, the freshness and the replay of the raw init data and parses it
+func (v *InitDataValidator) Validate(rawInitData string) (initdata.InitData, error) {
+	// The expiration is checked below, after the signature, so a forged init data never gets
+	// a more specific error than ErrInitDataBadSignature
+	if err := initdata.Validate(rawInitData, v.botToken, 0); err != nil {
+		if errors.Is(err, initdata.ErrUnexpectedFormat) {
+			return initdata.InitData{}, ErrInitDataMalformed
+		}
+		return initdata.InitData{}, ErrInitDataBadSignature
+	}
+	telegramInitData, err := initdata.Parse(rawInitData)
+	if err != nil || telegramInitData.User.ID == 0 || telegramInitData.AuthDateRaw == 0 {
+		return initdata.InitData{}, ErrInitDataMalformed
+	}
+	now := time.Now()
+	authDate := telegramInitData.AuthDate()
+	if authDate.After(now.Add(authDateMaxSkew)) {
+		return initdata.InitData{}, ErrInitDataMalformed
+	}
+	if v.maxAge > 0 && authDate.Add(v.maxAge).Before(now) {
+		return initdata.InitData{}, ErrInitDataExpired
+	}
+	if err := v.checkReplay(telegramInitData, now); err != nil {
+		return initdata.InitData{}, err
+	}
+	return telegramInitData, nil
+}
+
+// checkReplay - rejects the init data that has been used before and remembers it otherwise
+func (v *InitDataValidator) checkReplay(telegramInitData initdata.InitData, now time.Time) error {
+	key := "query_id:" + telegramInitData.QueryID
+	if telegramInitData.QueryID == "" {
+		key = "hash:" + telegramInitData.Hash
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.evict(now)
+	if e, ok := v.used[key]; ok && e.Value.(*usedInitData).expiresAt.After(now) {
+		return ErrInitDataReplayed
+	}
+	ttl := replayCacheDefaultTTL
+	if v.maxAge > 0 {
+		ttl = v.maxAge
+	}
+	if e, ok := v.used[key]; ok {
+		v.order.Remove(e)
+	}
+	v.used[key] = v.order.PushBack(&usedInitData{
+		key:       key,
+		expiresAt: telegramInitData.AuthDate().Add(ttl),
+	})
+	return nil
+}
+
+// evict - removes the expired init data from the oldest one and the oldest ones over replayCacheMaxSize
+func (v *InitDataValidator) evict(now time.Time) {
+	for e := v.order.Front(); e != nil; e = v.order.Front() {
+		u := e.Value.(*usedInitData)
+		if u.expiresAt.After(now) && v.order.Len() < replayCacheMaxSize {
+			return
+		}
+		v.order.Remove(e)
+		delete(v.used, u.key)
+	}
+}
@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	tokenAlgorithm     = "EdDSA"
+	tokenType          = "JWT"
+	tokenIssuer        = "tgdating-gateway"
+	refreshTokenLength = 32
+)
+
+var (
+	ErrAccessTokenMalformed    = errors.New("access token is malformed")
+	ErrAccessTokenBadSignature = errors.New("access token signature is invalid")
+	ErrAccessTokenExpired      = errors.New("access token is expired")
+	ErrPrivateKeyInvalid       = errors.New("private key must be a base64 encoded ed25519 seed or private key")
+)
+
+var tokenEncoding = base64.RawURLEncoding
+
+type tokenHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+}
+
+// Claims - the claims of the access token, Subject is the id of the user in telegram
+type Claims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// TokenIssuer - issues the access tokens signed with Ed25519 (JWT with the EdDSA algorithm) and
+// the opaque refresh tokens. Only the hash of a refresh token leaves the gateway
+type TokenIssuer struct {
+	privateKey      ed25519.PrivateKey
+	publicKey       ed25519.PublicKey
+	accessTokenTtl  time.Duration
+	refreshTokenTtl time.Duration
+}
+
+func NewTokenIssuer(privateKey ed25519.PrivateKey, accessTokenTtl, refreshTokenTtl time.Duration) *TokenIssuer {
+	return &TokenIssuer{
+		privateKey:      privateKey,
+		publicKey:       privateKey.Public().(ed25519.PublicKey),
+		accessTokenTtl:  accessTokenTtl,
+		refreshTokenTtl: refreshTokenTtl,
+	}
+}
+
+// ParsePrivateKey - decodes the base64 encoded ed25519 seed (32 bytes) or private key (64 bytes)
+func ParsePrivateKey(encodedKey string) (ed25519.PrivateKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, ErrPrivateKeyInvalid
+	}
+	switch len(key) {
+	case ed25519.SeedSize:
+		return ed25519.NewKeyFromSeed(key), nil
+	case ed25519.PrivateKeySize:
+		return ed25519.PrivateKey(key), nil
+	default:
+		return nil, ErrPrivateKeyInvalid
+	}
+}
+
+// GeneratePrivateKey - generates a random key, the tokens signed with it are valid until the restart
+func GeneratePrivateKey() (ed25519.PrivateKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return privateKey, nil
+}
+
+func (ti *TokenIssuer) IssueAccessToken(telegramUserId string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ti.accessTokenTtl)
+	header, err := json.Marshal(&tokenHeader{
+		Algorithm: tokenAlgorithm,
+		Type:      tokenType,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	claims, err := json.Marshal(&Claims{
+		Issuer:    tokenIssuer,
+		Subject:   telegramUserId,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	signingInput := tokenEncoding.EncodeToString(header) + "." + tokenEncoding.EncodeToString(claims)
+	signature := ed25519.Sign(ti.privateKey, []byte(signingInput))
+	return signingInput + "." + tokenEncoding.EncodeToString(signature), expiresAt, nil
+}
+
+// VerifyAccessToken - checks the algorithm, the signature and the expiration of the access token
+func (ti *TokenIssuer) VerifyAccessToken(accessToken string) (*Claims, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrAccessTokenMalformed
+	}
+	headerJson, err := tokenEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrAccessTokenMalformed
+	}
+	header := &tokenHeader{}
+	if err := json.Unmarshal(headerJson, header); err != nil {
+		return nil, ErrAccessTokenMalformed
+	}
+	// The algorithm is fixed, a token can't downgrade the verification by its header
+	if header.Algorithm != tokenAlgorithm {
+		return nil, ErrAccessTokenBadSignature
+	}
+	signature, err := tokenEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrAccessTokenMalformed
+	}
+	if !ed25519.Verify(ti.publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrAccessTokenBadSignature
+	}
+	claimsJson, err := tokenEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrAccessTokenMalformed
+	}
+	claims := &Claims{}
+	if err := json.Unmarshal(claimsJson, claims); err != nil {
+		return nil, ErrAccessTokenMalformed
+	}
+	if claims.Issuer != tokenIssuer || claims.Subject == "" {
+		return nil, ErrAccessTokenMalformed
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrAccessTokenExpired
+	}
+	return claims, nil
+}
+
+// IssueRefreshToken - returns a random refresh token, its hash to store and its expiration date
+func (ti *TokenIssuer) IssueRefreshToken() (string, string, time.Time, error) {
+	b := make([]byte, refreshTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", time.Time{}, err
+	}
+	refreshToken := tokenEncoding.EncodeToString(b)
+	return refreshToken, HashRefreshToken(refreshToken), time.Now().Add(ti.refreshTokenTtl), nil
+}
+
+func HashRefreshToken(refreshToken string) string {
+	hash := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(hash[:])
+}
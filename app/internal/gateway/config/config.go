@@ -4,18 +4,23 @@ import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
+	"time"
 )
 
 type Config struct {
-	AllowOrigins     string `envconfig:"ALLOW_ORIGINS"`
-	GatewayHost      string `envconfig:"GATEWAY_HOST"`
-	ProfilesHost     string `envconfig:"PROFILES_HOST"`
-	LoggerLevel      string `envconfig:"LOGGER_LEVEL"`
-	TelegramBotToken string `envconfig:"TELEGRAM_BOT_TOKEN"`
-	CryptoSecretKey  string `envconfig:"CRYPTO_SECRET_KEY"`
-	Kafka1           string `envconfig:"KAFKA_1"`
-	Kafka2           string `envconfig:"KAFKA_2"`
-	Kafka3           string `envconfig:"KAFKA_3"`
+	AllowOrigins     string        `envconfig:"ALLOW_ORIGINS"`
+	GatewayHost      string        `envconfig:"GATEWAY_HOST"`
+	ProfilesHost     string        `envconfig:"PROFILES_HOST"`
+	LoggerLevel      string        `envconfig:"LOGGER_LEVEL"`
+	TelegramBotToken string        `envconfig:"TELEGRAM_BOT_TOKEN"`
+	CryptoSecretKey  string        `envconfig:"CRYPTO_SECRET_KEY"`
+	Kafka1           string        `envconfig:"KAFKA_1"`
+	Kafka2           string        `envconfig:"KAFKA_2"`
+	Kafka3           string        `envconfig:"KAFKA_3"`
+	InitDataMaxAge   time.Duration `envconfig:"INIT_DATA_MAX_AGE" default:"1h"`
+	JwtPrivateKey    string        `envconfig:"JWT_PRIVATE_KEY"`
+	AccessTokenTtl   time.Duration `envconfig:"ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTokenTtl  time.Duration `envconfig:"REFRESH_TOKEN_TTL" default:"720h"`
 }
 
 func Load(l logger.Logger) (*Config, error) {
@@ -0,0 +1,298 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
+	v1 "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/http/api/v1"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/mapper"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+)
+
+const (
+	errorFilePathAdmin = "internal/gateway/controller/admin-controller.go"
+)
+
+var ErrClaimsMissing = errors.New("missing claims in context")
+
+// AdminController - the routes are only authenticated by the gateway, the role of the admin
+// is checked by the profiles service, which also records every action in the audit trail
+type AdminController struct {
+	logger logger.Logger
+	proto  pb.AdminClient
+}
+
+func NewAdminController(l logger.Logger, ac pb.AdminClient) *AdminController {
+	return &AdminController{
+		logger: l,
+		proto:  ac,
+	}
+}
+
+func (ac *AdminController) GetComplaintList() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("GET /api/v1/admin/complaints")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		req := &request.AdminComplaintGetListRequestDto{}
+		if err := ctf.QueryParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("GetComplaintList", "QueryParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		complaintListRequest, err := adminMapper.MapToGetComplaintListRequest(req, adminTelegramUserId)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("GetComplaintList", "MapToGetComplaintListRequest")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		complaintListResponse, err := ac.proto.GetComplaintList(ctx, complaintListRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("GetComplaintList", "proto.GetComplaintList")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, complaintListResponse)
+	}
+}
+
+func (ac *AdminController) GetProfile() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("GET /api/v1/admin/profiles/:telegramUserId")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		profileRequest := adminMapper.MapToGetProfileRequest(adminTelegramUserId, ctf.Params("telegramUserId"))
+		profileResponse, err := ac.proto.GetProfile(ctx, profileRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("GetProfile", "proto.GetProfile")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, profileResponse)
+	}
+}
+
+func (ac *AdminController) BlockProfile() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/admin/profiles/:telegramUserId/block")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		req := &request.AdminBlockRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("BlockProfile", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		blockRequest := adminMapper.MapToProfileBlockRequest(req, adminTelegramUserId, ctf.Params("telegramUserId"))
+		blockResponse, err := ac.proto.BlockProfile(ctx, blockRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("BlockProfile", "proto.BlockProfile")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, blockResponse)
+	}
+}
+
+func (ac *AdminController) UnblockProfile() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/admin/profiles/:telegramUserId/unblock")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		req := &request.AdminBlockRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("UnblockProfile", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		unblockRequest := adminMapper.MapToProfileBlockRequest(req, adminTelegramUserId, ctf.Params("telegramUserId"))
+		unblockResponse, err := ac.proto.UnblockProfile(ctx, unblockRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("UnblockProfile", "proto.UnblockProfile")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, unblockResponse)
+	}
+}
+
+func (ac *AdminController) BlockImage() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/admin/images/:id/block")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		imageId, err := strconv.ParseUint(ctf.Params("id"), 10, 64)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("BlockImage", "ParseUint")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		req := &request.AdminBlockRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("BlockImage", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		blockRequest := adminMapper.MapToImageBlockRequest(req, adminTelegramUserId, imageId)
+		blockResponse, err := ac.proto.BlockImage(ctx, blockRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("BlockImage", "proto.BlockImage")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, blockResponse)
+	}
+}
+
+func (ac *AdminController) UnblockImage() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/admin/images/:id/unblock")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		imageId, err := strconv.ParseUint(ctf.Params("id"), 10, 64)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("UnblockImage", "ParseUint")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		req := &request.AdminBlockRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("UnblockImage", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		unblockRequest := adminMapper.MapToImageBlockRequest(req, adminTelegramUserId, imageId)
+		unblockResponse, err := ac.proto.UnblockImage(ctx, unblockRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("UnblockImage", "proto.UnblockImage")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, unblockResponse)
+	}
+}
+
+// ReviewComplaints - the decision is upheld or dismissed and applies to all the complaints under review
+func (ac *AdminController) ReviewComplaints() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/admin/profiles/:telegramUserId/complaints/review")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		req := &request.AdminComplaintReviewRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("ReviewComplaints", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		reviewRequest := adminMapper.MapToComplaintReviewRequest(req, adminTelegramUserId, ctf.Params("telegramUserId"))
+		reviewResponse, err := ac.proto.ReviewComplaints(ctx, reviewRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("ReviewComplaints", "proto.ReviewComplaints")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, reviewResponse)
+	}
+}
+
+func (ac *AdminController) GetAuditList() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("GET /api/v1/admin/audit")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		adminTelegramUserId, err := ac.getAdminTelegramUserId(ctf)
+		if err != nil {
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		req := &request.AdminAuditGetListRequestDto{}
+		if err := ctf.QueryParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("GetAuditList", "QueryParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		adminMapper := &mapper.AdminMapper{}
+		auditListRequest := adminMapper.MapToGetAuditListRequest(req, adminTelegramUserId)
+		auditListResponse, err := ac.proto.GetAuditList(ctx, auditListRequest)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("GetAuditList", "proto.GetAuditList")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, ac.getErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, auditListResponse)
+	}
+}
+
+func (ac *AdminController) getAdminTelegramUserId(ctf *fiber.Ctx) (string, error) {
+	claims, ok := ctf.UserContext().Value(enum.ContextKeyClaims).(*auth.Claims)
+	if !ok {
+		return "", ErrClaimsMissing
+	}
+	return claims.Subject, nil
+}
+
+// getErrorStatus - maps the gRPC status of the admin methods to the http status
+func (ac *AdminController) getErrorStatus(err error) int {
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.NotFound:
+			return http.StatusNotFound
+		case codes.PermissionDenied:
+			return http.StatusForbidden
+		case codes.InvalidArgument:
+			return http.StatusBadRequest
+		case codes.FailedPrecondition:
+			return http.StatusConflict
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+func (ac *AdminController) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathAdmin)
+}
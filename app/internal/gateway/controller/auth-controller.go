@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
+	v1 "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/http/api/v1"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	errorFilePathAuth = "internal/gateway/controller/auth-controller.go"
+	tokenTypeBearer   = "Bearer"
+)
+
+var (
+	ErrRefreshTokenMissing = errors.New("refresh token is missing")
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid, expired or revoked")
+)
+
+// AuthController - exchanges the init data of the Mini App for the session tokens,
+// so the init data is decrypted and validated once per session instead of on every request
+type AuthController struct {
+	logger            logger.Logger
+	proto             pb.ProfileClient
+	initDataValidator *auth.InitDataValidator
+	tokenIssuer       *auth.TokenIssuer
+	cryptoSecretKey   string
+}
+
+func NewAuthController(l logger.Logger, pc pb.ProfileClient, idv *auth.InitDataValidator, ti *auth.TokenIssuer,
+	cryptoSecretKey string) *AuthController {
+	return &AuthController{
+		logger:            l,
+		proto:             pc,
+		initDataValidator: idv,
+		tokenIssuer:       ti,
+		cryptoSecretKey:   cryptoSecretKey,
+	}
+}
+
+// CreateSession - the encrypted init data is sent in the Authorization header as before
+func (ac *AuthController) CreateSession() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/auth/session")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		authData, err := auth.DecryptInitData(ctf.Get("Authorization"), ac.cryptoSecretKey)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("CreateSession", "DecryptInitData")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, errors.New("invalid decrypt token"), http.StatusUnauthorized)
+		}
+		telegramInitData, err := ac.initDataValidator.Validate(authData)
+		if err != nil {
+			errorMessage := ac.getErrorMessage("CreateSession", "initDataValidator.Validate")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
+		}
+		telegramUserId := strconv.FormatInt(telegramInitData.User.ID, 10)
+		refreshToken, refreshTokenHash, refreshTokenExpiresAt, err := ac.tokenIssuer.IssueRefreshToken()
+		if err != nil {
+			errorMessage := ac.getErrorMessage("CreateSession", "tokenIssuer.IssueRefreshToken")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		_, err = ac.proto.AddRefreshToken(ctx, &pb.RefreshTokenAddRequest{
+			TelegramUserId: telegramUserId,
+			TokenHash:      refreshTokenHash,
+			ExpiresAt:      timestamppb.New(refreshTokenExpiresAt),
+		})
+		if err != nil {
+			errorMessage := ac.getErrorMessage("CreateSession", "proto.AddRefreshToken")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return ac.responseSession(ctf, telegramUserId, refreshToken, refreshTokenExpiresAt)
+	}
+}
+
+// RefreshSession - the refresh token is single-use, a new one is returned with the new access token
+func (ac *AuthController) RefreshSession() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("POST /api/v1/auth/refresh")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.SessionRefreshRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("RefreshSession", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		if req.RefreshToken == "" {
+			return v1.ResponseError(ctf, ErrRefreshTokenMissing, http.StatusBadRequest)
+		}
+		refreshToken, refreshTokenHash, refreshTokenExpiresAt, err := ac.tokenIssuer.IssueRefreshToken()
+		if err != nil {
+			errorMessage := ac.getErrorMessage("RefreshSession", "tokenIssuer.IssueRefreshToken")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		rotateResponse, err := ac.proto.RotateRefreshToken(ctx, &pb.RefreshTokenRotateRequest{
+			TokenHash:    auth.HashRefreshToken(req.RefreshToken),
+			NewTokenHash: refreshTokenHash,
+			ExpiresAt:    timestamppb.New(refreshTokenExpiresAt),
+		})
+		if err != nil {
+			errorMessage := ac.getErrorMessage("RefreshSession", "proto.RotateRefreshToken")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			if status.Code(err) == codes.Unauthenticated {
+				return v1.ResponseError(ctf, ErrRefreshTokenInvalid, http.StatusUnauthorized)
+			}
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return ac.responseSession(ctf, rotateResponse.TelegramUserId, refreshToken, refreshTokenExpiresAt)
+	}
+}
+
+// DeleteSession - revokes the refresh token, the access token stays valid until it expires
+func (ac *AuthController) DeleteSession() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		ac.logger.Info("DELETE /api/v1/auth/session")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.SessionDeleteRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := ac.getErrorMessage("DeleteSession", "BodyParser")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		if req.RefreshToken == "" {
+			return v1.ResponseError(ctf, ErrRefreshTokenMissing, http.StatusBadRequest)
+		}
+		revokeResponse, err := ac.proto.RevokeRefreshToken(ctx, &pb.RefreshTokenRevokeRequest{
+			TokenHash: auth.HashRefreshToken(req.RefreshToken),
+		})
+		if err != nil {
+			errorMessage := ac.getErrorMessage("DeleteSession", "proto.RevokeRefreshToken")
+			ac.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseOk(ctf, revokeResponse)
+	}
+}
+
+func (ac *AuthController) responseSession(ctf *fiber.Ctx, telegramUserId, refreshToken string,
+	refreshTokenExpiresAt time.Time) error {
+	accessToken, accessTokenExpiresAt, err := ac.tokenIssuer.IssueAccessToken(telegramUserId)
+	if err != nil {
+		errorMessage := ac.getErrorMessage("responseSession", "tokenIssuer.IssueAccessToken")
+		ac.logger.Debug(errorMessage, zap.Error(err))
+		return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+	}
+	return v1.ResponseCreated(ctf, &response.SessionResponseDto{
+		TokenType:             tokenTypeBearer,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessTokenExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshTokenExpiresAt,
+	})
+}
+
+func (ac *AuthController) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathAuth)
+}
@@ -0,0 +1,100 @@
+package mapper
+
+import (
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/dto/request"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type AdminMapper struct {
+}
+
+// MapToGetComplaintListRequest - the dates are expected in RFC 3339, the empty filters are skipped
+func (pm *AdminMapper) MapToGetComplaintListRequest(
+	r *request.AdminComplaintGetListRequestDto, adminTelegramUserId string) (*pb.AdminComplaintListRequest, error) {
+	dateFrom, err := pm.parseDate(r.DateFrom)
+	if err != nil {
+		return nil, err
+	}
+	dateTo, err := pm.parseDate(r.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AdminComplaintListRequest{
+		AdminTelegramUserId:    adminTelegramUserId,
+		TelegramUserId:         pm.toOptional(r.TelegramUserId),
+		CriminalTelegramUserId: pm.toOptional(r.CriminalTelegramUserId),
+		Type:                   pm.toOptional(r.Type),
+		DateFrom:               dateFrom,
+		DateTo:                 dateTo,
+		Status:                 pm.toOptional(r.Status),
+		Page:                   r.Page,
+		Size:                   r.Size,
+	}, nil
+}
+
+func (pm *AdminMapper) MapToGetProfileRequest(
+	adminTelegramUserId, telegramUserId string) *pb.AdminProfileGetRequest {
+	return &pb.AdminProfileGetRequest{
+		AdminTelegramUserId: adminTelegramUserId,
+		TelegramUserId:      telegramUserId,
+	}
+}
+
+func (pm *AdminMapper) MapToProfileBlockRequest(
+	r *request.AdminBlockRequestDto, adminTelegramUserId, telegramUserId string) *pb.AdminProfileBlockRequest {
+	return &pb.AdminProfileBlockRequest{
+		AdminTelegramUserId: adminTelegramUserId,
+		TelegramUserId:      telegramUserId,
+		Reason:              r.Reason,
+	}
+}
+
+func (pm *AdminMapper) MapToImageBlockRequest(
+	r *request.AdminBlockRequestDto, adminTelegramUserId string, imageId uint64) *pb.AdminImageBlockRequest {
+	return &pb.AdminImageBlockRequest{
+		AdminTelegramUserId: adminTelegramUserId,
+		ImageId:             imageId,
+		Reason:              r.Reason,
+	}
+}
+
+func (pm *AdminMapper) MapToComplaintReviewRequest(r *request.AdminComplaintReviewRequestDto,
+	adminTelegramUserId, telegramUserId string) *pb.AdminComplaintReviewRequest {
+	return &pb.AdminComplaintReviewRequest{
+		AdminTelegramUserId: adminTelegramUserId,
+		TelegramUserId:      telegramUserId,
+		Decision:            r.Decision,
+		Reason:              r.Reason,
+	}
+}
+
+func (pm *AdminMapper) MapToGetAuditListRequest(
+	r *request.AdminAuditGetListRequestDto, adminTelegramUserId string) *pb.AdminAuditListRequest {
+	return &pb.AdminAuditListRequest{
+		AdminTelegramUserId:  adminTelegramUserId,
+		ActorTelegramUserId:  pm.toOptional(r.ActorTelegramUserId),
+		TargetTelegramUserId: pm.toOptional(r.TargetTelegramUserId),
+		Page:                 r.Page,
+		Size:                 r.Size,
+	}
+}
+
+func (pm *AdminMapper) parseDate(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
+
+func (pm *AdminMapper) toOptional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/events"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
+)
+
+type EventMapper struct {
+}
+
+// MapToHubContent - maps the event to the content sent over the websocket.
+// Returns nil for the events that are not delivered to the websocket connections
+func (pm *EventMapper) MapToHubContent(e *events.Event) *entity.HubContent {
+	hc := &entity.HubContent{
+		LikedTelegramUserId: e.GetRecipientTelegramUserId(),
+	}
+	switch p := e.GetPayload().(type) {
+	case *events.Event_LikeReceived:
+		hc.Type = enum.HubContentTypeLike
+		hc.Message = p.LikeReceived.GetMessage()
+		hc.UserImageUrl = p.LikeReceived.GetUserImageUrl()
+		hc.Username = p.LikeReceived.GetUsername()
+	case *events.Event_MatchCreated:
+		hc.Type = enum.HubContentTypeMatch
+		hc.Message = p.MatchCreated.GetMessage()
+		hc.UserImageUrl = p.MatchCreated.GetUserImageUrl()
+		hc.Username = p.MatchCreated.GetUsername()
+	case *events.Event_MessageReceived:
+		hc.Type = enum.HubContentTypeMessage
+		hc.Message = p.MessageReceived.GetText()
+		hc.UserImageUrl = p.MessageReceived.GetUserImageUrl()
+		hc.Username = p.MessageReceived.GetUsername()
+		hc.ConversationId = p.MessageReceived.GetConversationId()
+	case *events.Event_ProfileBlocked:
+		hc.Type = enum.HubContentTypeModeration
+		hc.Message = p.ProfileBlocked.GetReason()
+	case *events.Event_ImageModerated:
+		// The message is the reason of the rejection and is empty for an approved image
+		hc.Type = enum.HubContentTypeModeration
+		hc.Message = p.ImageModerated.GetReason()
+	default:
+		return nil
+	}
+	return hc
+}
@@ -127,6 +127,7 @@ func (pm *ProfileMapper) MapToByTelegramUserIdResponse(
 				TelegramUserId: image.TelegramUserId,
 				Name:           image.Name,
 				Url:            image.Url,
+				Variants:       pm.MapToImageVariantListResponse(image.GetVariants()),
 			})
 		}
 	}
@@ -229,6 +230,7 @@ func (pm *ProfileMapper) MapToDetailResponse(r *pb.ProfileDetailResponse) *respo
 				TelegramUserId: image.TelegramUserId,
 				Name:           image.Name,
 				Url:            image.Url,
+				Variants:       pm.MapToImageVariantListResponse(image.GetVariants()),
 			})
 		}
 	}
@@ -273,18 +275,11 @@ func (pm *ProfileMapper) MapToListRequest(
 		City:           r.City,
 		Latitude:       r.Latitude,
 		Longitude:      r.Longitude,
+		Cursor:         r.Cursor,
 	}
 }
 
 func (pm *ProfileMapper) MapToListResponse(r *pb.ProfileListResponse) *response.ProfileListResponseDto {
-	paginationEntity := &entity.PaginationEntity{
-		HasPrevious:   r.HasPrevious,
-		HasNext:       r.HasNext,
-		Page:          r.Page,
-		Size:          r.Size,
-		TotalEntities: r.TotalEntities,
-		TotalPages:    r.TotalPages,
-	}
 	profileContent := make([]*response.ProfileListItemResponseDto, 0)
 	if len(r.Content) > 0 {
 		for _, c := range r.Content {
@@ -298,8 +293,11 @@ func (pm *ProfileMapper) MapToListResponse(r *pb.ProfileListResponse) *response.
 		}
 	}
 	return &response.ProfileListResponseDto{
-		PaginationEntity: paginationEntity,
-		Content:          profileContent,
+		Content:            profileContent,
+		NextCursor:         r.NextCursor,
+		HasNext:            r.HasNext,
+		TotalEntities:      r.TotalEntities,
+		IsTotalApproximate: r.IsTotalApproximate,
 	}
 }
 
@@ -394,6 +392,52 @@ func (pm *ProfileMapper) MapToLikeUpdateRequest(r *request.LikeUpdateRequestDto)
 	}
 }
 
+func (pm *ProfileMapper) MapToGetMatchListRequest(telegramUserId string) *pb.GetMatchListRequest {
+	return &pb.GetMatchListRequest{
+		TelegramUserId: telegramUserId,
+	}
+}
+
+func (pm *ProfileMapper) MapToUnmatchRequest(r *request.UnmatchRequestDto) *pb.UnmatchRequest {
+	return &pb.UnmatchRequest{
+		TelegramUserId:        r.TelegramUserId,
+		MatchedTelegramUserId: r.MatchedTelegramUserId,
+	}
+}
+
+func (pm *ProfileMapper) MapToSendMessageRequest(r *request.MessageAddRequestDto) *pb.SendMessageRequest {
+	return &pb.SendMessageRequest{
+		TelegramUserId:          r.TelegramUserId,
+		RecipientTelegramUserId: r.RecipientTelegramUserId,
+		Text:                    r.Text,
+	}
+}
+
+func (pm *ProfileMapper) MapToGetConversationListRequest(telegramUserId string) *pb.GetConversationListRequest {
+	return &pb.GetConversationListRequest{
+		TelegramUserId: telegramUserId,
+	}
+}
+
+func (pm *ProfileMapper) MapToGetMessageListRequest(
+	r *request.MessageGetListRequestDto, conversationId uint64) *pb.GetMessageListRequest {
+	return &pb.GetMessageListRequest{
+		TelegramUserId: r.TelegramUserId,
+		ConversationId: conversationId,
+		Cursor:         r.Cursor,
+		Limit:          r.Limit,
+	}
+}
+
+func (pm *ProfileMapper) MapToMarkReadRequest(
+	r *request.MessageMarkReadRequestDto, conversationId uint64) *pb.MarkReadRequest {
+	return &pb.MarkReadRequest{
+		TelegramUserId:    r.TelegramUserId,
+		ConversationId:    conversationId,
+		LastReadMessageId: r.LastReadMessageId,
+	}
+}
+
 func (pm *ProfileMapper) MapToLikeGetLastRequest(telegramUserId string) *pb.LikeGetLastRequest {
 	return &pb.LikeGetLastRequest{
 		TelegramUserId: telegramUserId,
@@ -423,6 +467,69 @@ func (pm *ProfileMapper) MapToComplaintAddRequest(r *request.ComplaintAddRequest
 	}
 }
 
+func (pm *ProfileMapper) MapToImageUploadInfo(
+	r *request.ImageUploadRequestDto, filename string, size int64) *pb.ImageUploadInfo {
+	return &pb.ImageUploadInfo{
+		TelegramUserId: r.TelegramUserId,
+		Filename:       filename,
+		Size:           size,
+	}
+}
+
+func (pm *ProfileMapper) MapToImageResponse(r *pb.ImageResponse) *response.ImageResponseDto {
+	return &response.ImageResponseDto{
+		Id:             r.GetId(),
+		TelegramUserId: r.GetTelegramUserId(),
+		Name:           r.GetName(),
+		Url:            r.GetUrl(),
+		Variants:       pm.MapToImageVariantListResponse(r.GetVariants()),
+	}
+}
+
+func (pm *ProfileMapper) MapToImageSetPrimaryRequest(r *request.ImageSetPrimaryRequestDto) *pb.ImageSetPrimaryRequest {
+	return &pb.ImageSetPrimaryRequest{
+		TelegramUserId: r.TelegramUserId,
+		ImageId:        r.ImageId,
+	}
+}
+
+func (pm *ProfileMapper) MapToImageReorderRequest(r *request.ImageReorderRequestDto) *pb.ImageReorderRequest {
+	return &pb.ImageReorderRequest{
+		TelegramUserId: r.TelegramUserId,
+		ImageIds:       r.ImageIds,
+	}
+}
+
+func (pm *ProfileMapper) MapToImageUpdatePrivacyRequest(
+	r *request.ImageUpdatePrivacyRequestDto) *pb.ImageUpdatePrivacyRequest {
+	return &pb.ImageUpdatePrivacyRequest{
+		TelegramUserId: r.TelegramUserId,
+		ImageId:        r.ImageId,
+		IsPrivate:      r.IsPrivate,
+	}
+}
+
+func (pm *ProfileMapper) MapToImageGrantRequest(r *request.ImageGrantRequestDto) *pb.ImageGrantRequest {
+	return &pb.ImageGrantRequest{
+		TelegramUserId:       r.TelegramUserId,
+		ViewerTelegramUserId: r.ViewerTelegramUserId,
+	}
+}
+
+func (pm *ProfileMapper) MapToImageGrantListRequest(telegramUserId string) *pb.ImageGrantListRequest {
+	return &pb.ImageGrantListRequest{
+		TelegramUserId: telegramUserId,
+	}
+}
+
+func (pm *ProfileMapper) MapToComplaintAppealAddRequest(
+	r *request.ComplaintAppealAddRequestDto) *pb.ComplaintAppealAddRequest {
+	return &pb.ComplaintAppealAddRequest{
+		TelegramUserId: r.TelegramUserId,
+		Description:    r.Description,
+	}
+}
+
 func (pm *ProfileMapper) MapToGetStatusRequest(telegramUserId string) *pb.GetStatusByTelegramUserIdRequest {
 	return &pb.GetStatusByTelegramUserIdRequest{
 		TelegramUserId: telegramUserId,
@@ -471,3 +578,16 @@ func (pm *ProfileMapper) MapToUpdateCoordinatesRequest(
 		Longitude:      r.Longitude,
 	}
 }
+
+func (pm *ProfileMapper) MapToImageVariantListResponse(r []*pb.ImageVariant) []*response.ImageVariantResponseDto {
+	variants := make([]*response.ImageVariantResponseDto, 0, len(r))
+	for _, variant := range r {
+		variants = append(variants, &response.ImageVariantResponseDto{
+			Variant: variant.GetVariant(),
+			Url:     variant.GetUrl(),
+			Width:   variant.GetWidth(),
+			Height:  variant.GetHeight(),
+		})
+	}
+	return variants
+}
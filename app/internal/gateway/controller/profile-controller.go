@@ -2,20 +2,17 @@ package controller
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
 	v1 "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/http/api/v1"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/mapper"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/dto/request"
-	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/validation"
 	"github.com/gofiber/fiber/v2"
-	"github.com/segmentio/kafka-go"
-	initdata "github.com/telegram-mini-apps/init-data-golang"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -30,19 +27,19 @@ const (
 	defaultLocale   = "ru"
 	errorFilePath   = "internal/gateway/controller/profile-controller.go"
 	timeoutDuration = 30 * time.Second
+	// imageUploadChunkSize - the size of one message of the image upload stream
+	imageUploadChunkSize = 64 * 1024
 )
 
 type ProfileController struct {
-	logger      logger.Logger
-	kafkaWriter *kafka.Writer
-	proto       pb.ProfileClient
+	logger logger.Logger
+	proto  pb.ProfileClient
 }
 
-func NewProfileController(l logger.Logger, kw *kafka.Writer, pc pb.ProfileClient) *ProfileController {
+func NewProfileController(l logger.Logger, pc pb.ProfileClient) *ProfileController {
 	return &ProfileController{
-		logger:      l,
-		kafkaWriter: kw,
-		proto:       pc,
+		logger: l,
+		proto:  pc,
 	}
 }
 
@@ -61,11 +58,6 @@ func (pc *ProfileController) AddProfile() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("AddProfile", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		validateErr := validation.ValidateProfileAddRequestDto(ctf, req, locale)
 		if validateErr != nil {
 			errorMessage := pc.getErrorMessage("AddProfile",
@@ -108,11 +100,6 @@ func (pc *ProfileController) UpdateProfile() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("UpdateProfile", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		validateErr := validation.ValidateProfileEditRequestDto(ctf, req, locale)
 		if validateErr != nil {
 			errorMessage := pc.getErrorMessage("UpdateProfile",
@@ -150,11 +137,6 @@ func (pc *ProfileController) FreezeProfile() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("FreezeProfile", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		profileRequest := profileMapper.MapToFreezeRequest(req)
 		profileResponse, err := pc.proto.FreezeProfile(ctx, profileRequest)
@@ -178,11 +160,6 @@ func (pc *ProfileController) RestoreProfile() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("RestoreProfile", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		profileRequest := profileMapper.MapToRestoreRequest(req)
 		profileResponse, err := pc.proto.RestoreProfile(ctx, profileRequest)
@@ -207,11 +184,6 @@ func (pc *ProfileController) DeleteProfile() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("DeleteProfile", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		profileRequest := profileMapper.MapToDeleteRequest(req)
 		profileResponse, err := pc.proto.DeleteProfile(ctx, profileRequest)
@@ -333,8 +305,11 @@ func (pc *ProfileController) GetProfileList() fiber.Handler {
 				"proto.GetProfileList")
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			if e, ok := status.FromError(err); ok {
-				if e.Code() == codes.NotFound {
+				switch e.Code() {
+				case codes.NotFound:
 					return v1.ResponseError(ctf, err, http.StatusNotFound)
+				case codes.InvalidArgument:
+					return v1.ResponseError(ctf, err, http.StatusBadRequest)
 				}
 				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
 			}
@@ -416,6 +391,8 @@ func (pc *ProfileController) DeleteImage() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
 		}
+		// The route is only authenticated: the owner of the image is known after the image is loaded,
+		// so this is the one ownership check left to a handler
 		if err := pc.validateAuthUser(ctf, image.TelegramUserId); err != nil {
 			errorMessage := pc.getErrorMessage("DeleteImage", "validateAuthUser")
 			pc.logger.Debug(errorMessage, zap.Error(err))
@@ -434,6 +411,230 @@ func (pc *ProfileController) DeleteImage() fiber.Handler {
 	}
 }
 
+// UploadImage - sends the file to the profiles service by chunks, so it is never read into memory as a whole
+func (pc *ProfileController) UploadImage() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("POST /api/v1/profiles/images")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ImageUploadRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("UploadImage", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		fileHeader, err := ctf.FormFile("image")
+		if err != nil {
+			errorMessage := pc.getErrorMessage("UploadImage", "FormFile")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			errorMessage := pc.getErrorMessage("UploadImage", "fileHeader.Open")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		defer file.Close()
+		profileMapper := &mapper.ProfileMapper{}
+		info := profileMapper.MapToImageUploadInfo(req, fileHeader.Filename, fileHeader.Size)
+		imageUploaded, err := pc.uploadImage(ctx, info, file)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("UploadImage", "uploadImage")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getImageErrorStatus(err))
+		}
+		return v1.ResponseCreated(ctf, profileMapper.MapToImageResponse(imageUploaded.GetImage()))
+	}
+}
+
+// uploadImage - the first message of the stream contains the info of the file, the next ones contain its content
+func (pc *ProfileController) uploadImage(
+	ctx context.Context, info *pb.ImageUploadInfo, content io.Reader) (*pb.ImageUploadResponse, error) {
+	stream, err := pc.proto.UploadImage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pb.ImageUploadRequest{Data: &pb.ImageUploadRequest_Info{Info: info}}); err != nil {
+		return pc.closeImageUploadStream(stream, err)
+	}
+	buffer := make([]byte, imageUploadChunkSize)
+	for {
+		n, err := content.Read(buffer)
+		if n > 0 {
+			chunk := &pb.ImageUploadRequest{Data: &pb.ImageUploadRequest_Chunk{Chunk: buffer[:n]}}
+			if err := stream.Send(chunk); err != nil {
+				return pc.closeImageUploadStream(stream, err)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// closeImageUploadStream - io.EOF from Send means that the server has already finished the stream,
+// the reason is returned by CloseAndRecv
+func (pc *ProfileController) closeImageUploadStream(
+	stream pb.Profile_UploadImageClient, err error) (*pb.ImageUploadResponse, error) {
+	if errors.Is(err, io.EOF) {
+		return stream.CloseAndRecv()
+	}
+	return nil, err
+}
+
+func (pc *ProfileController) SetPrimaryImage() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("PUT /api/v1/profiles/images/primary")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ImageSetPrimaryRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("SetPrimaryImage", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		setPrimaryRequest := profileMapper.MapToImageSetPrimaryRequest(req)
+		imageUpdated, err := pc.proto.SetPrimaryImage(ctx, setPrimaryRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("SetPrimaryImage", "proto.SetPrimaryImage")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getImageErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, imageUpdated)
+	}
+}
+
+func (pc *ProfileController) ReorderImages() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("PUT /api/v1/profiles/images/order")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ImageReorderRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("ReorderImages", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		reorderRequest := profileMapper.MapToImageReorderRequest(req)
+		imagesUpdated, err := pc.proto.ReorderImages(ctx, reorderRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("ReorderImages", "proto.ReorderImages")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getImageErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, imagesUpdated)
+	}
+}
+
+func (pc *ProfileController) UpdateImagePrivacy() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("PUT /api/v1/profiles/images/privacy")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ImageUpdatePrivacyRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("UpdateImagePrivacy", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		privacyRequest := profileMapper.MapToImageUpdatePrivacyRequest(req)
+		imageUpdated, err := pc.proto.UpdateImagePrivacy(ctx, privacyRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("UpdateImagePrivacy", "proto.UpdateImagePrivacy")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getImageErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, imageUpdated)
+	}
+}
+
+func (pc *ProfileController) AddImageGrant() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("POST /api/v1/profiles/images/grants")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ImageGrantRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("AddImageGrant", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		grantRequest := profileMapper.MapToImageGrantRequest(req)
+		grantAdded, err := pc.proto.AddImageGrant(ctx, grantRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("AddImageGrant", "proto.AddImageGrant")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getImageErrorStatus(err))
+		}
+		return v1.ResponseCreated(ctf, grantAdded)
+	}
+}
+
+func (pc *ProfileController) DeleteImageGrant() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("DELETE /api/v1/profiles/images/grants")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ImageGrantRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("DeleteImageGrant", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		grantRequest := profileMapper.MapToImageGrantRequest(req)
+		grantDeleted, err := pc.proto.DeleteImageGrant(ctx, grantRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("DeleteImageGrant", "proto.DeleteImageGrant")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseOk(ctf, grantDeleted)
+	}
+}
+
+func (pc *ProfileController) GetImageGrantList() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("GET /api/v1/profiles/:telegramUserId/images/grants")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		telegramUserId := ctf.Params("telegramUserId")
+		profileMapper := &mapper.ProfileMapper{}
+		grantListRequest := profileMapper.MapToImageGrantListRequest(telegramUserId)
+		grantList, err := pc.proto.GetImageGrantList(ctx, grantListRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("GetImageGrantList", "proto.GetImageGrantList")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseOk(ctf, grantList)
+	}
+}
+
+// getImageErrorStatus - maps the gRPC status of the image management methods to the http status
+func (pc *ProfileController) getImageErrorStatus(err error) int {
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.NotFound:
+			return http.StatusNotFound
+		case codes.InvalidArgument:
+			return http.StatusBadRequest
+		case codes.FailedPrecondition:
+			return http.StatusConflict
+		}
+	}
+	return http.StatusInternalServerError
+}
+
 func (pc *ProfileController) GetFilter() fiber.Handler {
 	return func(ctf *fiber.Ctx) error {
 		pc.logger.Info("GET /api/v1/profiles/filters/:telegramUserId")
@@ -463,11 +664,6 @@ func (pc *ProfileController) UpdateFilter() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("UpdateFilter", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		filterRequest := profileMapper.MapToFilterUpdateRequest(req)
 		filterResponse, err := pc.proto.UpdateFilter(ctx, filterRequest)
@@ -491,11 +687,6 @@ func (pc *ProfileController) AddBlock() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("AddBlock", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		blockRequest := profileMapper.MapToBlockAddRequest(req)
 		blockAdded, err := pc.proto.AddBlock(ctx, blockRequest)
@@ -537,11 +728,6 @@ func (pc *ProfileController) Unblock() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("Unblock", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		unblockRequest := profileMapper.MapToUnblockRequest(req)
 		unblockResponse, err := pc.proto.Unblock(ctx, unblockRequest)
@@ -565,74 +751,11 @@ func (pc *ProfileController) AddLike() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("AddLike", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		locale := ctf.Get("Accept-Language")
 		if locale == "" {
 			locale = defaultLocale
 		}
 		profileMapper := &mapper.ProfileMapper{}
-		telegramRequest := profileMapper.MapToTelegramGetRequest(req.TelegramUserId)
-		telegramProfile, err := pc.proto.GetTelegram(ctx, telegramRequest)
-		if err != nil {
-			errorMessage := pc.getErrorMessage("AddLike",
-				"proto.GetTelegram")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-		}
-		likedTelegramRequest := profileMapper.MapToTelegramGetRequest(req.LikedTelegramUserId)
-		likedTelegramProfile, err := pc.proto.GetTelegram(ctx, likedTelegramRequest)
-		if err != nil {
-			errorMessage := pc.getErrorMessage("AddLike",
-				"proto.GetTelegram likedTelegramRequest")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-		}
-		imageRequest := profileMapper.MapToGetImageLastRequest(req.TelegramUserId)
-		lastImage, err := pc.proto.GetImageLastByTelegramUserId(ctx, imageRequest)
-		if err != nil {
-			errorMessage := pc.getErrorMessage("AddLike",
-				"proto.GetImageLastByTelegramUserId")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-		}
-		statusRequest := profileMapper.MapToGetStatusRequest(req.TelegramUserId)
-		statusTelegramUserId, err := pc.proto.GetStatusByTelegramUserId(ctx, statusRequest)
-		if err != nil {
-			errorMessage := pc.getErrorMessage("AddLike",
-				"proto.GetStatusByTelegramUserId")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-		}
-		if !statusTelegramUserId.IsBlocked {
-			hc := &entity.HubContent{
-				LikedTelegramUserId: req.LikedTelegramUserId,
-				Message:             pc.GetMessageLike(likedTelegramProfile.LanguageCode),
-				Type:                "like",
-				UserImageUrl:        lastImage.Url,
-				Username:            telegramProfile.Username,
-			}
-			hubContentJson, err := json.Marshal(hc)
-			if err != nil {
-				errorMessage := pc.getErrorMessage("AddLike", "json.Marshal")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-			err = pc.kafkaWriter.WriteMessages(context.Background(),
-				kafka.Message{
-					Key:   []byte(req.LikedTelegramUserId),
-					Value: hubContentJson,
-				},
-			)
-			if err != nil {
-				errorMessage := pc.getErrorMessage("AddLike", "kafkaWriter.WriteMessages")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-		}
 		likeRequest := profileMapper.MapToLikeAddRequest(req, locale)
 		likeAdded, err := pc.proto.AddLike(ctx, likeRequest)
 		if err != nil {
@@ -655,87 +778,15 @@ func (pc *ProfileController) UpdateLike() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("UpdateLike", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
-		locale := ctf.Get("Accept-Language")
-		if locale == "" {
-			locale = defaultLocale
-		}
 		profileMapper := &mapper.ProfileMapper{}
-		if req.IsLiked {
-			telegramRequest := profileMapper.MapToTelegramGetRequest(req.TelegramUserId)
-			telegramProfile, err := pc.proto.GetTelegram(ctx, telegramRequest)
-			if err != nil {
-				errorMessage := pc.getErrorMessage("UpdateLike",
-					"proto.GetTelegram")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-			likedTelegramRequest := profileMapper.MapToTelegramGetRequest(req.LikedTelegramUserId)
-			likedTelegramProfile, err := pc.proto.GetTelegram(ctx, likedTelegramRequest)
-			if err != nil {
-				errorMessage := pc.getErrorMessage("UpdateLike",
-					"proto.GetTelegram likedTelegramRequest")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-			imageRequest := profileMapper.MapToGetImageLastRequest(req.TelegramUserId)
-			lastImage, err := pc.proto.GetImageLastByTelegramUserId(ctx, imageRequest)
-			if err != nil {
-				errorMessage := pc.getErrorMessage("UpdateLike",
-					"proto.GetImageLastByTelegramUserId")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-			statusRequest := profileMapper.MapToGetStatusRequest(req.TelegramUserId)
-			statusTelegramUserId, err := pc.proto.GetStatusByTelegramUserId(ctx, statusRequest)
-			if err != nil {
-				errorMessage := pc.getErrorMessage("UpdateLike",
-					"proto.GetStatusByTelegramUserId")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-			if err != nil {
-				errorMessage := pc.getErrorMessage("UpdateLike",
-					"proto.GetImageLastByTelegramUserId")
-				pc.logger.Debug(errorMessage, zap.Error(err))
-				return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-			}
-			if !statusTelegramUserId.IsBlocked {
-				hc := &entity.HubContent{
-					LikedTelegramUserId: req.LikedTelegramUserId,
-					Message:             pc.GetMessageLike(likedTelegramProfile.LanguageCode),
-					Type:                "like",
-					UserImageUrl:        lastImage.Url,
-					Username:            telegramProfile.Username,
-				}
-				hubContentJson, err := json.Marshal(hc)
-				if err != nil {
-					errorMessage := pc.getErrorMessage("UpdateLike", "json.Marshal")
-					pc.logger.Debug(errorMessage, zap.Error(err))
-					return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-				}
-				err = pc.kafkaWriter.WriteMessages(context.Background(),
-					kafka.Message{
-						Key:   []byte(req.LikedTelegramUserId),
-						Value: hubContentJson,
-					},
-				)
-				if err != nil {
-					errorMessage := pc.getErrorMessage("UpdateLike", "kafkaWriter.WriteMessages")
-					pc.logger.Debug(errorMessage, zap.Error(err))
-					return v1.ResponseError(ctf, err, http.StatusInternalServerError)
-				}
-			}
-		}
 		likeRequest := profileMapper.MapToLikeUpdateRequest(req)
 		likeUpdated, err := pc.proto.UpdateLike(ctx, likeRequest)
 		if err != nil {
 			errorMessage := pc.getErrorMessage("UpdateLike", "proto.UpdateLike")
 			pc.logger.Debug(errorMessage, zap.Error(err))
+			if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+				return v1.ResponseError(ctf, err, http.StatusNotFound)
+			}
 			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
 		}
 		return v1.ResponseCreated(ctf, likeUpdated)
@@ -766,6 +817,171 @@ func (pc *ProfileController) GetLastLike() fiber.Handler {
 	}
 }
 
+func (pc *ProfileController) GetMatchList() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("GET /api/v1/profiles/matches")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.MatchGetListRequestDto{}
+		if err := ctf.QueryParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("GetMatchList", "QueryParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		matchListRequest := profileMapper.MapToGetMatchListRequest(req.TelegramUserId)
+		matchListResponse, err := pc.proto.GetMatchList(ctx, matchListRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("GetMatchList", "proto.GetMatchList")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseOk(ctf, matchListResponse)
+	}
+}
+
+func (pc *ProfileController) Unmatch() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("DELETE /api/v1/profiles/matches")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.UnmatchRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("Unmatch", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		unmatchRequest := profileMapper.MapToUnmatchRequest(req)
+		unmatchResponse, err := pc.proto.Unmatch(ctx, unmatchRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("Unmatch", "proto.Unmatch")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseOk(ctf, unmatchResponse)
+	}
+}
+
+func (pc *ProfileController) SendMessage() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("POST /api/v1/profiles/messages")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.MessageAddRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("SendMessage", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		messageRequest := profileMapper.MapToSendMessageRequest(req)
+		messageResponse, err := pc.proto.SendMessage(ctx, messageRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("SendMessage", "proto.SendMessage")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getMessageErrorStatus(err))
+		}
+		return v1.ResponseCreated(ctf, messageResponse)
+	}
+}
+
+func (pc *ProfileController) GetConversationList() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("GET /api/v1/profiles/conversations")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ConversationGetListRequestDto{}
+		if err := ctf.QueryParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("GetConversationList", "QueryParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		conversationListRequest := profileMapper.MapToGetConversationListRequest(req.TelegramUserId)
+		conversationListResponse, err := pc.proto.GetConversationList(ctx, conversationListRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("GetConversationList", "proto.GetConversationList")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseOk(ctf, conversationListResponse)
+	}
+}
+
+func (pc *ProfileController) GetMessageList() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("GET /api/v1/profiles/conversations/:conversationId/messages")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.MessageGetListRequestDto{}
+		if err := ctf.QueryParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("GetMessageList", "QueryParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		conversationId, err := pc.convertToUint64("conversationId", ctf.Params("conversationId"))
+		if err != nil {
+			errorMessage := pc.getErrorMessage("GetMessageList", "convertToUint64")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		messageListRequest := profileMapper.MapToGetMessageListRequest(req, conversationId)
+		messageListResponse, err := pc.proto.GetMessageList(ctx, messageListRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("GetMessageList", "proto.GetMessageList")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getMessageErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, messageListResponse)
+	}
+}
+
+func (pc *ProfileController) MarkRead() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("PUT /api/v1/profiles/conversations/:conversationId/read")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.MessageMarkReadRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("MarkRead", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		conversationId, err := pc.convertToUint64("conversationId", ctf.Params("conversationId"))
+		if err != nil {
+			errorMessage := pc.getErrorMessage("MarkRead", "convertToUint64")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		markReadRequest := profileMapper.MapToMarkReadRequest(req, conversationId)
+		markReadResponse, err := pc.proto.MarkRead(ctx, markReadRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("MarkRead", "proto.MarkRead")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, pc.getMessageErrorStatus(err))
+		}
+		return v1.ResponseOk(ctf, markReadResponse)
+	}
+}
+
+// getMessageErrorStatus - maps the gRPC status of the chat methods to the http status
+func (pc *ProfileController) getMessageErrorStatus(err error) int {
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.NotFound:
+			return http.StatusNotFound
+		case codes.PermissionDenied:
+			return http.StatusForbidden
+		case codes.InvalidArgument:
+			return http.StatusBadRequest
+		}
+	}
+	return http.StatusInternalServerError
+}
+
 func (pc *ProfileController) AddComplaint() fiber.Handler {
 	return func(ctf *fiber.Ctx) error {
 		pc.logger.Info("POST /api/v1/profiles/complaints")
@@ -777,11 +993,6 @@ func (pc *ProfileController) AddComplaint() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("AddComplaint", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		complaintRequest := profileMapper.MapToComplaintAddRequest(req)
 		complaintAdded, err := pc.proto.AddComplaint(ctx, complaintRequest)
@@ -794,6 +1005,33 @@ func (pc *ProfileController) AddComplaint() fiber.Handler {
 	}
 }
 
+// AddComplaintAppeal - the user blocked by the upheld complaints asks for the case to be reviewed again
+func (pc *ProfileController) AddComplaintAppeal() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		pc.logger.Info("POST /api/v1/profiles/complaints/appeal")
+		ctx, cancel := context.WithTimeout(ctf.Context(), timeoutDuration)
+		defer cancel()
+		req := &request.ComplaintAppealAddRequestDto{}
+		if err := ctf.BodyParser(req); err != nil {
+			errorMessage := pc.getErrorMessage("AddComplaintAppeal", "BodyParser")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			return v1.ResponseError(ctf, err, http.StatusBadRequest)
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		appealRequest := profileMapper.MapToComplaintAppealAddRequest(req)
+		appealAdded, err := pc.proto.AddComplaintAppeal(ctx, appealRequest)
+		if err != nil {
+			errorMessage := pc.getErrorMessage("AddComplaintAppeal", "proto.AddComplaintAppeal")
+			pc.logger.Debug(errorMessage, zap.Error(err))
+			if status.Code(err) == codes.FailedPrecondition {
+				return v1.ResponseError(ctf, err, http.StatusConflict)
+			}
+			return v1.ResponseError(ctf, err, http.StatusInternalServerError)
+		}
+		return v1.ResponseCreated(ctf, appealAdded)
+	}
+}
+
 func (pc *ProfileController) AddPayment() fiber.Handler {
 	return func(ctf *fiber.Ctx) error {
 		pc.logger.Info("POST /api/v1/profiles/payments")
@@ -805,11 +1043,6 @@ func (pc *ProfileController) AddPayment() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("AddPayment", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		paymentRequest := profileMapper.MapToPaymentAddRequest(req)
 		paymentAdded, err := pc.proto.AddPayment(ctx, paymentRequest)
@@ -852,11 +1085,6 @@ func (pc *ProfileController) UpdateSettings() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("UpdateSettings", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		updateSettingsRequest := profileMapper.MapToUpdateSettingsRequest(req)
 		updateSettingsResponse, err := pc.proto.UpdateSettings(ctx, updateSettingsRequest)
@@ -881,11 +1109,6 @@ func (pc *ProfileController) UpdateCoordinates() fiber.Handler {
 			pc.logger.Debug(errorMessage, zap.Error(err))
 			return v1.ResponseError(ctf, err, http.StatusBadRequest)
 		}
-		if err := pc.validateAuthUser(ctf, req.TelegramUserId); err != nil {
-			errorMessage := pc.getErrorMessage("UpdateCoordinates", "validateAuthUser")
-			pc.logger.Debug(errorMessage, zap.Error(err))
-			return v1.ResponseError(ctf, err, http.StatusUnauthorized)
-		}
 		profileMapper := &mapper.ProfileMapper{}
 		updateCoordinatesRequest := profileMapper.MapToUpdateCoordinatesRequest(req)
 		updateCoordinatesResponse, err := pc.proto.UpdateCoordinates(ctx, updateCoordinatesRequest)
@@ -904,13 +1127,15 @@ func (pc *ProfileController) getErrorMessage(repositoryMethodName string, callMe
 		errorFilePath)
 }
 
+// validateAuthUser - the routes are authorized by their policy, see middlewares.NewPolicyMiddleware.
+// This check is left for the resources whose owner is known only after they are loaded
 func (pc *ProfileController) validateAuthUser(ctf *fiber.Ctx, telegramUserId string) error {
-	telegramInitData, ok := ctf.UserContext().Value(enum.ContextKeyTelegram).(initdata.InitData)
+	claims, ok := ctf.UserContext().Value(enum.ContextKeyClaims).(*auth.Claims)
 	if !ok {
-		err := errors.New("missing telegram data in context")
+		err := errors.New("missing claims in context")
 		return err
 	}
-	if telegramUserId != strconv.FormatInt(telegramInitData.User.ID, 10) {
+	if telegramUserId != claims.Subject {
 		err := errors.New("unauthorized")
 		return err
 	}
@@ -950,63 +1175,6 @@ func (pc *ProfileController) getFiles(ctf *fiber.Ctx) ([]*pb.FileMetadata, error
 	return fileList, nil
 }
 
-func (pc *ProfileController) GetMessageLike(locale string) string {
-	switch locale {
-	case "ru":
-		return "Есть симпатия! Начинай общаться"
-	case "en":
-		return "There is sympathy! Start communicating"
-	case "ar":
-		return "هناك تعاطف! ابدأ بالتواصل"
-	case "be":
-		return "Ёсць сімпатыя! Пачынай мець зносіны"
-	case "ca":
-		return "Hi ha simpatia! Comença a comunicar-te"
-	case "cs":
-		return "Jsou tam sympatie! Začněte komunikovat"
-	case "de":
-		return "Es gibt Mitgefühl! Beginnen Sie mit der kommunikation"
-	case "es":
-		return "¡Hay simpatía! Empezar a comunicar"
-	case "fi":
-		return "Sympatiaa on! Aloita kommunikointi"
-	case "fr":
-		return "Il y a de la sympathie ! Commencez à communiquer"
-	case "he":
-		return "יש סימפטיה! תתחיל לתקשר"
-	case "hi":
-		return "सहानुभूति है! संवाद शुरू करें"
-	case "hr":
-		return "Postoji simpatija! Počnite komunicirati"
-	case "hu":
-		return "Együttérzés van! Kezdj el kommunikálni"
-	case "id":
-		return "Ada simpati! Mulailah berkomunikasi"
-	case "it":
-		return "C'è simpatia! Inizia a comunicare"
-	case "ja":
-		return "共感があるよ！通信を開始する"
-	case "kk":
-		return "Жанашырлық бар! Қарым-қатынасты бастаңыз"
-	case "ko":
-		return "동정심이 있습니다! 소통을 시작해 보세요"
-	case "nl":
-		return "Er is sympathie! Begin met communiceren"
-	case "no":
-		return "Det er sympati! Begynn å kommunisere"
-	case "pt":
-		return "Existe simpatia! Comece a se comunicar"
-	case "sv":
-		return "Det finns sympati! Börja kommunicera"
-	case "uk":
-		return "Є симпатія! Починай спілкуватися"
-	case "zh":
-		return "有同情心！开始沟通"
-	default:
-		return "There is sympathy! Start communicating"
-	}
-}
-
 func (pc *ProfileController) convertToUint64(name, value string) (uint64, error) {
 	if value == "" {
 		errorMessage := fmt.Sprintf("%s is empty", name)
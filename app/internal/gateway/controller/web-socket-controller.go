@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/hub"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathWebSocket = "internal/gateway/controller/web-socket-controller.go"
+	writeWait              = 10 * time.Second
+	pongWait               = 60 * time.Second
+	pingPeriod             = (pongWait * 9) / 10
+	maxReadMessageSize     = 512
+)
+
+type WebSocketController struct {
+	logger logger.Logger
+	hub    *hub.Hub
+}
+
+func NewWebSocketController(l logger.Logger, h *hub.Hub) *WebSocketController {
+	return &WebSocketController{
+		logger: l,
+		hub:    h,
+	}
+}
+
+// Connect - keeps the connection of the authenticated user open and pushes to it the events of the hub.
+// The channel is one way, the messages from the client are read only to handle the close and pong frames
+func (wc *WebSocketController) Connect() fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		wc.logger.Info("GET /api/v1/ws")
+		telegramUserId, ok := conn.Locals(enum.LocalsKeyTelegramUserId).(string)
+		if !ok || telegramUserId == "" {
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "unauthorized"),
+				time.Now().Add(writeWait))
+			return
+		}
+		client := wc.hub.Register(telegramUserId)
+		defer wc.hub.Unregister(client)
+		done := make(chan struct{})
+		go wc.readLoop(conn, done)
+		ticker := time.NewTicker(pingPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case hc, ok := <-client.Send:
+				_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if !ok {
+					_ = conn.WriteMessage(websocket.CloseMessage, []byte{})
+					return
+				}
+				if err := conn.WriteJSON(hc); err != nil {
+					errorMessage := wc.getErrorMessage("Connect", "WriteJSON")
+					wc.logger.Debug(errorMessage, zap.Error(err))
+					return
+				}
+			case <-ticker.C:
+				_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+					return
+				}
+			}
+		}
+	})
+}
+
+func (wc *WebSocketController) readLoop(conn *websocket.Conn, done chan struct{}) {
+	defer close(done)
+	conn.SetReadLimit(maxReadMessageSize)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				errorMessage := wc.getErrorMessage("readLoop", "ReadMessage")
+				wc.logger.Debug(errorMessage, zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+func (wc *WebSocketController) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathWebSocket)
+}
@@ -0,0 +1,5 @@
+package request
+
+type AdminBlockRequestDto struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,12 @@
+package request
+
+type AdminComplaintGetListRequestDto struct {
+	TelegramUserId         string `json:"telegramUserId"`
+	CriminalTelegramUserId string `json:"criminalTelegramUserId"`
+	Type                   string `json:"type"`
+	DateFrom               string `json:"dateFrom"`
+	DateTo                 string `json:"dateTo"`
+	Status                 string `json:"status"`
+	Page                   uint64 `json:"page"`
+	Size                   uint64 `json:"size"`
+}
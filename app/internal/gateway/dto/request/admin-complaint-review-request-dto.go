@@ -0,0 +1,6 @@
+package request
+
+type AdminComplaintReviewRequestDto struct {
+	Decision string `json:"decision"`
+	Reason   string `json:"reason"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type ComplaintAppealAddRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+	Description    string `json:"description"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type ConversationGetListRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type ImageGrantRequestDto struct {
+	TelegramUserId       string `json:"telegramUserId"`
+	ViewerTelegramUserId string `json:"viewerTelegramUserId"`
+}
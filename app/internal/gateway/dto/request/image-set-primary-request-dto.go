@@ -0,0 +1,6 @@
+package request
+
+type ImageSetPrimaryRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+	ImageId        uint64 `json:"imageId"`
+}
@@ -0,0 +1,7 @@
+package request
+
+type ImageUpdatePrivacyRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+	ImageId        uint64 `json:"imageId"`
+	IsPrivate      bool   `json:"isPrivate"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type ImageUploadRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type MatchGetListRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+}
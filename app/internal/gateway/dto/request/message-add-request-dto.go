@@ -0,0 +1,7 @@
+package request
+
+type MessageAddRequestDto struct {
+	TelegramUserId          string `json:"telegramUserId"`
+	RecipientTelegramUserId string `json:"recipientTelegramUserId"`
+	Text                    string `json:"text"`
+}
@@ -0,0 +1,7 @@
+package request
+
+type MessageGetListRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+	Cursor         string `json:"cursor"`
+	Limit          uint64 `json:"limit"`
+}
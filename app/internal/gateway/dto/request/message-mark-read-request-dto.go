@@ -0,0 +1,6 @@
+package request
+
+type MessageMarkReadRequestDto struct {
+	TelegramUserId    string `json:"telegramUserId"`
+	LastReadMessageId uint64 `json:"lastReadMessageId"`
+}
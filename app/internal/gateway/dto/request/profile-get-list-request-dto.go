@@ -7,4 +7,5 @@ type ProfileGetListRequestDto struct {
 	City           *string  `json:"city"`
 	Latitude       *float64 `json:"latitude"`
 	Longitude      *float64 `json:"longitude"`
+	Cursor         string   `json:"cursor"`
 }
@@ -0,0 +1,5 @@
+package request
+
+type SessionDeleteRequestDto struct {
+	RefreshToken string `json:"refreshToken"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type SessionRefreshRequestDto struct {
+	RefreshToken string `json:"refreshToken"`
+}
@@ -1,8 +1,9 @@
 package response
 
 type ImageResponseDto struct {
-	Id             uint64 `json:"id"`
-	TelegramUserId string `json:"telegramUserId"`
-	Name           string `json:"name"`
-	Url            string `json:"url"`
+	Id             uint64                     `json:"id"`
+	TelegramUserId string                     `json:"telegramUserId"`
+	Name           string                     `json:"name"`
+	Url            string                     `json:"url"`
+	Variants       []*ImageVariantResponseDto `json:"variants"`
 }
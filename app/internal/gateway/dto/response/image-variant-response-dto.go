@@ -0,0 +1,8 @@
+package response
+
+type ImageVariantResponseDto struct {
+	Variant string `json:"variant"`
+	Url     string `json:"url"`
+	Width   int32  `json:"width"`
+	Height  int32  `json:"height"`
+}
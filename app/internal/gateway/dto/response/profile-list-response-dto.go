@@ -1,8 +1,9 @@
 package response
 
-import "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/entity"
-
 type ProfileListResponseDto struct {
-	*entity.PaginationEntity
-	Content []*ProfileListItemResponseDto `json:"content"`
+	Content            []*ProfileListItemResponseDto `json:"content"`
+	NextCursor         string                        `json:"nextCursor"`
+	HasNext            bool                          `json:"hasNext"`
+	TotalEntities      uint64                        `json:"totalEntities"`
+	IsTotalApproximate bool                          `json:"isTotalApproximate"`
 }
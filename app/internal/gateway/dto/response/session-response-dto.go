@@ -0,0 +1,11 @@
+package response
+
+import "time"
+
+type SessionResponseDto struct {
+	TokenType             string    `json:"tokenType"`
+	AccessToken           string    `json:"accessToken"`
+	AccessTokenExpiresAt  time.Time `json:"accessTokenExpiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
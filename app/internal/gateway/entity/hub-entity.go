@@ -1,19 +1,12 @@
 package entity
 
-type HubContent struct {
-	LikedTelegramUserId string `json:"likedTelegramUserId"`
-	Message             string `json:"message"`
-	Type                string `json:"type"`
-	UserImageUrl        string `json:"userImageUrl"`
-	Username            string `json:"username"`
-}
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
 
-type Hub struct {
-	Broadcast chan *HubContent
-}
-
-func NewHub() *Hub {
-	return &Hub{
-		Broadcast: make(chan *HubContent, 5),
-	}
+type HubContent struct {
+	LikedTelegramUserId string              `json:"likedTelegramUserId"`
+	Message             string              `json:"message"`
+	Type                enum.HubContentType `json:"type"`
+	UserImageUrl        string              `json:"userImageUrl"`
+	Username            string              `json:"username"`
+	ConversationId      uint64              `json:"conversationId,omitempty"`
 }
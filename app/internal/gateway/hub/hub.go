@@ -0,0 +1,135 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/events"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/mapper"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"sync"
+)
+
+const (
+	errorFilePathHub = "internal/gateway/hub/hub.go"
+	clientBufferSize = 16
+)
+
+// MessageReader - the source of the hub events, implemented by *kafka.Reader
+type MessageReader interface {
+	ReadMessage(ctx context.Context) (kafka.Message, error)
+}
+
+// Client - a single connection of the user. A user may be connected from several devices
+type Client struct {
+	TelegramUserId string
+	Send           chan *entity.HubContent
+}
+
+// Hub - delivers the events to all the connections of their recipient
+type Hub struct {
+	logger  logger.Logger
+	mu      sync.RWMutex
+	clients map[string]map[*Client]struct{}
+}
+
+func NewHub(l logger.Logger) *Hub {
+	return &Hub{
+		logger:  l,
+		clients: make(map[string]map[*Client]struct{}),
+	}
+}
+
+func (h *Hub) Register(telegramUserId string) *Client {
+	c := &Client{
+		TelegramUserId: telegramUserId,
+		Send:           make(chan *entity.HubContent, clientBufferSize),
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.clients[telegramUserId]; !ok {
+		h.clients[telegramUserId] = make(map[*Client]struct{})
+	}
+	h.clients[telegramUserId][c] = struct{}{}
+	return c
+}
+
+func (h *Hub) Unregister(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	userClients, ok := h.clients[c.TelegramUserId]
+	if !ok {
+		return
+	}
+	if _, ok := userClients[c]; !ok {
+		return
+	}
+	delete(userClients, c)
+	close(c.Send)
+	if len(userClients) == 0 {
+		delete(h.clients, c.TelegramUserId)
+	}
+}
+
+// Publish - sends the event to every connection of the recipient. A slow connection
+// does not block the others, the event is dropped for it instead
+func (h *Hub) Publish(hc *entity.HubContent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.clients[hc.LikedTelegramUserId] {
+		select {
+		case c.Send <- hc:
+		default:
+			h.logger.Debug("hub client buffer is full, event dropped",
+				zap.String("telegramUserId", c.TelegramUserId), zap.String("type", string(hc.Type)))
+		}
+	}
+}
+
+// CountClients - returns the number of the open connections of the user
+func (h *Hub) CountClients(telegramUserId string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients[telegramUserId])
+}
+
+// Run - reads the events until ctx is canceled and publishes them to the connected users
+func (h *Hub) Run(ctx context.Context, reader MessageReader) error {
+	for {
+		m, err := reader.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+				return nil
+			}
+			errorMessage := h.getErrorMessage("Run", "ReadMessage")
+			h.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		e := &events.Event{}
+		if err := proto.Unmarshal(m.Value, e); err != nil {
+			errorMessage := h.getErrorMessage("Run", "proto.Unmarshal")
+			h.logger.Debug(errorMessage, zap.Error(err))
+			continue
+		}
+		if e.GetVersion() != events.EventVersion_EVENT_VERSION_V1 {
+			h.logger.Debug("hub skipped the event of an unsupported version",
+				zap.String("id", e.GetId()), zap.Int32("version", int32(e.GetVersion())))
+			continue
+		}
+		eventMapper := &mapper.EventMapper{}
+		hc := eventMapper.MapToHubContent(e)
+		if hc == nil || hc.LikedTelegramUserId == "" {
+			continue
+		}
+		h.Publish(hc)
+	}
+}
+
+func (h *Hub) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathHub)
+}
@@ -1,85 +1,71 @@
 package middlewares
 
 import (
-	"context"
 	"errors"
-	"fmt"
-	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/config"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller"
 	v1 "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/http/api/v1"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
-	"github.com/Luzifer/go-openssl/v4"
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
-	initdata "github.com/telegram-mini-apps/init-data-golang"
 	"go.uber.org/zap"
 	"net/http"
+	"strings"
 )
 
+var ErrAccessTokenMissing = errors.New("access token is missing")
+
 func InitFiberMiddlewares(
 	app *fiber.App,
-	config *config.Config,
 	logger logger.Logger,
+	tokenIssuer *auth.TokenIssuer,
+	authController *controller.AuthController,
 	profileController *controller.ProfileController,
-	initPublicRoutes func(app *fiber.App, profileController *controller.ProfileController),
-	initProtectedRoutes func(app *fiber.App, profileController *controller.ProfileController),
+	adminController *controller.AdminController,
+	webSocketController *controller.WebSocketController,
+	initRoutes func(app *fiber.App, policy Policy, webSocketMiddleware fiber.Handler,
+		authController *controller.AuthController, profileController *controller.ProfileController,
+		adminController *controller.AdminController, webSocketController *controller.WebSocketController),
 ) {
-	// routes that don't require a JWT token
-	initPublicRoutes(app, profileController)
-
-	app.Use(NewJwtMiddleware(config, logger))
-	// routes that require authentication/authorization
-	initProtectedRoutes(app, profileController)
+	// every route declares its policy, websocket routes are authenticated by their own middleware,
+	// browsers can't set headers on upgrade
+	initRoutes(app, NewPolicyMiddleware(logger, tokenIssuer), NewWebSocketMiddleware(logger, tokenIssuer),
+		authController, profileController, adminController, webSocketController)
 }
 
-func NewJwtMiddleware(config *config.Config, logger logger.Logger) fiber.Handler {
+// NewWebSocketMiddleware - authenticates the websocket upgrade request with the same access token as
+// the authenticated routes. The token is taken from the Authorization header or from the token query param
+func NewWebSocketMiddleware(logger logger.Logger, tokenIssuer *auth.TokenIssuer) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		return successHandler(c, config, logger)
+		if !websocket.IsWebSocketUpgrade(c) {
+			return fiber.ErrUpgradeRequired
+		}
+		accessToken := getBearerToken(c.Get("Authorization"))
+		if accessToken == "" {
+			accessToken = c.Query("token")
+		}
+		claims, err := verifyAccessToken(accessToken, tokenIssuer)
+		if err != nil {
+			logger.Debug("invalid websocket token", zap.Error(err))
+			return v1.ResponseError(c, err, http.StatusUnauthorized)
+		}
+		c.Locals(enum.LocalsKeyTelegramUserId, claims.Subject)
+		return c.Next()
 	}
 }
 
-func successHandler(c *fiber.Ctx, config *config.Config, logger logger.Logger) error {
-	encryptedToken := c.Get("Authorization")
-	secretKey := config.CryptoSecretKey
-	fmt.Println("encryptedToken: ", encryptedToken)
-	fmt.Println("secretKey: ", secretKey)
-	authData, err := decrypt(encryptedToken, secretKey)
-	if err != nil {
-		errorMessage := "invalid decrypt token"
-		err := errors.New(errorMessage)
-		logger.Debug(errorMessage, zap.Error(err))
-		logger.Debug("Received request", zap.String("path", c.Path()))
-		return v1.ResponseError(c, err, http.StatusUnauthorized)
-	}
-	// Validate init data. We consider init data sign valid for 1 hour from their creation moment
-	//if err := initdata.Validate(authData, config.TelegramBotToken, time.Hour*1); err != nil {
-	//	errorMessage := "invalid token"
-	//	err := errors.New(errorMessage)
-	//	logger.Debug(errorMessage, zap.Error(err))
-	//	logger.Debug("Received request", zap.String("path", c.Path()))
-	//	return v1.ResponseError(c, err, http.StatusUnauthorized)
-	//}
-	// Parse init data
-	telegramInitData, err := initdata.Parse(authData)
-	if err != nil {
-		errorMessage := "invalid parse token"
-		err := errors.New(errorMessage)
-		logger.Debug(errorMessage, zap.Error(err))
-		return v1.ResponseError(c, err, http.StatusUnauthorized)
+func verifyAccessToken(accessToken string, tokenIssuer *auth.TokenIssuer) (*auth.Claims, error) {
+	if accessToken == "" {
+		return nil, ErrAccessTokenMissing
 	}
-	// Save to context
-	var ctx = c.UserContext()
-	var contextWithClaims = context.WithValue(ctx, enum.ContextKeyTelegram, telegramInitData)
-	c.SetUserContext(contextWithClaims)
-	return c.Next()
+	return tokenIssuer.VerifyAccessToken(accessToken)
 }
 
-func decrypt(encryptedString, secretKey string) (string, error) {
-	o := openssl.New()
-	key := openssl.BytesToKeyMD5
-	dec, err := o.DecryptBytes(secretKey, []byte(encryptedString), key)
-	if err != nil {
-		return "", err
+func getBearerToken(authorization string) string {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
 	}
-	return string(dec), nil
+	return strings.TrimSpace(token)
 }
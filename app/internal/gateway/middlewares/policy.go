@@ -0,0 +1,91 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/auth"
+	v1 "github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/controller/http/api/v1"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/gateway/shared/enum"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+const telegramUserIdKey = "telegramUserId"
+
+var (
+	ErrTelegramUserIdMissing = errors.New("telegramUserId is missing in the request")
+	ErrForbidden             = errors.New("the request belongs to another user")
+)
+
+// Policy - returns the handler enforcing the policy of the route, see enum.RoutePolicy
+type Policy func(policy enum.RoutePolicy) fiber.Handler
+
+// NewPolicyMiddleware - the policies are declared by the routes and enforced here, so the handlers
+// don't have to check the access token themselves
+func NewPolicyMiddleware(logger logger.Logger, tokenIssuer *auth.TokenIssuer) Policy {
+	return func(policy enum.RoutePolicy) fiber.Handler {
+		if !policy.IsValid() {
+			// Misconfigured routes are denied instead of being silently public
+			logger.Error("invalid route policy", zap.String("policy", string(policy)))
+			policy = enum.RoutePolicySelfOnly
+		}
+		return func(c *fiber.Ctx) error {
+			if policy == enum.RoutePolicyPublic {
+				return c.Next()
+			}
+			claims, err := verifyAccessToken(getBearerToken(c.Get("Authorization")), tokenIssuer)
+			if err != nil {
+				logger.Debug("invalid token", zap.Error(err), zap.String("path", c.Path()))
+				return v1.ResponseError(c, err, http.StatusUnauthorized)
+			}
+			if policy == enum.RoutePolicySelfOnly {
+				telegramUserIdList, err := getRequestTelegramUserIdList(c)
+				if err != nil {
+					logger.Debug("invalid request", zap.Error(err), zap.String("path", c.Path()))
+					return v1.ResponseError(c, err, http.StatusBadRequest)
+				}
+				// Every id of the request must be the user of the token, the handlers read it from
+				// different places, so a query id of the user must not cover the body id of another one
+				for _, telegramUserId := range telegramUserIdList {
+					if telegramUserId != claims.Subject {
+						logger.Debug("forbidden request", zap.Error(ErrForbidden), zap.String("path", c.Path()))
+						return v1.ResponseError(c, ErrForbidden, http.StatusForbidden)
+					}
+				}
+			}
+			var ctx = c.UserContext()
+			var contextWithClaims = context.WithValue(ctx, enum.ContextKeyClaims, claims)
+			c.SetUserContext(contextWithClaims)
+			return c.Next()
+		}
+	}
+}
+
+// getRequestTelegramUserIdList - returns every id of the user the request is made for: the route param,
+// the query and the body, the places the handlers read it from
+func getRequestTelegramUserIdList(c *fiber.Ctx) ([]string, error) {
+	telegramUserIdList := make([]string, 0, 3)
+	if telegramUserId := c.Params(telegramUserIdKey); telegramUserId != "" {
+		telegramUserIdList = append(telegramUserIdList, telegramUserId)
+	}
+	if telegramUserId := c.Query(telegramUserIdKey); telegramUserId != "" {
+		telegramUserIdList = append(telegramUserIdList, telegramUserId)
+	}
+	if len(c.Body()) > 0 {
+		req := &struct {
+			TelegramUserId string `json:"telegramUserId" form:"telegramUserId"`
+		}{}
+		if err := c.BodyParser(req); err != nil {
+			return nil, err
+		}
+		if req.TelegramUserId != "" {
+			telegramUserIdList = append(telegramUserIdList, req.TelegramUserId)
+		}
+	}
+	if len(telegramUserIdList) == 0 {
+		return nil, ErrTelegramUserIdMissing
+	}
+	return telegramUserIdList, nil
+}
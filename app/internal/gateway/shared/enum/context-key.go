@@ -5,5 +5,4 @@ type ContextKey int
 const (
 	ContextKeyRequestId ContextKey = iota
 	ContextKeyClaims
-	ContextKeyTelegram
 )
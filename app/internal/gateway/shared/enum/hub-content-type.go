@@ -0,0 +1,15 @@
+package enum
+
+type HubContentType string
+
+const (
+	HubContentTypeLike       HubContentType = "like"
+	HubContentTypeMatch      HubContentType = "match"
+	HubContentTypeMessage    HubContentType = "message"
+	HubContentTypeModeration HubContentType = "moderation"
+)
+
+func (t HubContentType) IsValid() bool {
+	return t == HubContentTypeLike || t == HubContentTypeMatch || t == HubContentTypeMessage ||
+		t == HubContentTypeModeration
+}
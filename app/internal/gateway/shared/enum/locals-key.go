@@ -0,0 +1,5 @@
+package enum
+
+// LocalsKeyTelegramUserId - the key of the authenticated user id in the fiber locals,
+// used by the websocket connections which have no user context
+const LocalsKeyTelegramUserId = "telegramUserId"
@@ -0,0 +1,16 @@
+package enum
+
+type RoutePolicy string
+
+const (
+	// RoutePolicyPublic - the route is available without the access token
+	RoutePolicyPublic RoutePolicy = "public"
+	// RoutePolicyAuthenticated - the route requires a valid access token of any user
+	RoutePolicyAuthenticated RoutePolicy = "authenticated"
+	// RoutePolicySelfOnly - the route requires the access token of the user whose telegramUserId is in the request
+	RoutePolicySelfOnly RoutePolicy = "self-only"
+)
+
+func (p RoutePolicy) IsValid() bool {
+	return p == RoutePolicyPublic || p == RoutePolicyAuthenticated || p == RoutePolicySelfOnly
+}
@@ -4,32 +4,43 @@ import (
 	"context"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/config"
-	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/moderation"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/repository/psql"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"os"
 	"strings"
+	"time"
 )
 
 const (
 	errorFilePathApp = "internal/profiles/app/app.go"
 	bodyLimit        = 61 * 1024 * 1024 // 61 MB
+	// kafkaBatchSize, kafkaBatchTimeout - the writer flushes a batch without waiting for the default second,
+	// the outbox relay writes its whole batch at once
+	kafkaBatchSize    = 100
+	kafkaBatchTimeout = 10 * time.Millisecond
 )
 
 // App - application structure
 type App struct {
-	config     *config.Config
-	db         *Database
-	fiber      *fiber.App
-	gRPCServer *grpc.Server
-	Logger     logger.Logger
+	config      *config.Config
+	db          *Database
+	fiber       *fiber.App
+	gRPCServer  *grpc.Server
+	kafkaWriter *kafka.Writer
+	storage     service.ObjectStorage
+	ranker      service.ProfileRanker
+	Logger      logger.Logger
 }
 
 // New - create new application
@@ -121,25 +132,48 @@ func New() *App {
 		AllowMethods: "GET, POST, PUT, DELETE, OPTIONS",
 	}))
 
+	// Object storage of the images
+	objectStorage, err := newObjectStorage(cfg)
+	if err != nil {
+		errorMessage := getErrorMessage("New", "newObjectStorage", errorFilePathApp)
+		defaultLogger.Fatal(errorMessage, zap.Error(err))
+	}
+
+	// Ranking of the discovery feed
+	profileRanker, err := newProfileRanker(cfg)
+	if err != nil {
+		errorMessage := getErrorMessage("New", "newProfileRanker", errorFilePathApp)
+		defaultLogger.Fatal(errorMessage, zap.Error(err))
+	}
+
+	// Kafka. The topic is taken from every outbox row
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.Kafka1, cfg.Kafka2, cfg.Kafka3),
+		Balancer:     &kafka.LeastBytes{},
+		BatchSize:    kafkaBatchSize,
+		BatchTimeout: kafkaBatchTimeout,
+		Compression:  kafka.Gzip,
+		RequiredAcks: kafka.RequireAll,
+	}
+
 	return &App{
-		config:     cfg,
-		db:         database,
-		fiber:      f,
-		gRPCServer: s,
-		Logger:     loggerLevel,
+		config:      cfg,
+		db:          database,
+		fiber:       f,
+		gRPCServer:  s,
+		kafkaWriter: w,
+		storage:     objectStorage,
+		ranker:      profileRanker,
+		Logger:      loggerLevel,
 	}
 }
 
 // Run launches the application
 func (app *App) Run(ctx context.Context) {
 	g, ctx := errgroup.WithContext(ctx)
-	// Hub for telegram bot
-	hub := entity.NewHub()
-	//msgChan := make(chan *entity.HubContent, 1) // msgChan - канал для передачи сообщений
-
 	// Start server
 	g.Go(func() error {
-		if err := app.StartServer(ctx, hub); err != nil {
+		if err := app.StartServer(ctx); err != nil {
 			errorMessage := getErrorMessage("Run", "StartServer",
 				errorFilePathApp)
 			app.Logger.Fatal(errorMessage, zap.Error(err))
@@ -148,30 +182,52 @@ func (app *App) Run(ctx context.Context) {
 		return nil
 	})
 
-	// Start telegram bot
-	//g.Go(func() error {
-	//	if err := app.StartBot(ctx, msgChan); err != nil {
-	//		errorMessage := getErrorMessage("Run", "StartServer",
-	//			errorFilePathApp)
-	//		app.Logger.Fatal(errorMessage, zap.Error(err))
-	//		return err
-	//	}
-	//	return nil
-	//})
-
-	// Start Hub
-	//g.Go(func() error {
-	//	select {
-	//	case <-ctx.Done():
-	//		return nil
-	//	case c, ok := <-hub.Broadcast:
-	//		if !ok {
-	//			return nil
-	//		}
-	//		msgChan <- c
-	//	}
-	//	return nil
-	//})
+	// Start outbox relay
+	g.Go(func() error {
+		uwf := service.NewUnitOfWorkFactory(app.Logger, app.db.psql)
+		relay := service.NewOutboxRelay(app.Logger, uwf, app.kafkaWriter)
+		if err := relay.Run(ctx); err != nil {
+			errorMessage := getErrorMessage("Run", "relay.Run",
+				errorFilePathApp)
+			app.Logger.Error(errorMessage, zap.Error(err))
+			return err
+		}
+		return app.kafkaWriter.Close()
+	})
+
+	// Start image moderation
+	g.Go(func() error {
+		uwf := service.NewUnitOfWorkFactory(app.Logger, app.db.psql)
+		loader := moderation.NewStorageImageLoader(app.storage, app.config.ImageModerationMaxSize)
+		worker := service.NewImageModerationWorker(app.Logger, uwf, loader,
+			moderation.NewRuleModerator(app.config.ImageModerationMinSize, app.config.ImageModerationMaxSize),
+			moderation.NewHashBlocklistModerator(psql.NewImageHashBlocklistRepository(app.Logger, app.db.psql)))
+		if err := worker.Run(ctx); err != nil {
+			errorMessage := getErrorMessage("Run", "worker.Run",
+				errorFilePathApp)
+			app.Logger.Error(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+
+	// Start storage reconciliation
+	if app.config.StorageReconciliationInterval > 0 {
+		g.Go(func() error {
+			uwf := service.NewUnitOfWorkFactory(app.Logger, app.db.psql)
+			worker := service.NewStorageReconciliationWorker(app.Logger, uwf, app.storage,
+				app.config.StorageReconciliationGracePeriod)
+			if err := worker.Run(ctx, app.config.StorageReconciliationInterval,
+				app.config.StorageReconciliationDryRun); err != nil {
+				errorMessage := getErrorMessage("Run", "worker.Run",
+					errorFilePathApp)
+				app.Logger.Error(errorMessage, zap.Error(err))
+				return err
+			}
+			return nil
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		errorMessage := getErrorMessage("Run", "g.Wait",
 			errorFilePathApp)
@@ -179,6 +235,20 @@ func (app *App) Run(ctx context.Context) {
 	}
 }
 
+// ReconcileStorage - runs the storage reconciliation once, it is used by the reconcile command
+func (app *App) ReconcileStorage(ctx context.Context, isDryRun bool) error {
+	uwf := service.NewUnitOfWorkFactory(app.Logger, app.db.psql)
+	worker := service.NewStorageReconciliationWorker(app.Logger, uwf, app.storage,
+		app.config.StorageReconciliationGracePeriod)
+	if _, err := worker.Reconcile(ctx, isDryRun); err != nil {
+		errorMessage := getErrorMessage("ReconcileStorage", "worker.Reconcile",
+			errorFilePathApp)
+		app.Logger.Error(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func getErrorMessage(repositoryMethodName, callMethodName, errorFilePath string) string {
 	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
 		errorFilePath)
@@ -3,9 +3,7 @@ package app
 import (
 	"context"
 	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
-	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/config"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/controller"
-	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/repository/psql"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service"
 	"go.uber.org/zap"
@@ -16,9 +14,8 @@ const (
 	errorFilePathHttp = "internal/profiles/app/gRPC.go"
 )
 
-func (app *App) StartServer(ctx context.Context, hub *entity.Hub) error {
+func (app *App) StartServer(ctx context.Context) error {
 	app.fiber.Static("/static", "./static")
-	s3Client := config.NewS3(app.config)
 	ufw := service.NewUnitOfWorkFactory(app.Logger, app.db.psql)
 	navigatorRepository := psql.NewNavigatorRepository(app.Logger, app.db.psql)
 	filterRepository := psql.NewFilterRepository(app.Logger, app.db.psql)
@@ -26,6 +23,9 @@ func (app *App) StartServer(ctx context.Context, hub *entity.Hub) error {
 	imageRepository := psql.NewImageRepository(app.Logger, app.db.psql)
 	imageStatusRepository := psql.NewImageStatusRepository(app.Logger, app.db.psql)
 	likeRepository := psql.NewLikeRepository(app.Logger, app.db.psql)
+	matchRepository := psql.NewMatchRepository(app.Logger, app.db.psql)
+	conversationRepository := psql.NewConversationRepository(app.Logger, app.db.psql)
+	messageRepository := psql.NewMessageRepository(app.Logger, app.db.psql)
 	blockRepository := psql.NewBlockRepository(app.Logger, app.db.psql)
 	complaintRepository := psql.NewComplaintRepository(app.Logger, app.db.psql)
 	statusRepository := psql.NewStatusRepository(app.Logger, app.db.psql)
@@ -34,13 +34,14 @@ func (app *App) StartServer(ctx context.Context, hub *entity.Hub) error {
 	profileRepository := psql.NewProfileRepository(app.Logger, app.db.psql)
 	profileService := service.NewProfileService(
 		app.Logger, app.db.psql, app.config,
-		hub,
-		s3Client, ufw,
+		app.storage, app.ranker, ufw,
 		profileRepository, navigatorRepository, filterRepository, telegramRepository, imageRepository,
-		imageStatusRepository, likeRepository, blockRepository, complaintRepository,
-		statusRepository, paymentRepository, settingsRepository)
+		imageStatusRepository, likeRepository, matchRepository, conversationRepository, messageRepository,
+		blockRepository, complaintRepository, statusRepository, paymentRepository, settingsRepository)
 	profileController := controller.NewProfileController(app.Logger, profileService)
 	pb.RegisterProfileServer(app.gRPCServer, profileController)
+	adminController := controller.NewAdminController(app.Logger, service.NewAdminService(app.Logger, ufw))
+	pb.RegisterAdminServer(app.gRPCServer, adminController)
 	go func() {
 		app.Logger.Info("Starting Profile service on host: ", zap.String("host", app.config.ProfilesHost))
 		listen, err := net.Listen("tcp", app.config.ProfilesHost)
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/config"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/ranking"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service"
+)
+
+// newProfileRanker - creates the ranker of the discovery feed. When the experiment is on,
+// cfg.RankingExperimentPercent of the viewers are ranked by cfg.RankingExperimentWeights
+func newProfileRanker(cfg *config.Config) (service.ProfileRanker, error) {
+	weights, err := ranking.NewWeights(cfg.RankingWeights)
+	if err != nil {
+		return nil, err
+	}
+	control := ranking.NewWeightedRanker(weights)
+	if cfg.RankingExperimentPercent == 0 {
+		return control, nil
+	}
+	experimentWeights, err := ranking.NewWeights(cfg.RankingExperimentWeights)
+	if err != nil {
+		return nil, err
+	}
+	return ranking.NewSplitRanker(control, ranking.NewWeightedRanker(experimentWeights),
+		cfg.RankingExperimentPercent), nil
+}
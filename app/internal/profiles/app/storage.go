@@ -0,0 +1,34 @@
+package app
+
+import (
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/config"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/storage"
+)
+
+const (
+	storageDriverS3     = "s3"
+	storageDriverLocal  = "local"
+	storageDriverMemory = "memory"
+)
+
+// newObjectStorage - creates the storage of the images selected by cfg.StorageDriver
+func newObjectStorage(cfg *config.Config) (service.ObjectStorage, error) {
+	switch cfg.StorageDriver {
+	case storageDriverS3:
+		return storage.NewS3Storage(&storage.S3Config{
+			AccessKey:    cfg.S3AccessKey,
+			SecretKey:    cfg.S3SecretKey,
+			EndpointUrl:  cfg.S3EndpointUrl,
+			Region:       cfg.S3Region,
+			BucketName:   cfg.S3BucketName,
+			PublicDomain: cfg.S3BucketPublicDomain,
+		})
+	case storageDriverLocal:
+		return storage.NewLocalStorage(cfg.StorageLocalDirectory, cfg.StorageLocalUrl), nil
+	case storageDriverMemory:
+		return storage.NewMemoryStorage(cfg.StorageLocalUrl), nil
+	}
+	return nil, fmt.Errorf("unknown storage driver %q", cfg.StorageDriver)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
+	"time"
 )
 
 type Config struct {
@@ -24,7 +25,40 @@ type Config struct {
 	S3EndpointUrl        string `envconfig:"S3_ENDPOINT_URL"`
 	S3BucketName         string `envconfig:"S3_BUCKET_NAME"`
 	S3BucketPublicDomain string `envconfig:"S3_BUCKET_PUBLIC_DOMAIN"`
+	S3Region             string `envconfig:"S3_REGION" default:"ru-1"`
 	CryptoSecretKey      string `envconfig:"CRYPTO_SECRET_KEY"`
+	Kafka1               string `envconfig:"KAFKA_1"`
+	Kafka2               string `envconfig:"KAFKA_2"`
+	Kafka3               string `envconfig:"KAFKA_3"`
+	// ComplaintThresholds - the weight of the distinct reporters after which the complaints
+	// of the reason go to review and the user is blocked, e.g. "terrorism:1,spam:3"
+	ComplaintThresholds map[string]float64 `envconfig:"COMPLAINT_THRESHOLDS" default:"terrorism:1,fraud:2,spam:3,Other:3"`
+	// ImageModerationMinSize, ImageModerationMaxSize - the bounds of the size of an uploaded image in bytes
+	ImageModerationMinSize int64 `envconfig:"IMAGE_MODERATION_MIN_SIZE" default:"1024"`
+	ImageModerationMaxSize int64 `envconfig:"IMAGE_MODERATION_MAX_SIZE" default:"20971520"`
+	// ImageUploadMaxSize - the max size of an uploaded file in bytes, a larger upload is rejected while it is read
+	ImageUploadMaxSize int64 `envconfig:"IMAGE_UPLOAD_MAX_SIZE" default:"20971520"`
+	// ImagePrivateUrlTtl - how long the presigned urls of the private images are valid
+	ImagePrivateUrlTtl time.Duration `envconfig:"IMAGE_PRIVATE_URL_TTL" default:"15m"`
+	// StorageDriver - where the images are stored: "s3", "local" (the files of StorageLocalDirectory
+	// served by StorageLocalUrl) or "memory"
+	StorageDriver         string `envconfig:"STORAGE_DRIVER" default:"s3"`
+	StorageLocalDirectory string `envconfig:"STORAGE_LOCAL_DIRECTORY" default:"static/storage"`
+	StorageLocalUrl       string `envconfig:"STORAGE_LOCAL_URL"`
+	// StorageReconciliationInterval - how often the storage is compared with the images, 0 disables the worker.
+	// The objects and the images younger than StorageReconciliationGracePeriod are skipped
+	StorageReconciliationInterval    time.Duration `envconfig:"STORAGE_RECONCILIATION_INTERVAL" default:"24h"`
+	StorageReconciliationGracePeriod time.Duration `envconfig:"STORAGE_RECONCILIATION_GRACE_PERIOD" default:"1h"`
+	StorageReconciliationDryRun      bool          `envconfig:"STORAGE_RECONCILIATION_DRY_RUN" default:"true"`
+	// RankingWeights - the weights of the signals the discovery feed is ranked by.
+	// RankingExperimentWeights are used for RankingExperimentPercent of the viewers instead
+	RankingWeights           map[string]float64 `envconfig:"RANKING_WEIGHTS" default:"distance:0.35,activity:0.25,completeness:0.15,compatibility:0.15,likeBack:0.1"`
+	RankingExperimentWeights map[string]float64 `envconfig:"RANKING_EXPERIMENT_WEIGHTS"`
+	RankingExperimentPercent uint32             `envconfig:"RANKING_EXPERIMENT_PERCENT" default:"0"`
+	// RankingCandidateLimit - how many of the nearest candidates are ranked
+	RankingCandidateLimit uint64 `envconfig:"RANKING_CANDIDATE_LIMIT" default:"500"`
+	// ProfileListSnapshotTtl - how long the cursors of the ranked discovery feed stay valid
+	ProfileListSnapshotTtl time.Duration `envconfig:"PROFILE_LIST_SNAPSHOT_TTL" default:"1h"`
 }
 
 func Load(l logger.Logger) (*Config, error) {
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"database/sql"
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/controller/mapper"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/repository/psql"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AdminController struct {
+	logger  logger.Logger
+	service AdminService
+	pb.UnimplementedAdminServer
+}
+
+func NewAdminController(l logger.Logger, as AdminService) *AdminController {
+	return &AdminController{
+		logger:  l,
+		service: as,
+	}
+}
+
+func (pc *AdminController) GetComplaintList(
+	ctx context.Context, in *pb.AdminComplaintListRequest) (*pb.AdminComplaintListResponse, error) {
+	pc.logger.Info("GET /api/v1/admin/complaints")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToComplaintListRequest(in)
+	complaintList, err := pc.service.GetComplaintList(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToComplaintListResponse(complaintList), nil
+}
+
+func (pc *AdminController) GetProfile(
+	ctx context.Context, in *pb.AdminProfileGetRequest) (*pb.AdminProfileResponse, error) {
+	pc.logger.Info("GET /api/v1/admin/profiles/:telegramUserId")
+	profile, err := pc.service.GetProfile(ctx, in.AdminTelegramUserId, in.TelegramUserId)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	adminMapper := &mapper.AdminControllerMapper{}
+	return adminMapper.MapControllerToProfileResponse(profile), nil
+}
+
+func (pc *AdminController) BlockProfile(
+	ctx context.Context, in *pb.AdminProfileBlockRequest) (*pb.AdminActionResponse, error) {
+	pc.logger.Info("POST /api/v1/admin/profiles/:telegramUserId/block")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToProfileBlockRequest(in)
+	profileBlocked, err := pc.service.BlockProfile(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToActionResponse(profileBlocked), nil
+}
+
+func (pc *AdminController) UnblockProfile(
+	ctx context.Context, in *pb.AdminProfileBlockRequest) (*pb.AdminActionResponse, error) {
+	pc.logger.Info("POST /api/v1/admin/profiles/:telegramUserId/unblock")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToProfileBlockRequest(in)
+	profileUnblocked, err := pc.service.UnblockProfile(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToActionResponse(profileUnblocked), nil
+}
+
+func (pc *AdminController) BlockImage(
+	ctx context.Context, in *pb.AdminImageBlockRequest) (*pb.AdminActionResponse, error) {
+	pc.logger.Info("POST /api/v1/admin/images/:id/block")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToImageBlockRequest(in)
+	imageBlocked, err := pc.service.BlockImage(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToActionResponse(imageBlocked), nil
+}
+
+func (pc *AdminController) UnblockImage(
+	ctx context.Context, in *pb.AdminImageBlockRequest) (*pb.AdminActionResponse, error) {
+	pc.logger.Info("POST /api/v1/admin/images/:id/unblock")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToImageBlockRequest(in)
+	imageUnblocked, err := pc.service.UnblockImage(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToActionResponse(imageUnblocked), nil
+}
+
+func (pc *AdminController) ReviewComplaints(
+	ctx context.Context, in *pb.AdminComplaintReviewRequest) (*pb.AdminActionResponse, error) {
+	pc.logger.Info("POST /api/v1/admin/profiles/:telegramUserId/complaints/review")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToComplaintReviewRequest(in)
+	complaintsReviewed, err := pc.service.ReviewComplaints(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToActionResponse(complaintsReviewed), nil
+}
+
+func (pc *AdminController) GetAuditList(
+	ctx context.Context, in *pb.AdminAuditListRequest) (*pb.AdminAuditListResponse, error) {
+	pc.logger.Info("GET /api/v1/admin/audit")
+	adminMapper := &mapper.AdminControllerMapper{}
+	req := adminMapper.MapControllerToAuditListRequest(in)
+	auditList, err := pc.service.GetAuditList(ctx, req)
+	if err != nil {
+		return nil, pc.mapError(err)
+	}
+	return adminMapper.MapControllerToAuditListResponse(auditList), nil
+}
+
+func (pc *AdminController) mapError(err error) error {
+	if errors.Is(err, service.ErrAdminNotFound) || errors.Is(err, service.ErrAdminForbidden) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, service.ErrComplaintDecisionInvalid) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrComplaintNotUnderReview) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, psql.ErrNotRowFound) || errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, psql.ErrNotRowFoundMessage)
+	}
+	return err
+}
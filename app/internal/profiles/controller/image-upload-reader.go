@@ -0,0 +1,31 @@
+package controller
+
+import (
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+)
+
+// imageUploadReader - reads the content of the image from the chunks of the client stream.
+// Only one chunk is held at a time, io.EOF is returned when the client closes the stream
+type imageUploadReader struct {
+	stream pb.Profile_UploadImageServer
+	chunk  []byte
+}
+
+func newImageUploadReader(stream pb.Profile_UploadImageServer) *imageUploadReader {
+	return &imageUploadReader{
+		stream: stream,
+	}
+}
+
+func (r *imageUploadReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		in, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = in.GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
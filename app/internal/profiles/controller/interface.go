@@ -5,6 +5,7 @@ import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"io"
 )
 
 type ProfileService interface {
@@ -24,17 +25,34 @@ type ProfileService interface {
 	GetImageLastByTelegramUserId(ctx context.Context, telegramUserId string) (*response.ImageResponseDto, error)
 	GetImageById(ctx context.Context, imageId uint64) (*response.ImageResponseDto, error)
 	DeleteImage(ctx context.Context, id uint64) (*response.ResponseDto, error)
+	UploadImage(ctx context.Context, pr *request.ImageUploadRequestDto,
+		content io.Reader) (*response.ImageResponseDto, error)
+	SetPrimaryImage(ctx context.Context, pr *request.ImageSetPrimaryRequestDto) (*response.ResponseDto, error)
+	ReorderImages(ctx context.Context, pr *request.ImageReorderRequestDto) (*response.ResponseDto, error)
+	UpdateImagePrivacy(ctx context.Context, pr *request.ImageUpdatePrivacyRequestDto) (*response.ResponseDto, error)
+	AddImageGrant(ctx context.Context, pr *request.ImageGrantRequestDto) (*response.ResponseDto, error)
+	DeleteImageGrant(ctx context.Context, pr *request.ImageGrantRequestDto) (*response.ResponseDto, error)
+	GetImageGrantList(ctx context.Context, telegramUserId string) (*response.ImageGrantListResponseDto, error)
 	GetFilter(ctx context.Context, telegramUserId string) (*response.FilterResponseDto, error)
 	UpdateFilter(ctx context.Context, fr *request.FilterUpdateRequestDto) (*response.FilterResponseDto, error)
 	GetTelegram(ctx context.Context, telegramUserId string) (*response.TelegramResponseDto, error)
 	AddBlock(ctx context.Context, pr *request.BlockAddRequestDto) (*response.ResponseDto, error)
 	GetBlockedList(ctx context.Context, telegramUserId string) (*response.BlockedListResponseDto, error)
 	Unblock(ctx context.Context, p *request.UnblockRequestDto) (*response.ResponseDto, error)
-	AddLike(ctx context.Context, pr *request.LikeAddRequestDto, locale string) (*response.ResponseDto, error)
-	UpdateLike(ctx context.Context, pr *request.LikeUpdateRequestDto) (*response.ResponseDto, error)
+	AddLike(ctx context.Context, pr *request.LikeAddRequestDto, locale string) (*response.LikeMatchResponseDto, error)
+	UpdateLike(ctx context.Context, pr *request.LikeUpdateRequestDto) (*response.LikeMatchResponseDto, error)
 	GetLastLike(
 		ctx context.Context, telegramUserId string) (*entity.LikeEntity, error)
+	GetLikeList(ctx context.Context, telegramUserId string) (*response.LikeListResponseDto, error)
+	GetMatchList(ctx context.Context, telegramUserId string) (*response.MatchListResponseDto, error)
+	Unmatch(ctx context.Context, pr *request.UnmatchRequestDto) (*response.ResponseDto, error)
+	SendMessage(ctx context.Context, pr *request.MessageAddRequestDto) (*response.MessageResponseDto, error)
+	GetConversationList(ctx context.Context, telegramUserId string) (*response.ConversationListResponseDto, error)
+	GetMessageList(
+		ctx context.Context, pr *request.MessageGetListRequestDto) (*response.MessageListResponseDto, error)
+	MarkRead(ctx context.Context, pr *request.MessageMarkReadRequestDto) (*response.ResponseDto, error)
 	AddComplaint(ctx context.Context, pr *request.ComplaintAddRequestDto) (*response.ResponseDto, error)
+	AddComplaintAppeal(ctx context.Context, pr *request.ComplaintAppealAddRequestDto) (*response.ResponseDto, error)
 	GetStatusByTelegramUserId(
 		ctx context.Context, telegramUserId string) (*response.StatusResponseDto, error)
 	UpdateCoordinates(
@@ -43,4 +61,21 @@ type ProfileService interface {
 	GetPaymentLastByTelegramUserId(ctx context.Context, telegramUserId string) (*entity.PaymentEntity, error)
 	CheckPremium(ctx context.Context, telegramUserId string) (*response.PremiumResponseDto, error)
 	UpdateSettings(ctx context.Context, pr *request.ProfileUpdateSettingsRequestDto) (*response.ResponseDto, error)
+	AddRefreshToken(ctx context.Context, pr *request.RefreshTokenAddRequestDto) (*response.ResponseDto, error)
+	RotateRefreshToken(ctx context.Context, pr *request.RefreshTokenRotateRequestDto) (string, error)
+	RevokeRefreshToken(ctx context.Context, tokenHash string) (*response.ResponseDto, error)
+}
+
+type AdminService interface {
+	GetComplaintList(ctx context.Context,
+		pr *request.AdminComplaintGetListRequestDto) (*response.ComplaintListResponseDto, error)
+	GetProfile(ctx context.Context,
+		adminTelegramUserId, telegramUserId string) (*response.AdminProfileResponseDto, error)
+	BlockProfile(ctx context.Context, pr *request.AdminProfileBlockRequestDto) (*response.ResponseDto, error)
+	UnblockProfile(ctx context.Context, pr *request.AdminProfileBlockRequestDto) (*response.ResponseDto, error)
+	BlockImage(ctx context.Context, pr *request.AdminImageBlockRequestDto) (*response.ResponseDto, error)
+	UnblockImage(ctx context.Context, pr *request.AdminImageBlockRequestDto) (*response.ResponseDto, error)
+	ReviewComplaints(ctx context.Context, pr *request.AdminComplaintReviewRequestDto) (*response.ResponseDto, error)
+	GetAuditList(ctx context.Context,
+		pr *request.AdminAuditGetListRequestDto) (*response.AdminAuditListResponseDto, error)
 }
@@ -0,0 +1,161 @@
+package mapper
+
+import (
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type AdminControllerMapper struct {
+}
+
+func (pm *AdminControllerMapper) MapControllerToComplaintListRequest(
+	r *pb.AdminComplaintListRequest) *request.AdminComplaintGetListRequestDto {
+	var dateFrom, dateTo *time.Time
+	if r.DateFrom != nil {
+		t := r.DateFrom.AsTime()
+		dateFrom = &t
+	}
+	if r.DateTo != nil {
+		t := r.DateTo.AsTime()
+		dateTo = &t
+	}
+	return &request.AdminComplaintGetListRequestDto{
+		AdminTelegramUserId:    r.AdminTelegramUserId,
+		TelegramUserId:         r.TelegramUserId,
+		CriminalTelegramUserId: r.CriminalTelegramUserId,
+		Type:                   r.Type,
+		DateFrom:               dateFrom,
+		DateTo:                 dateTo,
+		Status:                 r.Status,
+		Page:                   r.Page,
+		Size:                   r.Size,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToComplaintListResponse(
+	r *response.ComplaintListResponseDto) *pb.AdminComplaintListResponse {
+	content := make([]*pb.AdminComplaintListItemResponse, 0, len(r.Content))
+	for _, c := range r.Content {
+		content = append(content, &pb.AdminComplaintListItemResponse{
+			Id:                     c.Id,
+			TelegramUserId:         c.TelegramUserId,
+			CriminalTelegramUserId: c.CriminalTelegramUserId,
+			Type:                   c.Type,
+			Description:            c.Description,
+			CreatedAt:              timestamppb.New(c.CreatedAt),
+			Status:                 c.Status,
+			Weight:                 c.Weight,
+		})
+	}
+	return &pb.AdminComplaintListResponse{
+		HasPrevious:   r.HasPrevious,
+		HasNext:       r.HasNext,
+		Page:          r.Page,
+		Size:          r.Size,
+		TotalEntities: r.TotalEntities,
+		TotalPages:    r.TotalPages,
+		Content:       content,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToProfileResponse(
+	r *response.AdminProfileResponseDto) *pb.AdminProfileResponse {
+	images := make([]*pb.AdminImageResponse, 0, len(r.Images))
+	for _, i := range r.Images {
+		images = append(images, &pb.AdminImageResponse{
+			Id:        i.Id,
+			Name:      i.Name,
+			Url:       i.Url,
+			IsBlocked: i.IsBlocked,
+			IsPrimary: i.IsPrimary,
+			IsPrivate: i.IsPrivate,
+			CreatedAt: timestamppb.New(i.CreatedAt),
+		})
+	}
+	return &pb.AdminProfileResponse{
+		TelegramUserId: r.TelegramUserId,
+		DisplayName:    r.DisplayName,
+		Age:            r.Age,
+		Gender:         r.Gender,
+		Description:    r.Description,
+		IsBlocked:      r.IsBlocked,
+		IsFrozen:       r.IsFrozen,
+		ComplaintCount: r.ComplaintCount,
+		Images:         images,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToProfileBlockRequest(
+	r *pb.AdminProfileBlockRequest) *request.AdminProfileBlockRequestDto {
+	return &request.AdminProfileBlockRequestDto{
+		AdminTelegramUserId: r.AdminTelegramUserId,
+		TelegramUserId:      r.TelegramUserId,
+		Reason:              r.Reason,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToImageBlockRequest(
+	r *pb.AdminImageBlockRequest) *request.AdminImageBlockRequestDto {
+	return &request.AdminImageBlockRequestDto{
+		AdminTelegramUserId: r.AdminTelegramUserId,
+		ImageId:             r.ImageId,
+		Reason:              r.Reason,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToComplaintReviewRequest(
+	r *pb.AdminComplaintReviewRequest) *request.AdminComplaintReviewRequestDto {
+	return &request.AdminComplaintReviewRequestDto{
+		AdminTelegramUserId: r.AdminTelegramUserId,
+		TelegramUserId:      r.TelegramUserId,
+		Decision:            enum.ComplaintStatus(r.Decision),
+		Reason:              r.Reason,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToActionResponse(r *response.ResponseDto) *pb.AdminActionResponse {
+	return &pb.AdminActionResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToAuditListRequest(
+	r *pb.AdminAuditListRequest) *request.AdminAuditGetListRequestDto {
+	return &request.AdminAuditGetListRequestDto{
+		AdminTelegramUserId:  r.AdminTelegramUserId,
+		ActorTelegramUserId:  r.ActorTelegramUserId,
+		TargetTelegramUserId: r.TargetTelegramUserId,
+		Page:                 r.Page,
+		Size:                 r.Size,
+	}
+}
+
+func (pm *AdminControllerMapper) MapControllerToAuditListResponse(
+	r *response.AdminAuditListResponseDto) *pb.AdminAuditListResponse {
+	content := make([]*pb.AdminAuditListItemResponse, 0, len(r.Content))
+	for _, a := range r.Content {
+		content = append(content, &pb.AdminAuditListItemResponse{
+			Id:                   a.Id,
+			AdminTelegramUserId:  a.AdminTelegramUserId,
+			Role:                 string(a.Role),
+			Action:               string(a.Action),
+			TargetTelegramUserId: a.TargetTelegramUserId,
+			TargetImageId:        a.TargetImageId,
+			Reason:               a.Reason,
+			CreatedAt:            timestamppb.New(a.CreatedAt),
+		})
+	}
+	return &pb.AdminAuditListResponse{
+		HasPrevious:   r.HasPrevious,
+		HasNext:       r.HasNext,
+		Page:          r.Page,
+		Size:          r.Size,
+		TotalEntities: r.TotalEntities,
+		TotalPages:    r.TotalPages,
+		Content:       content,
+	}
+}
@@ -102,6 +102,7 @@ func (pm *ProfileControllerMapper) MapControllerResponse(
 				TelegramUserId: image.TelegramUserId,
 				Name:           image.Name,
 				Url:            image.Url,
+				Variants:       pm.MapControllerToImageVariantListResponse(image.Variants),
 			})
 		}
 	}
@@ -172,6 +173,7 @@ func (pm *ProfileControllerMapper) MapControllerToDetailResponse(
 				TelegramUserId: image.TelegramUserId,
 				Name:           image.Name,
 				Url:            image.Url,
+				Variants:       pm.MapControllerToImageVariantListResponse(image.Variants),
 			})
 		}
 	}
@@ -241,13 +243,11 @@ func (pm *ProfileControllerMapper) MapControllerToListResponse(
 		}
 	}
 	return &pb.ProfileListResponse{
-		HasPrevious:   r.HasPrevious,
-		HasNext:       r.HasNext,
-		Page:          r.Page,
-		Size:          r.Size,
-		TotalEntities: r.TotalEntities,
-		TotalPages:    r.TotalPages,
-		Content:       contentList,
+		HasNext:            r.HasNext,
+		TotalEntities:      r.TotalEntities,
+		Content:            contentList,
+		NextCursor:         r.NextCursor,
+		IsTotalApproximate: r.IsTotalApproximate,
 	}
 }
 
@@ -263,9 +263,24 @@ func (pm *ProfileControllerMapper) MapControllerToImageResponse(r *response.Imag
 		TelegramUserId: r.TelegramUserId,
 		Name:           r.Name,
 		Url:            r.Url,
+		Variants:       pm.MapControllerToImageVariantListResponse(r.Variants),
 	}
 }
 
+func (pm *ProfileControllerMapper) MapControllerToImageVariantListResponse(
+	r []*response.ImageVariantResponseDto) []*pb.ImageVariant {
+	variants := make([]*pb.ImageVariant, 0, len(r))
+	for _, variant := range r {
+		variants = append(variants, &pb.ImageVariant{
+			Variant: string(variant.Variant),
+			Url:     variant.Url,
+			Width:   int32(variant.Width),
+			Height:  int32(variant.Height),
+		})
+	}
+	return variants
+}
+
 func (pm *ProfileControllerMapper) MapControllerToFilterResponse(
 	r *response.FilterResponseDto) *pb.FilterResponse {
 	return &pb.FilterResponse{
@@ -326,16 +341,154 @@ func (pm *ProfileControllerMapper) MapControllerToUnblockResponse(r *response.Re
 	}
 }
 
-func (pm *ProfileControllerMapper) MapControllerToLikeAddResponse(r *response.ResponseDto) *pb.LikeAddResponse {
+func (pm *ProfileControllerMapper) MapControllerToLikeAddResponse(
+	r *response.LikeMatchResponseDto) *pb.LikeAddResponse {
 	return &pb.LikeAddResponse{
 		Success: r.Success,
+		IsMatch: r.IsMatch,
 	}
 }
 
 func (pm *ProfileControllerMapper) MapControllerToLikeUpdateResponse(
-	r *response.ResponseDto) *pb.LikeUpdateResponse {
+	r *response.LikeMatchResponseDto) *pb.LikeUpdateResponse {
 	return &pb.LikeUpdateResponse{
 		Success: r.Success,
+		IsMatch: r.IsMatch,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToGetLikeListResponse(
+	r *response.LikeListResponseDto) *pb.GetLikeListResponse {
+	content := make([]*pb.LikeListItemResponse, 0)
+	if len(r.Content) > 0 {
+		for _, c := range r.Content {
+			content = append(content, &pb.LikeListItemResponse{
+				TelegramUserId: c.TelegramUserId,
+				DisplayName:    c.DisplayName,
+				Username:       c.Username,
+				Url:            c.Url,
+				UpdatedAt:      timestamppb.New(c.UpdatedAt),
+			})
+		}
+	}
+	return &pb.GetLikeListResponse{
+		Content: content,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToGetMatchListResponse(
+	r *response.MatchListResponseDto) *pb.GetMatchListResponse {
+	content := make([]*pb.MatchListItemResponse, 0)
+	if len(r.Content) > 0 {
+		for _, c := range r.Content {
+			content = append(content, &pb.MatchListItemResponse{
+				MatchedTelegramUserId: c.MatchedTelegramUserId,
+				DisplayName:           c.DisplayName,
+				Username:              c.Username,
+				Url:                   c.Url,
+				CreatedAt:             timestamppb.New(c.CreatedAt),
+			})
+		}
+	}
+	return &pb.GetMatchListResponse{
+		Content: content,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToUnmatchRequest(r *pb.UnmatchRequest) *request.UnmatchRequestDto {
+	return &request.UnmatchRequestDto{
+		TelegramUserId:        r.TelegramUserId,
+		MatchedTelegramUserId: r.MatchedTelegramUserId,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToUnmatchResponse(r *response.ResponseDto) *pb.UnmatchResponse {
+	return &pb.UnmatchResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToSendMessageRequest(
+	r *pb.SendMessageRequest) *request.MessageAddRequestDto {
+	return &request.MessageAddRequestDto{
+		TelegramUserId:          r.TelegramUserId,
+		RecipientTelegramUserId: r.RecipientTelegramUserId,
+		Text:                    r.Text,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToMessageResponse(r *response.MessageResponseDto) *pb.MessageResponse {
+	return &pb.MessageResponse{
+		Id:                      r.Id,
+		ConversationId:          r.ConversationId,
+		SenderTelegramUserId:    r.SenderTelegramUserId,
+		RecipientTelegramUserId: r.RecipientTelegramUserId,
+		Text:                    r.Text,
+		IsRead:                  r.IsRead,
+		CreatedAt:               timestamppb.New(r.CreatedAt),
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToGetConversationListResponse(
+	r *response.ConversationListResponseDto) *pb.GetConversationListResponse {
+	content := make([]*pb.ConversationListItemResponse, 0)
+	if len(r.Content) > 0 {
+		for _, c := range r.Content {
+			var lastMessageAt *timestamppb.Timestamp
+			if c.LastMessageAt != nil {
+				lastMessageAt = timestamppb.New(*c.LastMessageAt)
+			}
+			content = append(content, &pb.ConversationListItemResponse{
+				Id:                 c.Id,
+				PeerTelegramUserId: c.PeerTelegramUserId,
+				DisplayName:        c.DisplayName,
+				Url:                c.Url,
+				LastMessage:        c.LastMessage,
+				LastMessageAt:      lastMessageAt,
+				UnreadCount:        c.UnreadCount,
+			})
+		}
+	}
+	return &pb.GetConversationListResponse{
+		Content: content,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToGetMessageListRequest(
+	r *pb.GetMessageListRequest) *request.MessageGetListRequestDto {
+	return &request.MessageGetListRequestDto{
+		TelegramUserId: r.TelegramUserId,
+		ConversationId: r.ConversationId,
+		Cursor:         r.Cursor,
+		Limit:          r.Limit,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToGetMessageListResponse(
+	r *response.MessageListResponseDto) *pb.GetMessageListResponse {
+	content := make([]*pb.MessageResponse, 0, len(r.Content))
+	for _, c := range r.Content {
+		content = append(content, pm.MapControllerToMessageResponse(c))
+	}
+	return &pb.GetMessageListResponse{
+		Content:    content,
+		NextCursor: r.NextCursor,
+		HasNext:    r.HasNext,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToMarkReadRequest(
+	r *pb.MarkReadRequest) *request.MessageMarkReadRequestDto {
+	return &request.MessageMarkReadRequestDto{
+		TelegramUserId:    r.TelegramUserId,
+		ConversationId:    r.ConversationId,
+		LastReadMessageId: r.LastReadMessageId,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToMarkReadResponse(r *response.ResponseDto) *pb.MarkReadResponse {
+	return &pb.MarkReadResponse{
+		Success: r.Success,
 	}
 }
 
@@ -365,6 +518,55 @@ func (pm *ProfileControllerMapper) MapControllerToComplaintAddResponse(
 		Success: r.Success,
 	}
 }
+
+func (pm *ProfileControllerMapper) MapControllerToImageSetPrimaryResponse(
+	r *response.ResponseDto) *pb.ImageSetPrimaryResponse {
+	return &pb.ImageSetPrimaryResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToImageReorderResponse(
+	r *response.ResponseDto) *pb.ImageReorderResponse {
+	return &pb.ImageReorderResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToImageUpdatePrivacyResponse(
+	r *response.ResponseDto) *pb.ImageUpdatePrivacyResponse {
+	return &pb.ImageUpdatePrivacyResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToImageGrantResponse(r *response.ResponseDto) *pb.ImageGrantResponse {
+	return &pb.ImageGrantResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToImageGrantListResponse(
+	r *response.ImageGrantListResponseDto) *pb.ImageGrantListResponse {
+	content := make([]*pb.ImageGrantItem, 0, len(r.Content))
+	for _, grant := range r.Content {
+		content = append(content, &pb.ImageGrantItem{
+			ViewerTelegramUserId: grant.ViewerTelegramUserId,
+			CreatedAt:            timestamppb.New(grant.CreatedAt),
+		})
+	}
+	return &pb.ImageGrantListResponse{
+		Content: content,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToComplaintAppealAddResponse(
+	r *response.ResponseDto) *pb.ComplaintAppealAddResponse {
+	return &pb.ComplaintAppealAddResponse{
+		Success: r.Success,
+	}
+}
+
 func (pm *ProfileControllerMapper) MapControllerToStatusResponse(s *response.StatusResponseDto) *pb.StatusResponse {
 	return &pb.StatusResponse{
 		IsBlocked:        s.IsBlocked,
@@ -427,3 +629,35 @@ func (pm *ProfileControllerMapper) MapControllerToUpdateCoordinatesResponse(
 		Success: r.Success,
 	}
 }
+
+func (pm *ProfileControllerMapper) MapControllerToRefreshTokenAddRequest(
+	in *pb.RefreshTokenAddRequest) *request.RefreshTokenAddRequestDto {
+	return &request.RefreshTokenAddRequestDto{
+		TelegramUserId: in.TelegramUserId,
+		TokenHash:      in.TokenHash,
+		ExpiresAt:      in.ExpiresAt.AsTime(),
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToRefreshTokenAddResponse(
+	r *response.ResponseDto) *pb.RefreshTokenAddResponse {
+	return &pb.RefreshTokenAddResponse{
+		Success: r.Success,
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToRefreshTokenRotateRequest(
+	in *pb.RefreshTokenRotateRequest) *request.RefreshTokenRotateRequestDto {
+	return &request.RefreshTokenRotateRequestDto{
+		TokenHash:    in.TokenHash,
+		NewTokenHash: in.NewTokenHash,
+		ExpiresAt:    in.ExpiresAt.AsTime(),
+	}
+}
+
+func (pm *ProfileControllerMapper) MapControllerToRefreshTokenRevokeResponse(
+	r *response.ResponseDto) *pb.RefreshTokenRevokeResponse {
+	return &pb.RefreshTokenRevokeResponse{
+		Success: r.Success,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/repository/psql"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -194,9 +195,13 @@ func (pc *ProfileController) GetProfileList(
 		City:           in.City,
 		Latitude:       in.Latitude,
 		Longitude:      in.Longitude,
+		Cursor:         in.Cursor,
 	}
 	profileList, err := pc.service.GetProfileList(ctx, req)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		if errors.Is(err, psql.ErrNotRowFound) {
 			return nil, status.Errorf(codes.NotFound, psql.ErrNotRowFoundMessage)
 		}
@@ -277,6 +282,121 @@ func (pc *ProfileController) DeleteImage(
 	return fileResponse, nil
 }
 
+// UploadImage - the first message of the stream contains the info of the file, the next ones contain its content
+func (pc *ProfileController) UploadImage(stream pb.Profile_UploadImageServer) error {
+	pc.logger.Info("POST /api/v1/profiles/images")
+	in, err := stream.Recv()
+	if err != nil {
+		errorMessage := pc.getErrorMessage("UploadImage", "stream.Recv")
+		pc.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	info := in.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first message of the stream must contain the image info")
+	}
+	req := &request.ImageUploadRequestDto{
+		TelegramUserId: info.TelegramUserId,
+		Filename:       info.Filename,
+		Size:           info.Size,
+	}
+	imageUploaded, err := pc.service.UploadImage(stream.Context(), req, newImageUploadReader(stream))
+	if err != nil {
+		return pc.mapImageError(err)
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return stream.SendAndClose(&pb.ImageUploadResponse{
+		Image: profileMapper.MapControllerToImageResponse(imageUploaded),
+	})
+}
+
+func (pc *ProfileController) SetPrimaryImage(
+	ctx context.Context, in *pb.ImageSetPrimaryRequest) (*pb.ImageSetPrimaryResponse, error) {
+	pc.logger.Info("PUT /api/v1/profiles/images/primary")
+	req := &request.ImageSetPrimaryRequestDto{
+		TelegramUserId: in.TelegramUserId,
+		ImageId:        in.ImageId,
+	}
+	imageUpdated, err := pc.service.SetPrimaryImage(ctx, req)
+	if err != nil {
+		return nil, pc.mapImageError(err)
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToImageSetPrimaryResponse(imageUpdated), nil
+}
+
+func (pc *ProfileController) ReorderImages(
+	ctx context.Context, in *pb.ImageReorderRequest) (*pb.ImageReorderResponse, error) {
+	pc.logger.Info("PUT /api/v1/profiles/images/order")
+	req := &request.ImageReorderRequestDto{
+		TelegramUserId: in.TelegramUserId,
+		ImageIds:       in.ImageIds,
+	}
+	imagesUpdated, err := pc.service.ReorderImages(ctx, req)
+	if err != nil {
+		return nil, pc.mapImageError(err)
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToImageReorderResponse(imagesUpdated), nil
+}
+
+func (pc *ProfileController) UpdateImagePrivacy(
+	ctx context.Context, in *pb.ImageUpdatePrivacyRequest) (*pb.ImageUpdatePrivacyResponse, error) {
+	pc.logger.Info("PUT /api/v1/profiles/images/privacy")
+	req := &request.ImageUpdatePrivacyRequestDto{
+		TelegramUserId: in.TelegramUserId,
+		ImageId:        in.ImageId,
+		IsPrivate:      in.IsPrivate,
+	}
+	imageUpdated, err := pc.service.UpdateImagePrivacy(ctx, req)
+	if err != nil {
+		return nil, pc.mapImageError(err)
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToImageUpdatePrivacyResponse(imageUpdated), nil
+}
+
+func (pc *ProfileController) AddImageGrant(
+	ctx context.Context, in *pb.ImageGrantRequest) (*pb.ImageGrantResponse, error) {
+	pc.logger.Info("POST /api/v1/profiles/images/grants")
+	req := &request.ImageGrantRequestDto{
+		TelegramUserId:       in.TelegramUserId,
+		ViewerTelegramUserId: in.ViewerTelegramUserId,
+	}
+	grantAdded, err := pc.service.AddImageGrant(ctx, req)
+	if err != nil {
+		return nil, pc.mapImageError(err)
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToImageGrantResponse(grantAdded), nil
+}
+
+func (pc *ProfileController) DeleteImageGrant(
+	ctx context.Context, in *pb.ImageGrantRequest) (*pb.ImageGrantResponse, error) {
+	pc.logger.Info("DELETE /api/v1/profiles/images/grants")
+	req := &request.ImageGrantRequestDto{
+		TelegramUserId:       in.TelegramUserId,
+		ViewerTelegramUserId: in.ViewerTelegramUserId,
+	}
+	grantDeleted, err := pc.service.DeleteImageGrant(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToImageGrantResponse(grantDeleted), nil
+}
+
+func (pc *ProfileController) GetImageGrantList(
+	ctx context.Context, in *pb.ImageGrantListRequest) (*pb.ImageGrantListResponse, error) {
+	pc.logger.Info("GET /api/v1/profiles/images/grants")
+	grantList, err := pc.service.GetImageGrantList(ctx, in.TelegramUserId)
+	if err != nil {
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToImageGrantListResponse(grantList), nil
+}
+
 func (pc *ProfileController) GetFilter(
 	ctx context.Context, in *pb.FilterGetRequest) (*pb.FilterResponse, error) {
 	pc.logger.Info("GET /api/v1/profiles/filters/:telegramUserId")
@@ -386,6 +506,9 @@ func (pc *ProfileController) UpdateLike(ctx context.Context, in *pb.LikeUpdateRe
 	}
 	likeUpdated, err := pc.service.UpdateLike(ctx, req)
 	if err != nil {
+		if errors.Is(err, service.ErrLikeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
 	profileMapper := &mapper.ProfileControllerMapper{}
@@ -405,6 +528,107 @@ func (pc *ProfileController) GetLastLike(
 	return likeResponse, nil
 }
 
+func (pc *ProfileController) GetLikeList(
+	ctx context.Context, in *pb.GetLikeListRequest) (*pb.GetLikeListResponse, error) {
+	pc.logger.Info("GET /api/v1/profiles/likes")
+	likeList, err := pc.service.GetLikeList(ctx, in.TelegramUserId)
+	if err != nil {
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	likeListResponse := profileMapper.MapControllerToGetLikeListResponse(likeList)
+	return likeListResponse, nil
+}
+
+func (pc *ProfileController) GetMatchList(
+	ctx context.Context, in *pb.GetMatchListRequest) (*pb.GetMatchListResponse, error) {
+	pc.logger.Info("GET /api/v1/profiles/matches")
+	matchList, err := pc.service.GetMatchList(ctx, in.TelegramUserId)
+	if err != nil {
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	matchListResponse := profileMapper.MapControllerToGetMatchListResponse(matchList)
+	return matchListResponse, nil
+}
+
+func (pc *ProfileController) Unmatch(ctx context.Context, in *pb.UnmatchRequest) (*pb.UnmatchResponse, error) {
+	pc.logger.Info("DELETE /api/v1/profiles/matches")
+	profileMapper := &mapper.ProfileControllerMapper{}
+	unmatchRequest := profileMapper.MapControllerToUnmatchRequest(in)
+	unmatched, err := pc.service.Unmatch(ctx, unmatchRequest)
+	if err != nil {
+		return nil, err
+	}
+	unmatchResponse := profileMapper.MapControllerToUnmatchResponse(unmatched)
+	return unmatchResponse, nil
+}
+
+func (pc *ProfileController) SendMessage(
+	ctx context.Context, in *pb.SendMessageRequest) (*pb.MessageResponse, error) {
+	pc.logger.Info("POST /api/v1/profiles/messages")
+	profileMapper := &mapper.ProfileControllerMapper{}
+	messageRequest := profileMapper.MapControllerToSendMessageRequest(in)
+	message, err := pc.service.SendMessage(ctx, messageRequest)
+	if err != nil {
+		if errors.Is(err, service.ErrMessageNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, service.ErrEmptyMessage) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	messageResponse := profileMapper.MapControllerToMessageResponse(message)
+	return messageResponse, nil
+}
+
+func (pc *ProfileController) GetConversationList(
+	ctx context.Context, in *pb.GetConversationListRequest) (*pb.GetConversationListResponse, error) {
+	pc.logger.Info("GET /api/v1/profiles/conversations")
+	conversationList, err := pc.service.GetConversationList(ctx, in.TelegramUserId)
+	if err != nil {
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	conversationListResponse := profileMapper.MapControllerToGetConversationListResponse(conversationList)
+	return conversationListResponse, nil
+}
+
+func (pc *ProfileController) GetMessageList(
+	ctx context.Context, in *pb.GetMessageListRequest) (*pb.GetMessageListResponse, error) {
+	pc.logger.Info("GET /api/v1/profiles/conversations/:conversationId/messages")
+	profileMapper := &mapper.ProfileControllerMapper{}
+	messageListRequest := profileMapper.MapControllerToGetMessageListRequest(in)
+	messageList, err := pc.service.GetMessageList(ctx, messageListRequest)
+	if err != nil {
+		if errors.Is(err, service.ErrConversationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	messageListResponse := profileMapper.MapControllerToGetMessageListResponse(messageList)
+	return messageListResponse, nil
+}
+
+func (pc *ProfileController) MarkRead(ctx context.Context, in *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	pc.logger.Info("PUT /api/v1/profiles/conversations/:conversationId/read")
+	profileMapper := &mapper.ProfileControllerMapper{}
+	markReadRequest := profileMapper.MapControllerToMarkReadRequest(in)
+	marked, err := pc.service.MarkRead(ctx, markReadRequest)
+	if err != nil {
+		if errors.Is(err, service.ErrConversationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	markReadResponse := profileMapper.MapControllerToMarkReadResponse(marked)
+	return markReadResponse, nil
+}
+
 func (pc *ProfileController) AddComplaint(
 	ctx context.Context, in *pb.ComplaintAddRequest) (*pb.ComplaintAddResponse, error) {
 	pc.logger.Info("POST /api/v1/profiles/complaints")
@@ -423,6 +647,27 @@ func (pc *ProfileController) AddComplaint(
 	return complaintResponse, nil
 }
 
+func (pc *ProfileController) AddComplaintAppeal(
+	ctx context.Context, in *pb.ComplaintAppealAddRequest) (*pb.ComplaintAppealAddResponse, error) {
+	pc.logger.Info("POST /api/v1/profiles/complaints/appeal")
+	req := &request.ComplaintAppealAddRequestDto{
+		TelegramUserId: in.TelegramUserId,
+		Description:    in.Description,
+	}
+	appealAdded, err := pc.service.AddComplaintAppeal(ctx, req)
+	if err != nil {
+		if errors.Is(err, service.ErrComplaintAppealNotAllowed) || errors.Is(err, service.ErrComplaintAppealExists) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, psql.ErrNotRowFound) {
+			return nil, status.Errorf(codes.NotFound, psql.ErrNotRowFoundMessage)
+		}
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToComplaintAppealAddResponse(appealAdded), nil
+}
+
 func (pc *ProfileController) GetStatusByTelegramUserId(
 	ctx context.Context, in *pb.GetStatusByTelegramUserIdRequest) (*pb.StatusResponse, error) {
 	pc.logger.Info("GET GetStatusByTelegramUserId")
@@ -497,6 +742,60 @@ func (pc *ProfileController) UpdateCoordinates(
 	return updatedCoordinatesResponse, nil
 }
 
+func (pc *ProfileController) AddRefreshToken(
+	ctx context.Context, in *pb.RefreshTokenAddRequest) (*pb.RefreshTokenAddResponse, error) {
+	pc.logger.Info("POST /api/v1/auth/session")
+	profileMapper := &mapper.ProfileControllerMapper{}
+	req := profileMapper.MapControllerToRefreshTokenAddRequest(in)
+	refreshTokenAdded, err := pc.service.AddRefreshToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return profileMapper.MapControllerToRefreshTokenAddResponse(refreshTokenAdded), nil
+}
+
+func (pc *ProfileController) RotateRefreshToken(
+	ctx context.Context, in *pb.RefreshTokenRotateRequest) (*pb.RefreshTokenRotateResponse, error) {
+	pc.logger.Info("POST /api/v1/auth/refresh")
+	profileMapper := &mapper.ProfileControllerMapper{}
+	req := profileMapper.MapControllerToRefreshTokenRotateRequest(in)
+	telegramUserId, err := pc.service.RotateRefreshToken(ctx, req)
+	if err != nil {
+		if errors.Is(err, service.ErrRefreshTokenInvalid) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.RefreshTokenRotateResponse{
+		TelegramUserId: telegramUserId,
+	}, nil
+}
+
+func (pc *ProfileController) RevokeRefreshToken(
+	ctx context.Context, in *pb.RefreshTokenRevokeRequest) (*pb.RefreshTokenRevokeResponse, error) {
+	pc.logger.Info("DELETE /api/v1/auth/session")
+	refreshTokenRevoked, err := pc.service.RevokeRefreshToken(ctx, in.TokenHash)
+	if err != nil {
+		return nil, err
+	}
+	profileMapper := &mapper.ProfileControllerMapper{}
+	return profileMapper.MapControllerToRefreshTokenRevokeResponse(refreshTokenRevoked), nil
+}
+
+// mapImageError - maps the errors of the image management to the gRPC status codes
+func (pc *ProfileController) mapImageError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrImageNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrImageOrderInvalid), errors.Is(err, service.ErrImageContentType),
+		errors.Is(err, service.ErrImageTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrImageBlocked), errors.Is(err, service.ErrImageGrantNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
 func (pc *ProfileController) getErrorMessage(repositoryMethodName string, callMethodName string) string {
 	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
 		errorFilePath)
@@ -0,0 +1,16 @@
+package request
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type AdminAuditAddRequestRepositoryDto struct {
+	AdminTelegramUserId  string           `json:"adminTelegramUserId"`
+	Role                 enum.AdminRole   `json:"role"`
+	Action               enum.AdminAction `json:"action"`
+	TargetTelegramUserId *string          `json:"targetTelegramUserId"`
+	TargetImageId        *uint64          `json:"targetImageId"`
+	Reason               string           `json:"reason"`
+	CreatedAt            time.Time        `json:"createdAt"`
+}
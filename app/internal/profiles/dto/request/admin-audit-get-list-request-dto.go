@@ -0,0 +1,9 @@
+package request
+
+type AdminAuditGetListRequestDto struct {
+	AdminTelegramUserId  string  `json:"adminTelegramUserId"`
+	ActorTelegramUserId  *string `json:"actorTelegramUserId"`
+	TargetTelegramUserId *string `json:"targetTelegramUserId"`
+	Page                 uint64  `json:"page"`
+	Size                 uint64  `json:"size"`
+}
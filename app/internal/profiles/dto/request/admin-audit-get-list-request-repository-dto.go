@@ -0,0 +1,8 @@
+package request
+
+type AdminAuditGetListRequestRepositoryDto struct {
+	ActorTelegramUserId  *string `json:"actorTelegramUserId"`
+	TargetTelegramUserId *string `json:"targetTelegramUserId"`
+	Page                 uint64  `json:"page"`
+	Size                 uint64  `json:"size"`
+}
@@ -0,0 +1,15 @@
+package request
+
+import "time"
+
+type AdminComplaintGetListRequestDto struct {
+	AdminTelegramUserId    string     `json:"adminTelegramUserId"`
+	TelegramUserId         *string    `json:"telegramUserId"`
+	CriminalTelegramUserId *string    `json:"criminalTelegramUserId"`
+	Type                   *string    `json:"type"`
+	DateFrom               *time.Time `json:"dateFrom"`
+	DateTo                 *time.Time `json:"dateTo"`
+	Status                 *string    `json:"status"`
+	Page                   uint64     `json:"page"`
+	Size                   uint64     `json:"size"`
+}
@@ -0,0 +1,10 @@
+package request
+
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+
+type AdminComplaintReviewRequestDto struct {
+	AdminTelegramUserId string               `json:"adminTelegramUserId"`
+	TelegramUserId      string               `json:"telegramUserId"`
+	Decision            enum.ComplaintStatus `json:"decision"`
+	Reason              string               `json:"reason"`
+}
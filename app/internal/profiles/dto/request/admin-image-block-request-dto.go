@@ -0,0 +1,7 @@
+package request
+
+type AdminImageBlockRequestDto struct {
+	AdminTelegramUserId string `json:"adminTelegramUserId"`
+	ImageId             uint64 `json:"imageId"`
+	Reason              string `json:"reason"`
+}
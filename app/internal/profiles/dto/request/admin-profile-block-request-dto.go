@@ -0,0 +1,7 @@
+package request
+
+type AdminProfileBlockRequestDto struct {
+	AdminTelegramUserId string `json:"adminTelegramUserId"`
+	TelegramUserId      string `json:"telegramUserId"`
+	Reason              string `json:"reason"`
+}
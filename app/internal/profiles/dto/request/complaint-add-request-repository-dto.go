@@ -6,10 +6,12 @@ import (
 )
 
 type ComplaintAddRequestRepositoryDto struct {
-	TelegramUserId         string         `json:"telegramUserId"`
-	CriminalTelegramUserId string         `json:"criminalTelegramUserId"`
-	Type                   enum.Complaint `json:"type"`
-	Description            string         `json:"description"`
-	CreatedAt              time.Time      `json:"createdAt"`
-	UpdatedAt              time.Time      `json:"updatedAt"`
+	TelegramUserId         string               `json:"telegramUserId"`
+	CriminalTelegramUserId string               `json:"criminalTelegramUserId"`
+	Type                   enum.Complaint       `json:"type"`
+	Description            string               `json:"description"`
+	Status                 enum.ComplaintStatus `json:"status"`
+	Weight                 float64              `json:"weight"`
+	CreatedAt              time.Time            `json:"createdAt"`
+	UpdatedAt              time.Time            `json:"updatedAt"`
 }
@@ -0,0 +1,14 @@
+package request
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type ComplaintAppealAddRequestRepositoryDto struct {
+	TelegramUserId string                     `json:"telegramUserId"`
+	Description    string                     `json:"description"`
+	Status         enum.ComplaintAppealStatus `json:"status"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	UpdatedAt      time.Time                  `json:"updatedAt"`
+}
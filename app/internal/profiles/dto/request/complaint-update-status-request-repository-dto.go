@@ -0,0 +1,14 @@
+package request
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type ComplaintUpdateStatusRequestRepositoryDto struct {
+	CriminalTelegramUserId string               `json:"criminalTelegramUserId"`
+	PreviousStatus         enum.ComplaintStatus `json:"previousStatus"`
+	Status                 enum.ComplaintStatus `json:"status"`
+	ReviewedBy             *string              `json:"reviewedBy"`
+	UpdatedAt              time.Time            `json:"updatedAt"`
+}
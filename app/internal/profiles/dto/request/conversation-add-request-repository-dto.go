@@ -0,0 +1,10 @@
+package request
+
+import "time"
+
+type ConversationAddRequestRepositoryDto struct {
+	TelegramUserId     string    `json:"telegramUserId"`
+	PeerTelegramUserId string    `json:"peerTelegramUserId"`
+	CreatedAt          time.Time `json:"createdAt"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,10 @@
+package request
+
+import "time"
+
+type ConversationUpdateLastMessageRequestRepositoryDto struct {
+	Id            uint64    `json:"id"`
+	LastMessageId uint64    `json:"lastMessageId"`
+	LastMessageAt time.Time `json:"lastMessageAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
@@ -5,10 +5,12 @@ import (
 )
 
 type ImageAddRequestRepositoryDto struct {
-	TelegramUserId string    `json:"telegramUserId"`
-	Name           string    `json:"name"`
-	Url            string    `json:"url"`
-	Size           int64     `json:"size"`
-	CreatedAt      time.Time `json:"createdAt"`
-	UpdatedAt      time.Time `json:"updatedAt"`
+	TelegramUserId string                                 `json:"telegramUserId"`
+	Name           string                                 `json:"name"`
+	Url            string                                 `json:"url"`
+	Size           int64                                  `json:"size"`
+	ContentHash    string                                 `json:"contentHash"`
+	Variants       []*ImageVariantAddRequestRepositoryDto `json:"variants"`
+	CreatedAt      time.Time                              `json:"createdAt"`
+	UpdatedAt      time.Time                              `json:"updatedAt"`
 }
@@ -0,0 +1,6 @@
+package request
+
+type ImageReorderRequestDto struct {
+	TelegramUserId string   `json:"telegramUserId"`
+	ImageIds       []uint64 `json:"imageIds"`
+}
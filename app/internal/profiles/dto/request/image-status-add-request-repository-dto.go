@@ -1,12 +1,16 @@
 package request
 
-import "time"
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
 
 type ImageStatusAddRequestRepositoryDto struct {
-	ImageId   uint64    `json:"imageId"`
-	IsBlocked bool      `json:"isBlocked"`
-	IsPrimary bool      `json:"isPrimary"`
-	IsPrivate bool      `json:"isPrivate"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ImageId          uint64                     `json:"imageId"`
+	IsBlocked        bool                       `json:"isBlocked"`
+	IsPrimary        bool                       `json:"isPrimary"`
+	IsPrivate        bool                       `json:"isPrivate"`
+	ModerationStatus enum.ImageModerationStatus `json:"moderationStatus"`
+	CreatedAt        time.Time                  `json:"createdAt"`
+	UpdatedAt        time.Time                  `json:"updatedAt"`
 }
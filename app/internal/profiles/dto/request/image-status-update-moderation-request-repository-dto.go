@@ -0,0 +1,13 @@
+package request
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type ImageStatusUpdateModerationRequestRepositoryDto struct {
+	ImageId          uint64                     `json:"imageId"`
+	ModerationStatus enum.ImageModerationStatus `json:"moderationStatus"`
+	ModerationReason string                     `json:"moderationReason"`
+	ModeratedAt      time.Time                  `json:"moderatedAt"`
+}
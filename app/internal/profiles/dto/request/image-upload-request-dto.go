@@ -0,0 +1,7 @@
+package request
+
+type ImageUploadRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+	Filename       string `json:"filename"`
+	Size           int64  `json:"size"`
+}
@@ -0,0 +1,17 @@
+package request
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type ImageVariantAddRequestRepositoryDto struct {
+	ImageId   uint64            `json:"imageId"`
+	Variant   enum.ImageVariant `json:"variant"`
+	Key       string            `json:"key"`
+	Url       string            `json:"url"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Size      int64             `json:"size"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
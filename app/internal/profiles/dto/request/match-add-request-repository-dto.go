@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type MatchAddRequestRepositoryDto struct {
+	TelegramUserId        string    `json:"telegramUserId"`
+	MatchedTelegramUserId string    `json:"matchedTelegramUserId"`
+	CreatedAt             time.Time `json:"createdAt"`
+}
@@ -0,0 +1,12 @@
+package request
+
+import "time"
+
+type MessageAddRequestRepositoryDto struct {
+	ConversationId          uint64    `json:"conversationId"`
+	SenderTelegramUserId    string    `json:"senderTelegramUserId"`
+	RecipientTelegramUserId string    `json:"recipientTelegramUserId"`
+	Text                    string    `json:"text"`
+	IsRead                  bool      `json:"isRead"`
+	CreatedAt               time.Time `json:"createdAt"`
+}
@@ -0,0 +1,8 @@
+package request
+
+type MessageGetListRequestDto struct {
+	TelegramUserId string `json:"telegramUserId"`
+	ConversationId uint64 `json:"conversationId"`
+	Cursor         string `json:"cursor"`
+	Limit          uint64 `json:"limit"`
+}
@@ -0,0 +1,7 @@
+package request
+
+type MessageGetListRequestRepositoryDto struct {
+	ConversationId uint64 `json:"conversationId"`
+	BeforeId       uint64 `json:"beforeId"`
+	Limit          uint64 `json:"limit"`
+}
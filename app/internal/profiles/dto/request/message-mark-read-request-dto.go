@@ -0,0 +1,7 @@
+package request
+
+type MessageMarkReadRequestDto struct {
+	TelegramUserId    string `json:"telegramUserId"`
+	ConversationId    uint64 `json:"conversationId"`
+	LastReadMessageId uint64 `json:"lastReadMessageId"`
+}
@@ -0,0 +1,10 @@
+package request
+
+import "time"
+
+type MessageMarkReadRequestRepositoryDto struct {
+	ConversationId          uint64    `json:"conversationId"`
+	RecipientTelegramUserId string    `json:"recipientTelegramUserId"`
+	LastReadMessageId       uint64    `json:"lastReadMessageId"`
+	ReadAt                  time.Time `json:"readAt"`
+}
@@ -0,0 +1,12 @@
+package request
+
+import "time"
+
+type OutboxAddRequestRepositoryDto struct {
+	Topic         string    `json:"topic"`
+	MessageKey    string    `json:"messageKey"`
+	Payload       []byte    `json:"payload"`
+	IsSent        bool      `json:"isSent"`
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
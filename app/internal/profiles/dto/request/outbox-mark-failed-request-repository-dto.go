@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type OutboxMarkFailedRequestRepositoryDto struct {
+	Id            uint64    `json:"id"`
+	LastError     string    `json:"lastError"`
+	NextAttemptAt time.Time `json:"nextAttemptAt"`
+}
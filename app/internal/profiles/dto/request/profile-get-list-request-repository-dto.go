@@ -6,8 +6,7 @@ type ProfileGetListRequestRepositoryDto struct {
 	AgeFrom        uint64  `json:"ageFrom"`
 	AgeTo          uint64  `json:"ageTo"`
 	Distance       float64 `json:"distance"`
-	Page           uint64  `json:"page"`
-	Size           uint64  `json:"size"`
+	Limit          uint64  `json:"limit"` // the number of the nearest candidates to rank
 	IsLiked        bool    `json:"isLiked"`
 	IsOnline       bool    `json:"isOnline"`
 }
@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type ProfileListSnapshotAddRequestRepositoryDto struct {
+	TelegramUserId           string    `json:"telegramUserId"`
+	CandidateTelegramUserIds []string  `json:"candidateTelegramUserIds"`
+	CreatedAt                time.Time `json:"createdAt"`
+}
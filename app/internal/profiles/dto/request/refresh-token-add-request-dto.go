@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type RefreshTokenAddRequestDto struct {
+	TelegramUserId string    `json:"telegramUserId"`
+	TokenHash      string    `json:"tokenHash"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
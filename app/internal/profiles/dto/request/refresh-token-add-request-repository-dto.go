@@ -0,0 +1,10 @@
+package request
+
+import "time"
+
+type RefreshTokenAddRequestRepositoryDto struct {
+	TelegramUserId string    `json:"telegramUserId"`
+	TokenHash      string    `json:"tokenHash"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
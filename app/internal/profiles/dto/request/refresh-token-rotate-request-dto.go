@@ -0,0 +1,9 @@
+package request
+
+import "time"
+
+type RefreshTokenRotateRequestDto struct {
+	TokenHash    string    `json:"tokenHash"`
+	NewTokenHash string    `json:"newTokenHash"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type UnmatchRequestDto struct {
+	TelegramUserId        string `json:"telegramUserId"`
+	MatchedTelegramUserId string `json:"matchedTelegramUserId"`
+}
@@ -0,0 +1,8 @@
+package response
+
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+
+type AdminAuditListResponseDto struct {
+	*entity.PaginationEntity
+	Content []*entity.AdminAuditEntity `json:"content"`
+}
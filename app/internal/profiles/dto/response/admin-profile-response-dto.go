@@ -0,0 +1,13 @@
+package response
+
+type AdminProfileResponseDto struct {
+	TelegramUserId string                        `json:"telegramUserId"`
+	DisplayName    string                        `json:"displayName"`
+	Age            uint64                        `json:"age"`
+	Gender         string                        `json:"gender"`
+	Description    string                        `json:"description"`
+	IsBlocked      bool                          `json:"isBlocked"`
+	IsFrozen       bool                          `json:"isFrozen"`
+	ComplaintCount uint64                        `json:"complaintCount"`
+	Images         []*ImageResponseRepositoryDto `json:"images"`
+}
@@ -0,0 +1,14 @@
+package response
+
+import "time"
+
+type ComplaintListItemResponseDto struct {
+	Id                     uint64    `json:"id"`
+	TelegramUserId         string    `json:"telegramUserId"`
+	CriminalTelegramUserId string    `json:"criminalTelegramUserId"`
+	Type                   string    `json:"type"`
+	Description            string    `json:"description"`
+	Status                 string    `json:"status"`
+	Weight                 float64   `json:"weight"`
+	CreatedAt              time.Time `json:"createdAt"`
+}
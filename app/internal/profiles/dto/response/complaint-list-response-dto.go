@@ -0,0 +1,8 @@
+package response
+
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+
+type ComplaintListResponseDto struct {
+	*entity.PaginationEntity
+	Content []*ComplaintListItemResponseDto `json:"content"`
+}
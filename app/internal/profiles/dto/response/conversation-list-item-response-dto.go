@@ -0,0 +1,13 @@
+package response
+
+import "time"
+
+type ConversationListItemResponseDto struct {
+	Id                 uint64     `json:"id"`
+	PeerTelegramUserId string     `json:"peerTelegramUserId"`
+	DisplayName        string     `json:"displayName"`
+	Url                string     `json:"url"`
+	LastMessage        string     `json:"lastMessage"`
+	LastMessageAt      *time.Time `json:"lastMessageAt"`
+	UnreadCount        uint64     `json:"unreadCount"`
+}
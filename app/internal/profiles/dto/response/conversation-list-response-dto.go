@@ -0,0 +1,5 @@
+package response
+
+type ConversationListResponseDto struct {
+	Content []*ConversationListItemResponseDto `json:"content"`
+}
@@ -0,0 +1,7 @@
+package response
+
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+
+type ImageGrantListResponseDto struct {
+	Content []*entity.ImageGrantEntity `json:"content"`
+}
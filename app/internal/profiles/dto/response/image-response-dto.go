@@ -1,8 +1,10 @@
 package response
 
 type ImageResponseDto struct {
-	Id             uint64 `json:"id"`
-	TelegramUserId string `json:"telegramUserId"`
-	Name           string `json:"name"`
-	Url            string `json:"url"`
+	Id             uint64                     `json:"id"`
+	TelegramUserId string                     `json:"telegramUserId"`
+	Name           string                     `json:"name"`
+	Url            string                     `json:"url"`
+	IsPrivate      bool                       `json:"isPrivate"`
+	Variants       []*ImageVariantResponseDto `json:"variants"`
 }
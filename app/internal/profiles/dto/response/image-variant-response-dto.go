@@ -0,0 +1,12 @@
+package response
+
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+
+type ImageVariantResponseDto struct {
+	ImageId uint64            `json:"imageId"`
+	Variant enum.ImageVariant `json:"variant"`
+	Key     string            `json:"key"`
+	Url     string            `json:"url"`
+	Width   int               `json:"width"`
+	Height  int               `json:"height"`
+}
@@ -0,0 +1,11 @@
+package response
+
+import "time"
+
+type LikeListItemResponseDto struct {
+	TelegramUserId string    `json:"telegramUserId"`
+	DisplayName    string    `json:"displayName"`
+	Username       string    `json:"username"`
+	Url            string    `json:"url"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,5 @@
+package response
+
+type LikeListResponseDto struct {
+	Content []*LikeListItemResponseDto `json:"content"`
+}
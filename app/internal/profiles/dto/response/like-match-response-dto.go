@@ -0,0 +1,6 @@
+package response
+
+type LikeMatchResponseDto struct {
+	Success bool `json:"success"`
+	IsMatch bool `json:"isMatch"`
+}
@@ -0,0 +1,11 @@
+package response
+
+import "time"
+
+type MatchListItemResponseDto struct {
+	MatchedTelegramUserId string    `json:"matchedTelegramUserId"`
+	DisplayName           string    `json:"displayName"`
+	Username              string    `json:"username"`
+	Url                   string    `json:"url"`
+	CreatedAt             time.Time `json:"createdAt"`
+}
@@ -0,0 +1,5 @@
+package response
+
+type MatchListResponseDto struct {
+	Content []*MatchListItemResponseDto `json:"content"`
+}
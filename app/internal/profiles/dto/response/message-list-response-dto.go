@@ -0,0 +1,7 @@
+package response
+
+type MessageListResponseDto struct {
+	Content    []*MessageResponseDto `json:"content"`
+	NextCursor string                `json:"nextCursor"`
+	HasNext    bool                  `json:"hasNext"`
+}
@@ -0,0 +1,13 @@
+package response
+
+import "time"
+
+type MessageResponseDto struct {
+	Id                      uint64    `json:"id"`
+	ConversationId          uint64    `json:"conversationId"`
+	SenderTelegramUserId    string    `json:"senderTelegramUserId"`
+	RecipientTelegramUserId string    `json:"recipientTelegramUserId"`
+	Text                    string    `json:"text"`
+	IsRead                  bool      `json:"isRead"`
+	CreatedAt               time.Time `json:"createdAt"`
+}
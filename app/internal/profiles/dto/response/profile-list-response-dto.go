@@ -1,8 +1,11 @@
 package response
 
-import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
-
+// ProfileListResponseDto - TotalEntities is the number of the ranked candidates, it is approximate
+// when there are more candidates than are ranked
 type ProfileListResponseDto struct {
-	*entity.PaginationEntity
-	Content []*ProfileListItemResponseDto `json:"content"`
+	Content            []*ProfileListItemResponseDto `json:"content"`
+	NextCursor         string                        `json:"nextCursor"`
+	HasNext            bool                          `json:"hasNext"`
+	TotalEntities      uint64                        `json:"totalEntities"`
+	IsTotalApproximate bool                          `json:"isTotalApproximate"`
 }
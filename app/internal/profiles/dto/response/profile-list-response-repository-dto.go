@@ -3,6 +3,5 @@ package response
 import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 
 type ProfileListResponseRepositoryDto struct {
-	*entity.PaginationEntity
-	Content []*ProfileListItemResponseDto `json:"content"`
+	Content []*entity.ProfileCandidateEntity `json:"content"`
 }
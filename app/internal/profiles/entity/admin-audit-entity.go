@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type AdminAuditEntity struct {
+	Id                   uint64           `json:"id"`
+	AdminTelegramUserId  string           `json:"adminTelegramUserId"`
+	Role                 enum.AdminRole   `json:"role"`
+	Action               enum.AdminAction `json:"action"`
+	TargetTelegramUserId *string          `json:"targetTelegramUserId"`
+	TargetImageId        *uint64          `json:"targetImageId"`
+	Reason               string           `json:"reason"`
+	CreatedAt            time.Time        `json:"createdAt"`
+}
@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type AdminEntity struct {
+	Id             uint64         `json:"id"`
+	TelegramUserId string         `json:"telegramUserId"`
+	Role           enum.AdminRole `json:"role"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type ComplaintAppealEntity struct {
+	Id             uint64                     `json:"id"`
+	TelegramUserId string                     `json:"telegramUserId"`
+	Description    string                     `json:"description"`
+	Status         enum.ComplaintAppealStatus `json:"status"`
+	ReviewedBy     *string                    `json:"reviewedBy"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	UpdatedAt      time.Time                  `json:"updatedAt"`
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type ConversationEntity struct {
+	Id                 uint64     `json:"id"`
+	TelegramUserId     string     `json:"telegramUserId"`
+	PeerTelegramUserId string     `json:"peerTelegramUserId"`
+	LastMessageId      *uint64    `json:"lastMessageId"`
+	LastMessageAt      *time.Time `json:"lastMessageAt"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// ImageGrantEntity - the access of the viewer to the private images of the user
+type ImageGrantEntity struct {
+	Id                   uint64    `json:"id"`
+	TelegramUserId       string    `json:"telegramUserId"`
+	ViewerTelegramUserId string    `json:"viewerTelegramUserId"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
@@ -0,0 +1,20 @@
+package entity
+
+// ImageModerationEntity - the image waiting for the moderation
+type ImageModerationEntity struct {
+	ImageId        uint64 `json:"imageId"`
+	TelegramUserId string `json:"telegramUserId"`
+	Name           string `json:"name"`
+	Key            string `json:"key"` // the key of the full variant in the object storage
+	Size           int64  `json:"size"`
+	ContentHash    string `json:"contentHash"`
+	Attempts       uint64 `json:"attempts"`
+}
+
+// ImageModerationResultEntity - the decision of the moderator, Reason is set for a rejected image.
+// IsFailed is set when the image couldn't be checked after all the attempts
+type ImageModerationResultEntity struct {
+	IsApproved bool   `json:"isApproved"`
+	IsFailed   bool   `json:"isFailed"`
+	Reason     string `json:"reason"`
+}
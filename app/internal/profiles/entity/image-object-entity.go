@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+// ImageObjectEntity - the key of the storage object of an image variant
+type ImageObjectEntity struct {
+	ImageId   uint64    `json:"imageId"`
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"createdAt"`
+}
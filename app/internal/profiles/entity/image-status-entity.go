@@ -1,13 +1,19 @@
 package entity
 
-import "time"
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
 
 type ImageStatusEntity struct {
-	Id        uint64    `json:"id"`
-	ImageId   uint64    `json:"imageId"`
-	IsBlocked bool      `json:"isBlocked"`
-	IsPrimary bool      `json:"isPrimary"`
-	IsPrivate bool      `json:"isPrivate"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	Id               uint64                     `json:"id"`
+	ImageId          uint64                     `json:"imageId"`
+	IsBlocked        bool                       `json:"isBlocked"`
+	IsPrimary        bool                       `json:"isPrimary"`
+	IsPrivate        bool                       `json:"isPrivate"`
+	ModerationStatus enum.ImageModerationStatus `json:"moderationStatus"`
+	ModerationReason string                     `json:"moderationReason"`
+	ModeratedAt      *time.Time                 `json:"moderatedAt"`
+	CreatedAt        time.Time                  `json:"createdAt"`
+	UpdatedAt        time.Time                  `json:"updatedAt"`
 }
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type MatchEntity struct {
+	Id                    uint64    `json:"id"`
+	TelegramUserId        string    `json:"telegramUserId"`
+	MatchedTelegramUserId string    `json:"matchedTelegramUserId"`
+	CreatedAt             time.Time `json:"createdAt"`
+}
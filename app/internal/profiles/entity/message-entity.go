@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type MessageEntity struct {
+	Id                      uint64     `json:"id"`
+	ConversationId          uint64     `json:"conversationId"`
+	SenderTelegramUserId    string     `json:"senderTelegramUserId"`
+	RecipientTelegramUserId string     `json:"recipientTelegramUserId"`
+	Text                    string     `json:"text"`
+	IsRead                  bool       `json:"isRead"`
+	ReadAt                  *time.Time `json:"readAt"`
+	CreatedAt               time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type OutboxEntity struct {
+	Id            uint64     `json:"id"`
+	Topic         string     `json:"topic"`
+	MessageKey    string     `json:"messageKey"`
+	Payload       []byte     `json:"payload"`
+	IsSent        bool       `json:"isSent"`
+	Attempts      uint64     `json:"attempts"`
+	LastError     *string    `json:"lastError"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	SentAt        *time.Time `json:"sentAt"`
+}
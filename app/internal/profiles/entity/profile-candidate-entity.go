@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// ProfileCandidateEntity - a profile of the discovery feed with the signals it is ranked by
+type ProfileCandidateEntity struct {
+	TelegramUserId string    `json:"telegramUserId"`
+	Distance       *float64  `json:"distance"`
+	Url            string    `json:"url"`
+	IsLiked        bool      `json:"isLiked"`
+	LastOnline     time.Time `json:"lastOnline"`
+	ImageCount     uint64    `json:"imageCount"`
+	HasDescription bool      `json:"hasDescription"`
+	FilterDistance float64   `json:"filterDistance"` // the search distance of the candidate in kilometers
+	AgeMargin      float64   `json:"ageMargin"`      // the viewer in the age range of the candidate: 0 at a bound, 1 in the middle
+	HasLikedViewer bool      `json:"hasLikedViewer"` // the candidate has already liked the viewer
+	LikeCount      uint64    `json:"likeCount"`      // the likes given by the candidate during the last month
+	Score          float64   `json:"score"`
+}
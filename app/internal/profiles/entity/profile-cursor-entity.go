@@ -0,0 +1,7 @@
+package entity
+
+// ProfileCursorEntity - the position of the next page in the stored snapshot of the discovery feed
+type ProfileCursorEntity struct {
+	SnapshotId uint64 `json:"snapshotId"`
+	Position   uint64 `json:"position"`
+}
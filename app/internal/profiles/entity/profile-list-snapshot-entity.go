@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// ProfileListSnapshotEntity - a page of the ranked discovery feed of the viewer. TotalEntities is the number
+// of all the candidates of the feed, CandidateTelegramUserIds are the candidates of the page in the ranked order
+type ProfileListSnapshotEntity struct {
+	Id                       uint64    `json:"id"`
+	TelegramUserId           string    `json:"telegramUserId"`
+	TotalEntities            uint64    `json:"totalEntities"`
+	CandidateTelegramUserIds []string  `json:"candidateTelegramUserIds"`
+	CreatedAt                time.Time `json:"createdAt"`
+}
@@ -0,0 +1,7 @@
+package entity
+
+// RankingViewerEntity - the user the discovery feed is ranked for
+type RankingViewerEntity struct {
+	TelegramUserId string  `json:"telegramUserId"`
+	Distance       float64 `json:"distance"` // the search distance of the viewer in kilometers
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type RefreshTokenEntity struct {
+	Id             uint64     `json:"id"`
+	TelegramUserId string     `json:"telegramUserId"`
+	TokenHash      string     `json:"tokenHash"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	RevokedAt      *time.Time `json:"revokedAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+type StorageObjectEntity struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
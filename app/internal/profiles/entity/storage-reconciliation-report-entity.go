@@ -0,0 +1,12 @@
+package entity
+
+// StorageReconciliationReportEntity - the result of the comparison of the storage with the images in the database.
+// OrphanedObjects have no image, MissingObjects belong to the images whose files are not in the storage
+type StorageReconciliationReportEntity struct {
+	IsDryRun        bool                   `json:"isDryRun"`
+	ObjectCount     int                    `json:"objectCount"`
+	ImageKeyCount   int                    `json:"imageKeyCount"`
+	OrphanedObjects []*StorageObjectEntity `json:"orphanedObjects"`
+	MissingObjects  []*ImageObjectEntity   `json:"missingObjects"`
+	DeletedCount    int                    `json:"deletedCount"`
+}
@@ -0,0 +1,38 @@
+package moderation
+
+import (
+	"context"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+)
+
+// HashBlocklist - the hashes of the images blocked before, implemented by psql.ImageHashBlocklistRepository
+type HashBlocklist interface {
+	Exists(ctx context.Context, hash string) (bool, error)
+}
+
+// HashBlocklistModerator - rejects the images that have already been blocked by an admin,
+// so the same file can't be uploaded again
+type HashBlocklistModerator struct {
+	blocklist HashBlocklist
+}
+
+func NewHashBlocklistModerator(b HashBlocklist) *HashBlocklistModerator {
+	return &HashBlocklistModerator{
+		blocklist: b,
+	}
+}
+
+func (m *HashBlocklistModerator) Moderate(
+	ctx context.Context, image *entity.ImageModerationEntity, content []byte) (*entity.ImageModerationResultEntity, error) {
+	if image.ContentHash == "" {
+		return approve(), nil
+	}
+	exists, err := m.blocklist.Exists(ctx, image.ContentHash)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return reject(ReasonBlocklisted), nil
+	}
+	return approve(), nil
+}
@@ -0,0 +1,24 @@
+package moderation
+
+import "github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+
+// The reasons of a rejection, they are sent to the owner of the image
+const (
+	ReasonInvalidFormat = "invalid_format"
+	ReasonTooSmall      = "too_small"
+	ReasonTooLarge      = "too_large"
+	ReasonBlocklisted   = "blocklisted"
+)
+
+func approve() *entity.ImageModerationResultEntity {
+	return &entity.ImageModerationResultEntity{
+		IsApproved: true,
+	}
+}
+
+func reject(reason string) *entity.ImageModerationResultEntity {
+	return &entity.ImageModerationResultEntity{
+		IsApproved: false,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,36 @@
+package moderation
+
+import (
+	"context"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"net/http"
+	"strings"
+)
+
+// RuleModerator - checks the stored file itself: it must be an image and its size must be within the bounds
+type RuleModerator struct {
+	minSize int64
+	maxSize int64
+}
+
+func NewRuleModerator(minSize, maxSize int64) *RuleModerator {
+	return &RuleModerator{
+		minSize: minSize,
+		maxSize: maxSize,
+	}
+}
+
+func (m *RuleModerator) Moderate(
+	ctx context.Context, image *entity.ImageModerationEntity, content []byte) (*entity.ImageModerationResultEntity, error) {
+	if !strings.HasPrefix(http.DetectContentType(content), "image/") {
+		return reject(ReasonInvalidFormat), nil
+	}
+	size := int64(len(content))
+	if size < m.minSize {
+		return reject(ReasonTooSmall), nil
+	}
+	if m.maxSize > 0 && size > m.maxSize {
+		return reject(ReasonTooLarge), nil
+	}
+	return approve(), nil
+}
@@ -0,0 +1,36 @@
+package moderation
+
+import (
+	"context"
+	"io"
+)
+
+// ObjectDownloader - reads the objects of the storage, implemented by the backends of the storage package
+type ObjectDownloader interface {
+	Download(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// StorageImageLoader - reads an uploaded image from the object storage by its key, so the private images
+// are loaded with the credentials of the service instead of their public urls
+type StorageImageLoader struct {
+	storage ObjectDownloader
+	maxSize int64
+}
+
+func NewStorageImageLoader(s ObjectDownloader, maxSize int64) *StorageImageLoader {
+	return &StorageImageLoader{
+		storage: s,
+		maxSize: maxSize,
+	}
+}
+
+func (l *StorageImageLoader) Load(ctx context.Context, key string) ([]byte, error) {
+	body, err := l.storage.Download(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	// One byte over the limit is read, so a larger file is rejected by RuleModerator instead of
+	// being read entirely
+	return io.ReadAll(io.LimitReader(body, l.maxSize+1))
+}
@@ -0,0 +1,12 @@
+package ranking
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"time"
+)
+
+// Ranker - scores the candidates for the viewer and sorts them from the best one.
+// The scores depend only on the candidates, the viewer and now, so a ranking can be repeated
+type Ranker interface {
+	Rank(viewer *entity.RankingViewerEntity, candidates []*entity.ProfileCandidateEntity, now time.Time)
+}
@@ -0,0 +1,69 @@
+package ranking
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"math"
+	"time"
+)
+
+const (
+	// activityHalfLife - the activity signal halves every activityHalfLife since the candidate was online
+	activityHalfLife = 24 * time.Hour
+	// completenessImageCount - the number of the images that makes the images part of the profile complete
+	completenessImageCount = 3
+	// likeActivitySmoothing - the number of the given likes at which the like activity of a candidate is 0.5
+	likeActivitySmoothing = 20
+)
+
+// distanceSignal - 1 for the candidate next to the viewer, 0 at the search distance of the viewer
+// and for the candidate with an unknown location
+func distanceSignal(viewer *entity.RankingViewerEntity, c *entity.ProfileCandidateEntity) float64 {
+	if c.Distance == nil || viewer.Distance <= 0 {
+		return 0
+	}
+	maxDistance := viewer.Distance * 1000
+	return 1 - math.Min(*c.Distance, maxDistance)/maxDistance
+}
+
+// activitySignal - 1 for the candidate who is online now, it halves every activityHalfLife
+func activitySignal(c *entity.ProfileCandidateEntity, now time.Time) float64 {
+	since := now.Sub(c.LastOnline)
+	if since <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, since.Hours()/activityHalfLife.Hours())
+}
+
+// completenessSignal - the images and the description make up a half of the signal each
+func completenessSignal(c *entity.ProfileCandidateEntity) float64 {
+	images := math.Min(float64(c.ImageCount), completenessImageCount) / completenessImageCount
+	description := 0.0
+	if c.HasDescription {
+		description = 1
+	}
+	return (images + description) / 2
+}
+
+// compatibilitySignal - how far inside the filter of the candidate the viewer falls. Every candidate accepts
+// the viewer, the signal is 1 for the viewer in the middle of the age range of the candidate and next to them,
+// it goes down to 0 at the bounds of the age range and the search distance. The age range alone counts
+// for the candidate with an unknown location
+func compatibilitySignal(c *entity.ProfileCandidateEntity) float64 {
+	age := math.Max(0, math.Min(c.AgeMargin, 1))
+	if c.Distance == nil || c.FilterDistance <= 0 {
+		return age
+	}
+	maxDistance := c.FilterDistance * 1000
+	distance := 1 - math.Min(*c.Distance, maxDistance)/maxDistance
+	return (age + distance) / 2
+}
+
+// likeBackSignal - the probability that the candidate likes the viewer back. It is certain when the candidate
+// has already liked the viewer, otherwise it grows with the number of the likes the candidate gives, up to 0.5
+func likeBackSignal(c *entity.ProfileCandidateEntity) float64 {
+	if c.HasLikedViewer {
+		return 1
+	}
+	likeCount := float64(c.LikeCount)
+	return 0.5 * likeCount / (likeCount + likeActivitySmoothing)
+}
@@ -0,0 +1,39 @@
+package ranking
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"hash/fnv"
+	"time"
+)
+
+// SplitRanker - the A/B test of two strategies. The viewers are split by the hash of their telegramUserId,
+// so a viewer always gets the same strategy, and experimentPercent of them get the experiment one
+type SplitRanker struct {
+	control           Ranker
+	experiment        Ranker
+	experimentPercent uint32
+}
+
+func NewSplitRanker(control, experiment Ranker, experimentPercent uint32) *SplitRanker {
+	return &SplitRanker{
+		control:           control,
+		experiment:        experiment,
+		experimentPercent: experimentPercent,
+	}
+}
+
+func (r *SplitRanker) Rank(
+	viewer *entity.RankingViewerEntity, candidates []*entity.ProfileCandidateEntity, now time.Time) {
+	if r.IsExperiment(viewer.TelegramUserId) {
+		r.experiment.Rank(viewer, candidates, now)
+		return
+	}
+	r.control.Rank(viewer, candidates, now)
+}
+
+// IsExperiment - whether the viewer is in the experiment group
+func (r *SplitRanker) IsExperiment(telegramUserId string) bool {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(telegramUserId))
+	return h.Sum32()%100 < r.experimentPercent
+}
@@ -0,0 +1,36 @@
+package ranking
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"sort"
+	"time"
+)
+
+// WeightedRanker - scores a candidate as the weighted sum of the signals. The candidates with the same score
+// are ordered by their telegramUserId, so the order is stable
+type WeightedRanker struct {
+	weights *Weights
+}
+
+func NewWeightedRanker(w *Weights) *WeightedRanker {
+	return &WeightedRanker{
+		weights: w,
+	}
+}
+
+func (r *WeightedRanker) Rank(
+	viewer *entity.RankingViewerEntity, candidates []*entity.ProfileCandidateEntity, now time.Time) {
+	for _, c := range candidates {
+		c.Score = r.weights.Distance*distanceSignal(viewer, c) +
+			r.weights.Activity*activitySignal(c, now) +
+			r.weights.Completeness*completenessSignal(c) +
+			r.weights.Compatibility*compatibilitySignal(c) +
+			r.weights.LikeBack*likeBackSignal(c)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].TelegramUserId < candidates[j].TelegramUserId
+	})
+}
@@ -0,0 +1,48 @@
+package ranking
+
+import (
+	"fmt"
+)
+
+const (
+	SignalDistance      = "distance"
+	SignalActivity      = "activity"
+	SignalCompleteness  = "completeness"
+	SignalCompatibility = "compatibility"
+	SignalLikeBack      = "likeBack"
+)
+
+// Weights - the weight of every signal in the score, every signal is in [0, 1]
+type Weights struct {
+	Distance      float64
+	Activity      float64
+	Completeness  float64
+	Compatibility float64
+	LikeBack      float64
+}
+
+// NewWeights - reads the weights from the config, e.g. "distance:0.4,activity:0.3".
+// A signal that is not listed has no weight
+func NewWeights(values map[string]float64) (*Weights, error) {
+	w := &Weights{}
+	for signal, value := range values {
+		if value < 0 {
+			return nil, fmt.Errorf("weight of the signal %s is negative", signal)
+		}
+		switch signal {
+		case SignalDistance:
+			w.Distance = value
+		case SignalActivity:
+			w.Activity = value
+		case SignalCompleteness:
+			w.Completeness = value
+		case SignalCompatibility:
+			w.Compatibility = value
+		case SignalLikeBack:
+			w.LikeBack = value
+		default:
+			return nil, fmt.Errorf("unknown ranking signal %s", signal)
+		}
+	}
+	return w, nil
+}
@@ -0,0 +1,106 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+	"strings"
+)
+
+const (
+	errorFilePathAdminAudit = "internal/repository/psql/admin-audit-repository.go"
+)
+
+type AdminAuditRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewAdminAuditRepository(l logger.Logger, db DBTX) *AdminAuditRepository {
+	return &AdminAuditRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+func (r *AdminAuditRepository) Add(
+	ctx context.Context, p *request.AdminAuditAddRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.admin_audit_logs (admin_telegram_user_id, role, action, target_telegram_user_id," +
+		" target_image_id, reason, created_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	_, err := r.db.ExecContext(ctx, query, &p.AdminTelegramUserId, &p.Role, &p.Action, p.TargetTelegramUserId,
+		p.TargetImageId, &p.Reason, &p.CreatedAt)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	auditResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return auditResponse, nil
+}
+
+// SelectList - returns the audit trail, the latest actions first
+func (r *AdminAuditRepository) SelectList(
+	ctx context.Context, pr *request.AdminAuditGetListRequestRepositoryDto) (*response.AdminAuditListResponseDto, error) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	if pr.ActorTelegramUserId != nil {
+		args = append(args, *pr.ActorTelegramUserId)
+		conditions = append(conditions, fmt.Sprintf("admin_telegram_user_id = $%d", len(args)))
+	}
+	if pr.TargetTelegramUserId != nil {
+		args = append(args, *pr.TargetTelegramUserId)
+		conditions = append(conditions, fmt.Sprintf("target_telegram_user_id = $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	var totalEntities uint64
+	countQuery := "SELECT COUNT(*) FROM dating.admin_audit_logs" + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalEntities); err != nil {
+		errorMessage := r.getErrorMessage("SelectList", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	offset := (pr.Page - 1) * pr.Size
+	query := "SELECT id, admin_telegram_user_id, role, action, target_telegram_user_id, target_image_id, reason," +
+		" created_at" +
+		" FROM dating.admin_audit_logs" + where +
+		" ORDER BY id DESC" +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	rows, err := r.db.QueryContext(ctx, query, append(args, pr.Size, offset)...)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectList", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	auditList := make([]*entity.AdminAuditEntity, 0)
+	for rows.Next() {
+		p := &entity.AdminAuditEntity{}
+		err := rows.Scan(&p.Id, &p.AdminTelegramUserId, &p.Role, &p.Action, &p.TargetTelegramUserId,
+			&p.TargetImageId, &p.Reason, &p.CreatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectList", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		auditList = append(auditList, p)
+	}
+	return &response.AdminAuditListResponseDto{
+		PaginationEntity: entity.GetPagination(pr.Page, pr.Size, totalEntities),
+		Content:          auditList,
+	}, nil
+}
+
+func (r *AdminAuditRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathAdminAudit)
+}
@@ -0,0 +1,52 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathAdmin = "internal/repository/psql/admin-repository.go"
+)
+
+type AdminRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewAdminRepository(l logger.Logger, db DBTX) *AdminRepository {
+	return &AdminRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+// FindByTelegramUserId - returns nil if the user is not an admin
+func (r *AdminRepository) FindByTelegramUserId(
+	ctx context.Context, telegramUserId string) (*entity.AdminEntity, error) {
+	p := &entity.AdminEntity{}
+	query := "SELECT id, telegram_user_id, role, created_at, updated_at" +
+		" FROM dating.admins" +
+		" WHERE telegram_user_id = $1"
+	row := r.db.QueryRowContext(ctx, query, telegramUserId)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.Role, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindByTelegramUserId", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *AdminRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathAdmin)
+}
@@ -19,10 +19,10 @@ const (
 
 type BlockRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewBlockRepository(l logger.Logger, db *sql.DB) *BlockRepository {
+func NewBlockRepository(l logger.Logger, db DBTX) *BlockRepository {
 	return &BlockRepository{
 		logger: l,
 		db:     db,
@@ -77,11 +77,12 @@ func (r *BlockRepository) GetBlockedList(ctx context.Context,
 	query := "WITH filtered_profiles AS (" +
 		"SELECT pb.id, pb.telegram_user_id, pb.blocked_telegram_user_id, pb.initiator_id, pb.is_blocked," +
 		" pb.created_at, pb.updated_at," +
-		" (SELECT url FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
+		" (SELECT COALESCE(piv.url, pi.url) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" LEFT JOIN dating.profile_image_variants piv ON piv.image_id = pi.id AND piv.variant = 'thumbnail'" +
 		" WHERE pi.telegram_user_id = pb.blocked_telegram_user_id AND" +
-		" pis.is_blocked = false AND pis.is_private = false" +
-		" ORDER BY pi.created_at DESC LIMIT 1) AS url" +
+		" pis.is_blocked = false AND pis.is_private = false AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id LIMIT 1) AS url" +
 		" FROM dating.profile_blocks pb" +
 		" JOIN dating.profile_statuses ps ON pb.blocked_telegram_user_id = ps.telegram_user_id" +
 		" WHERE pb.telegram_user_id = $1 AND pb.initiator_id = $1 AND pb.is_blocked = true AND ps.is_blocked = false" +
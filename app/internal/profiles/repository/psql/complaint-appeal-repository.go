@@ -0,0 +1,89 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathComplaintAppeal = "internal/repository/psql/complaint-appeal-repository.go"
+)
+
+type ComplaintAppealRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewComplaintAppealRepository(l logger.Logger, db DBTX) *ComplaintAppealRepository {
+	return &ComplaintAppealRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+func (r *ComplaintAppealRepository) Add(
+	ctx context.Context, p *request.ComplaintAppealAddRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.profile_complaint_appeals (telegram_user_id, description, status, created_at," +
+		" updated_at)" +
+		" VALUES ($1, $2, $3, $4, $5)"
+	_, err := r.db.ExecContext(ctx, query, &p.TelegramUserId, &p.Description, &p.Status, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	appealResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return appealResponse, nil
+}
+
+// FindOpenByTelegramUserId - returns the appeal waiting for a decision or nil if there is none
+func (r *ComplaintAppealRepository) FindOpenByTelegramUserId(
+	ctx context.Context, telegramUserId string) (*entity.ComplaintAppealEntity, error) {
+	p := &entity.ComplaintAppealEntity{}
+	query := "SELECT id, telegram_user_id, description, status, reviewed_by, created_at, updated_at" +
+		" FROM dating.profile_complaint_appeals" +
+		" WHERE telegram_user_id = $1 AND status = $2"
+	row := r.db.QueryRowContext(ctx, query, telegramUserId, enum.ComplaintAppealStatusOpen)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.Description, &p.Status, &p.ReviewedBy, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindOpenByTelegramUserId", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *ComplaintAppealRepository) UpdateStatus(ctx context.Context, id uint64, status enum.ComplaintAppealStatus,
+	reviewedBy string) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_complaint_appeals SET status = $1, reviewed_by = $2, updated_at = $3" +
+		" WHERE id = $4"
+	_, err := r.db.ExecContext(ctx, query, status, reviewedBy, time.Now().UTC(), id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateStatus", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	appealResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return appealResponse, nil
+}
+
+func (r *ComplaintAppealRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathComplaintAppeal)
+}
@@ -2,12 +2,14 @@ package psql
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
 	"go.uber.org/zap"
+	"strings"
 )
 
 const (
@@ -16,10 +18,10 @@ const (
 
 type ComplaintRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewComplaintRepository(l logger.Logger, db *sql.DB) *ComplaintRepository {
+func NewComplaintRepository(l logger.Logger, db DBTX) *ComplaintRepository {
 	return &ComplaintRepository{
 		logger: l,
 		db:     db,
@@ -29,11 +31,11 @@ func NewComplaintRepository(l logger.Logger, db *sql.DB) *ComplaintRepository {
 func (r *ComplaintRepository) Add(
 	ctx context.Context, p *request.ComplaintAddRequestRepositoryDto) (*response.ResponseDto, error) {
 	query := "INSERT INTO dating.profile_complaints (telegram_user_id, criminal_telegram_user_id, type, description," +
-		" created_at, updated_at)" +
-		" VALUES ($1, $2, $3, $4, $5, $6)" +
+		" status, weight, created_at, updated_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8)" +
 		" RETURNING id"
 	row := r.db.QueryRowContext(ctx, query, &p.TelegramUserId, &p.CriminalTelegramUserId, &p.Type, &p.Description,
-		p.CreatedAt, &p.UpdatedAt)
+		&p.Status, &p.Weight, p.CreatedAt, &p.UpdatedAt)
 	id := uint64(0)
 	err := row.Scan(&id)
 	if err != nil {
@@ -96,6 +98,162 @@ func (r *ComplaintRepository) GetCountUserComplaintsByCurrentMonth(
 	return countUserComplaints, nil
 }
 
+// SelectList - returns the complaints matching the filters, the latest complaints first
+func (r *ComplaintRepository) SelectList(
+	ctx context.Context, pr *request.ComplaintGetListRequestRepositoryDto) (*response.ComplaintListResponseDto, error) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	if pr.TelegramUserId != nil {
+		args = append(args, *pr.TelegramUserId)
+		conditions = append(conditions, fmt.Sprintf("telegram_user_id = $%d", len(args)))
+	}
+	if pr.CriminalTelegramUserId != nil {
+		args = append(args, *pr.CriminalTelegramUserId)
+		conditions = append(conditions, fmt.Sprintf("criminal_telegram_user_id = $%d", len(args)))
+	}
+	if pr.Type != nil {
+		args = append(args, *pr.Type)
+		conditions = append(conditions, fmt.Sprintf("type = $%d", len(args)))
+	}
+	if pr.DateFrom != nil {
+		args = append(args, *pr.DateFrom)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if pr.DateTo != nil {
+		args = append(args, *pr.DateTo)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if pr.Status != nil {
+		args = append(args, *pr.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	var totalEntities uint64
+	countQuery := "SELECT COUNT(*) FROM dating.profile_complaints" + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalEntities); err != nil {
+		errorMessage := r.getErrorMessage("SelectList", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	offset := (pr.Page - 1) * pr.Size
+	query := "SELECT id, telegram_user_id, criminal_telegram_user_id, type, description, status, weight, created_at" +
+		" FROM dating.profile_complaints" + where +
+		" ORDER BY id DESC" +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+	rows, err := r.db.QueryContext(ctx, query, append(args, pr.Size, offset)...)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectList", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	complaintList := make([]*response.ComplaintListItemResponseDto, 0)
+	for rows.Next() {
+		p := &response.ComplaintListItemResponseDto{}
+		err := rows.Scan(&p.Id, &p.TelegramUserId, &p.CriminalTelegramUserId, &p.Type, &p.Description, &p.Status,
+			&p.Weight, &p.CreatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectList", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		complaintList = append(complaintList, p)
+	}
+	return &response.ComplaintListResponseDto{
+		PaginationEntity: entity.GetPagination(pr.Page, pr.Size, totalEntities),
+		Content:          complaintList,
+	}, nil
+}
+
+func (r *ComplaintRepository) GetCountByCriminalTelegramUserId(
+	ctx context.Context, criminalTelegramUserId string) (uint64, error) {
+	query := "SELECT COUNT(*) FROM dating.profile_complaints WHERE criminal_telegram_user_id = $1"
+	var countComplaints uint64
+	err := r.db.QueryRowContext(ctx, query, criminalTelegramUserId).Scan(&countComplaints)
+	if err != nil {
+		errorMessage := r.getErrorMessage("GetCountByCriminalTelegramUserId", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return countComplaints, nil
+}
+
+// GetWeightByCriminalTelegramUserId - returns the weight of the distinct reporters of the complaints
+// of the reason waiting for a decision. A reporter is counted once with the weight of the heaviest complaint
+func (r *ComplaintRepository) GetWeightByCriminalTelegramUserId(
+	ctx context.Context, criminalTelegramUserId string, complaintType enum.Complaint) (float64, error) {
+	query := "SELECT COALESCE(SUM(weight), 0) FROM (" +
+		" SELECT MAX(weight) AS weight" +
+		" FROM dating.profile_complaints" +
+		" WHERE criminal_telegram_user_id = $1 AND type = $2 AND status IN ($3, $4)" +
+		" GROUP BY telegram_user_id" +
+		" ) reporters"
+	var weight float64
+	err := r.db.QueryRowContext(ctx, query, criminalTelegramUserId, complaintType, enum.ComplaintStatusOpen,
+		enum.ComplaintStatusUnderReview).Scan(&weight)
+	if err != nil {
+		errorMessage := r.getErrorMessage("GetWeightByCriminalTelegramUserId", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return weight, nil
+}
+
+// GetCountByTelegramUserIdAndStatus - counts the complaints sent by the reporter with the status
+func (r *ComplaintRepository) GetCountByTelegramUserIdAndStatus(
+	ctx context.Context, telegramUserId string, status enum.ComplaintStatus) (uint64, error) {
+	query := "SELECT COUNT(*) FROM dating.profile_complaints WHERE telegram_user_id = $1 AND status = $2"
+	var countComplaints uint64
+	err := r.db.QueryRowContext(ctx, query, telegramUserId, status).Scan(&countComplaints)
+	if err != nil {
+		errorMessage := r.getErrorMessage("GetCountByTelegramUserIdAndStatus", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return countComplaints, nil
+}
+
+// GetCountByCriminalTelegramUserIdAndStatus - counts the complaints against the user with the status
+func (r *ComplaintRepository) GetCountByCriminalTelegramUserIdAndStatus(
+	ctx context.Context, criminalTelegramUserId string, status enum.ComplaintStatus) (uint64, error) {
+	query := "SELECT COUNT(*) FROM dating.profile_complaints WHERE criminal_telegram_user_id = $1 AND status = $2"
+	var countComplaints uint64
+	err := r.db.QueryRowContext(ctx, query, criminalTelegramUserId, status).Scan(&countComplaints)
+	if err != nil {
+		errorMessage := r.getErrorMessage("GetCountByCriminalTelegramUserIdAndStatus", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return countComplaints, nil
+}
+
+// UpdateStatusByCriminalTelegramUserId - moves all the complaints against the user from one status to another
+// and returns how many have been moved
+func (r *ComplaintRepository) UpdateStatusByCriminalTelegramUserId(
+	ctx context.Context, p *request.ComplaintUpdateStatusRequestRepositoryDto) (uint64, error) {
+	query := "UPDATE dating.profile_complaints" +
+		" SET status = $1, reviewed_by = COALESCE($2, reviewed_by)," +
+		" reviewed_at = CASE WHEN $2::VARCHAR IS NULL THEN reviewed_at ELSE $3 END, updated_at = $3" +
+		" WHERE criminal_telegram_user_id = $4 AND status = $5"
+	result, err := r.db.ExecContext(ctx, query, p.Status, p.ReviewedBy, p.UpdatedAt, p.CriminalTelegramUserId,
+		p.PreviousStatus)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateStatusByCriminalTelegramUserId", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateStatusByCriminalTelegramUserId", "RowsAffected")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return uint64(rowsAffected), nil
+}
+
 func (r *ComplaintRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
 	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
 		errorFilePathComplaint)
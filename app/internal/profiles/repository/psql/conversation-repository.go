@@ -0,0 +1,142 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathConversation = "internal/repository/psql/conversation-repository.go"
+)
+
+type ConversationRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewConversationRepository(l logger.Logger, db DBTX) *ConversationRepository {
+	return &ConversationRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+// Add - returns the id of the conversation between two users, creating it on the first message
+func (r *ConversationRepository) Add(
+	ctx context.Context, p *request.ConversationAddRequestRepositoryDto) (uint64, error) {
+	query := "INSERT INTO dating.conversations (telegram_user_id, peer_telegram_user_id, created_at, updated_at)" +
+		" VALUES ($1, $2, $3, $4)" +
+		" ON CONFLICT (telegram_user_id, peer_telegram_user_id) DO UPDATE SET updated_at = EXCLUDED.updated_at" +
+		" RETURNING id"
+	row := r.db.QueryRowContext(ctx, query, &p.TelegramUserId, &p.PeerTelegramUserId, &p.CreatedAt, &p.UpdatedAt)
+	id := uint64(0)
+	err := row.Scan(&id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *ConversationRepository) UpdateLastMessage(
+	ctx context.Context, p *request.ConversationUpdateLastMessageRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "UPDATE dating.conversations SET last_message_id = $1, last_message_at = $2, updated_at = $3" +
+		" WHERE id = $4"
+	_, err := r.db.ExecContext(ctx, query, &p.LastMessageId, &p.LastMessageAt, &p.UpdatedAt, &p.Id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateLastMessage", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	conversationResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return conversationResponse, nil
+}
+
+func (r *ConversationRepository) FindById(ctx context.Context, id uint64) (*entity.ConversationEntity, error) {
+	p := &entity.ConversationEntity{}
+	query := "SELECT id, telegram_user_id, peer_telegram_user_id, last_message_id, last_message_at, created_at," +
+		" updated_at" +
+		" FROM dating.conversations" +
+		" WHERE id = $1"
+	row := r.db.QueryRowContext(ctx, query, id)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.PeerTelegramUserId, &p.LastMessageId, &p.LastMessageAt,
+		&p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindById", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *ConversationRepository) SelectListByTelegramUserId(
+	ctx context.Context, telegramUserId string) (*response.ConversationListResponseDto, error) {
+	query := "WITH user_conversations AS (" +
+		"SELECT c.id, CASE WHEN c.telegram_user_id = $1 THEN c.peer_telegram_user_id" +
+		" ELSE c.telegram_user_id END AS peer_telegram_user_id," +
+		" c.last_message_id, c.last_message_at, c.updated_at" +
+		" FROM dating.conversations c" +
+		" WHERE c.telegram_user_id = $1 OR c.peer_telegram_user_id = $1" +
+		" )" +
+		" SELECT uc.id, uc.peer_telegram_user_id, p.display_name," +
+		" COALESCE((SELECT COALESCE(piv.url, pi.url) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" LEFT JOIN dating.profile_image_variants piv ON piv.image_id = pi.id AND piv.variant = 'thumbnail'" +
+		" WHERE pi.telegram_user_id = uc.peer_telegram_user_id AND" +
+		" pis.is_blocked = false AND pis.is_private = false AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id LIMIT 1), '') AS url," +
+		" COALESCE(m.text, ''), uc.last_message_at," +
+		" (SELECT COUNT(*) FROM dating.messages um" +
+		" WHERE um.conversation_id = uc.id AND um.recipient_telegram_user_id = $1 AND um.is_read = false)" +
+		" AS unread_count" +
+		" FROM user_conversations uc" +
+		" JOIN dating.profiles p ON p.telegram_user_id = uc.peer_telegram_user_id" +
+		" JOIN dating.profile_statuses ps ON ps.telegram_user_id = uc.peer_telegram_user_id" +
+		" LEFT JOIN dating.messages m ON m.id = uc.last_message_id" +
+		" WHERE ps.is_blocked = false" +
+		" AND NOT EXISTS (SELECT 1 FROM dating.profile_blocks pb" +
+		" WHERE pb.telegram_user_id = $1 AND pb.blocked_telegram_user_id = uc.peer_telegram_user_id" +
+		" AND pb.is_blocked = true)" +
+		" ORDER BY COALESCE(uc.last_message_at, uc.updated_at) DESC"
+	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	content := make([]*response.ConversationListItemResponseDto, 0)
+	for rows.Next() {
+		p := &response.ConversationListItemResponseDto{}
+		err := rows.Scan(&p.Id, &p.PeerTelegramUserId, &p.DisplayName, &p.Url, &p.LastMessage, &p.LastMessageAt,
+			&p.UnreadCount)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			continue
+		}
+		content = append(content, p)
+	}
+	conversationList := &response.ConversationListResponseDto{
+		Content: content,
+	}
+	return conversationList, nil
+}
+
+func (r *ConversationRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathConversation)
+}
@@ -0,0 +1,14 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+)
+
+// DBTX - common querier implemented by both *sql.DB and *sql.Tx,
+// lets the repositories run inside or outside a transaction
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
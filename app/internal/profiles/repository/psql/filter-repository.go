@@ -2,7 +2,6 @@ package psql
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
@@ -17,10 +16,10 @@ const (
 
 type FilterRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewFilterRepository(l logger.Logger, db *sql.DB) *FilterRepository {
+func NewFilterRepository(l logger.Logger, db DBTX) *FilterRepository {
 	return &FilterRepository{
 		logger: l,
 		db:     db,
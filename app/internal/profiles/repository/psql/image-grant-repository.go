@@ -0,0 +1,110 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathImageGrant = "internal/repository/psql/image-grant-repository.go"
+)
+
+type ImageGrantRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewImageGrantRepository(l logger.Logger, db DBTX) *ImageGrantRepository {
+	return &ImageGrantRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+// Add - grants the viewer access to the private images of the user, a repeated grant is ignored
+func (r *ImageGrantRepository) Add(
+	ctx context.Context, telegramUserId, viewerTelegramUserId string) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.profile_image_grants (telegram_user_id, viewer_telegram_user_id, created_at)" +
+		" VALUES ($1, $2, $3)" +
+		" ON CONFLICT (telegram_user_id, viewer_telegram_user_id) DO NOTHING"
+	_, err := r.db.ExecContext(ctx, query, telegramUserId, viewerTelegramUserId, time.Now().UTC())
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	grantResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return grantResponse, nil
+}
+
+func (r *ImageGrantRepository) Delete(
+	ctx context.Context, telegramUserId, viewerTelegramUserId string) (*response.ResponseDto, error) {
+	query := "DELETE FROM dating.profile_image_grants WHERE telegram_user_id = $1 AND viewer_telegram_user_id = $2"
+	_, err := r.db.ExecContext(ctx, query, telegramUserId, viewerTelegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Delete", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	grantResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return grantResponse, nil
+}
+
+// DeletePair - revokes the grants of both users to each other
+func (r *ImageGrantRepository) DeletePair(
+	ctx context.Context, telegramUserId, otherTelegramUserId string) (*response.ResponseDto, error) {
+	query := "DELETE FROM dating.profile_image_grants" +
+		" WHERE (telegram_user_id = $1 AND viewer_telegram_user_id = $2)" +
+		" OR (telegram_user_id = $2 AND viewer_telegram_user_id = $1)"
+	_, err := r.db.ExecContext(ctx, query, telegramUserId, otherTelegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("DeletePair", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	grantResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return grantResponse, nil
+}
+
+func (r *ImageGrantRepository) SelectListByTelegramUserId(
+	ctx context.Context, telegramUserId string) ([]*entity.ImageGrantEntity, error) {
+	query := "SELECT id, telegram_user_id, viewer_telegram_user_id, created_at" +
+		" FROM dating.profile_image_grants" +
+		" WHERE telegram_user_id = $1" +
+		" ORDER BY created_at DESC"
+	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*entity.ImageGrantEntity, 0)
+	for rows.Next() {
+		p := &entity.ImageGrantEntity{}
+		err := rows.Scan(&p.Id, &p.TelegramUserId, &p.ViewerTelegramUserId, &p.CreatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+func (r *ImageGrantRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathImageGrant)
+}
@@ -0,0 +1,62 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathImageHashBlocklist = "internal/repository/psql/image-hash-blocklist-repository.go"
+)
+
+type ImageHashBlocklistRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewImageHashBlocklistRepository(l logger.Logger, db DBTX) *ImageHashBlocklistRepository {
+	return &ImageHashBlocklistRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+// Add - blocklists the hash of an image, a hash already in the list keeps its first reason
+func (r *ImageHashBlocklistRepository) Add(
+	ctx context.Context, hash, reason string) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.image_hash_blocklist (hash, reason, created_at)" +
+		" VALUES ($1, $2, $3)" +
+		" ON CONFLICT (hash) DO NOTHING"
+	_, err := r.db.ExecContext(ctx, query, hash, reason, time.Now().UTC())
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	blocklistResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return blocklistResponse, nil
+}
+
+func (r *ImageHashBlocklistRepository) Exists(ctx context.Context, hash string) (bool, error) {
+	query := "SELECT EXISTS (SELECT 1 FROM dating.image_hash_blocklist WHERE hash = $1)"
+	row := r.db.QueryRowContext(ctx, query, hash)
+	exists := false
+	err := row.Scan(&exists)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Exists", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *ImageHashBlocklistRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathImageHashBlocklist)
+}
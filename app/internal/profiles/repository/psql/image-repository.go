@@ -2,12 +2,12 @@ package psql
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
 	"go.uber.org/zap"
+	"time"
 )
 
 const (
@@ -16,10 +16,10 @@ const (
 
 type ImageRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewImageRepository(l logger.Logger, db *sql.DB) *ImageRepository {
+func NewImageRepository(l logger.Logger, db DBTX) *ImageRepository {
 	return &ImageRepository{
 		logger: l,
 		db:     db,
@@ -28,9 +28,13 @@ func NewImageRepository(l logger.Logger, db *sql.DB) *ImageRepository {
 
 func (r *ImageRepository) Add(
 	ctx context.Context, p *request.ImageAddRequestRepositoryDto) (uint64, error) {
-	query := "INSERT INTO dating.profile_images (telegram_user_id, name, url, size, created_at, updated_at)" +
-		" VALUES ($1, $2, $3, $4, $5, $6) RETURNING id"
-	row := r.db.QueryRowContext(ctx, query, &p.TelegramUserId, &p.Name, &p.Url, &p.Size, &p.CreatedAt, &p.UpdatedAt)
+	query := "INSERT INTO dating.profile_images (telegram_user_id, name, url, size, content_hash, position," +
+		" created_at, updated_at)" +
+		" VALUES ($1, $2, $3, $4, $5," +
+		" (SELECT COALESCE(MAX(position) + 1, 0) FROM dating.profile_images WHERE telegram_user_id = $1)," +
+		" $6, $7) RETURNING id"
+	row := r.db.QueryRowContext(ctx, query, &p.TelegramUserId, &p.Name, &p.Url, &p.Size, &p.ContentHash,
+		&p.CreatedAt, &p.UpdatedAt)
 	id := uint64(0)
 	err := row.Scan(&id)
 	if err != nil {
@@ -61,7 +65,7 @@ func (r *ImageRepository) FindById(ctx context.Context, imageId uint64) (*respon
 	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
 		" pis.is_private, pi.created_at, pi.updated_at" +
 		" FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
 		" WHERE pi.id = $1"
 	row := r.db.QueryRowContext(ctx, query, imageId)
 	err := row.Scan(&p.Id, &p.TelegramUserId, &p.Name, &p.Url, &p.Size, &p.IsBlocked, &p.IsPrimary, &p.IsPrivate,
@@ -71,9 +75,15 @@ func (r *ImageRepository) FindById(ctx context.Context, imageId uint64) (*respon
 		r.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
+	if err := r.attachRepositoryVariants(ctx, []*response.ImageResponseRepositoryDto{p}); err != nil {
+		errorMessage := r.getErrorMessage("FindById", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
 	return p, nil
 }
 
+// FindLastByTelegramUserId - returns the avatar of the user: the primary image or the first one by position
 func (r *ImageRepository) FindLastByTelegramUserId(
 	ctx context.Context, telegramUserId string) (*response.ImageResponseDto, error) {
 	p := &response.ImageResponseRepositoryDto{}
@@ -81,9 +91,10 @@ func (r *ImageRepository) FindLastByTelegramUserId(
 	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
 		" pis.is_private, pi.created_at, pi.updated_at" +
 		" FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
 		" WHERE pi.telegram_user_id = $1 AND pis.is_blocked = false AND pis.is_private = false" +
-		" ORDER BY pi.id DESC" +
+		" AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id" +
 		" LIMIT 1"
 	row := r.db.QueryRowContext(ctx, query, telegramUserId)
 	err := row.Scan(&p.Id, &p.TelegramUserId, &p.Name, &p.Url, &p.Size, &s.IsBlocked, &s.IsPrimary, &s.IsPrivate,
@@ -99,6 +110,11 @@ func (r *ImageRepository) FindLastByTelegramUserId(
 		Name:           p.Name,
 		Url:            p.Url,
 	}
+	if err := r.attachVariants(ctx, []*response.ImageResponseDto{result}); err != nil {
+		errorMessage := r.getErrorMessage("FindLastByTelegramUserId", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
 	return result, nil
 }
 
@@ -107,7 +123,7 @@ func (r *ImageRepository) SelectListAllByTelegramUserId(
 	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
 		" pis.is_private, pi.created_at, pi.updated_at" +
 		" FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
 		" WHERE pi.telegram_user_id = $1"
 	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
 	if err != nil {
@@ -137,6 +153,48 @@ func (r *ImageRepository) SelectListAllByTelegramUserId(
 		}
 		list = append(list, result)
 	}
+	if err := r.attachVariants(ctx, list); err != nil {
+		errorMessage := r.getErrorMessage("SelectListAllByTelegramUserId", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return list, nil
+}
+
+// SelectListDetailByTelegramUserId - returns all the images of the user with their statuses,
+// including the private and the blocked ones
+func (r *ImageRepository) SelectListDetailByTelegramUserId(
+	ctx context.Context, telegramUserId string) ([]*response.ImageResponseRepositoryDto, error) {
+	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
+		" pis.is_private, pi.created_at, pi.updated_at" +
+		" FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" WHERE pi.telegram_user_id = $1" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id"
+	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListDetailByTelegramUserId", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*response.ImageResponseRepositoryDto, 0)
+	for rows.Next() {
+		p := &response.ImageResponseRepositoryDto{}
+		err := rows.Scan(&p.Id, &p.TelegramUserId, &p.Name, &p.Url, &p.Size, &p.IsBlocked, &p.IsPrimary,
+			&p.IsPrivate, &p.CreatedAt, &p.UpdatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListDetailByTelegramUserId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	if err := r.attachRepositoryVariants(ctx, list); err != nil {
+		errorMessage := r.getErrorMessage("SelectListDetailByTelegramUserId", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
 	return list, nil
 }
 
@@ -145,8 +203,10 @@ func (r *ImageRepository) SelectListPublicByTelegramUserId(
 	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
 		" pis.is_private, pi.created_at, pi.updated_at" +
 		" FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
-		" WHERE pi.telegram_user_id = $1 AND pis.is_blocked = false AND pis.is_private = false"
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" WHERE pi.telegram_user_id = $1 AND pis.is_blocked = false AND pis.is_private = false" +
+		" AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id"
 	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
 	if err != nil {
 		errorMessage := r.getErrorMessage("SelectListPublicByTelegramUserId",
@@ -172,9 +232,15 @@ func (r *ImageRepository) SelectListPublicByTelegramUserId(
 			TelegramUserId: p.TelegramUserId,
 			Name:           p.Name,
 			Url:            p.Url,
+			IsPrivate:      pr.IsPrivate,
 		}
 		list = append(list, result)
 	}
+	if err := r.attachVariants(ctx, list); err != nil {
+		errorMessage := r.getErrorMessage("SelectListPublicByTelegramUserId", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
 	return list, nil
 }
 
@@ -183,8 +249,9 @@ func (r *ImageRepository) SelectListByTelegramUserId(
 	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
 		" pis.is_private, pi.created_at, pi.updated_at" +
 		" FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
-		" WHERE pi.telegram_user_id = $1 AND pis.is_blocked = false"
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" WHERE pi.telegram_user_id = $1 AND pis.is_blocked = false" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id"
 	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
 	if err != nil {
 		errorMessage := r.getErrorMessage("SelectListByTelegramUserId",
@@ -209,12 +276,156 @@ func (r *ImageRepository) SelectListByTelegramUserId(
 			TelegramUserId: p.TelegramUserId,
 			Name:           p.Name,
 			Url:            p.Url,
+			IsPrivate:      pr.IsPrivate,
 		}
 		list = append(list, result)
 	}
+	if err := r.attachVariants(ctx, list); err != nil {
+		errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
 	return list, nil
 }
 
+// SelectListVisibleByTelegramUserId - returns the images shown to the viewer: the approved ones, and the private
+// ones only to their owner and to the viewers holding a grant
+func (r *ImageRepository) SelectListVisibleByTelegramUserId(
+	ctx context.Context, telegramUserId, viewerTelegramUserId string) ([]*response.ImageResponseDto, error) {
+	query := "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url, pi.size, pis.is_blocked, pis.is_primary," +
+		" pis.is_private, pi.created_at, pi.updated_at" +
+		" FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" WHERE pi.telegram_user_id = $1 AND pis.is_blocked = false AND pis.moderation_status = 'approved'" +
+		" AND (pis.is_private = false OR pi.telegram_user_id = $2" +
+		" OR EXISTS (SELECT 1 FROM dating.profile_image_grants pig" +
+		" WHERE pig.telegram_user_id = pi.telegram_user_id AND pig.viewer_telegram_user_id = $2))" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id"
+	rows, err := r.db.QueryContext(ctx, query, telegramUserId, viewerTelegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListVisibleByTelegramUserId",
+			"QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*response.ImageResponseDto, 0)
+	for rows.Next() {
+		p := &response.ImageResponseDto{}
+		pr := &response.ImageResponseRepositoryDto{}
+		err := rows.Scan(&p.Id, &p.TelegramUserId, &p.Name, &p.Url, &pr.Size, &pr.IsBlocked, &pr.IsPrimary,
+			&pr.IsPrivate, &pr.CreatedAt, &pr.UpdatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListVisibleByTelegramUserId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			continue
+		}
+		result := &response.ImageResponseDto{
+			Id:             p.Id,
+			TelegramUserId: p.TelegramUserId,
+			Name:           p.Name,
+			Url:            p.Url,
+			IsPrivate:      pr.IsPrivate,
+		}
+		list = append(list, result)
+	}
+	if err := r.attachVariants(ctx, list); err != nil {
+		errorMessage := r.getErrorMessage("SelectListVisibleByTelegramUserId", "attachVariants")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return list, nil
+}
+
+// UpdateUrl - the public url of the image, it is empty while the image is private
+func (r *ImageRepository) UpdateUrl(ctx context.Context, imageId uint64, url string) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_images SET url = $1, updated_at = $2 WHERE id = $3"
+	_, err := r.db.ExecContext(ctx, query, url, time.Now().UTC(), imageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateUrl", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+func (r *ImageRepository) UpdatePosition(
+	ctx context.Context, imageId uint64, position uint64) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_images SET position = $1, updated_at = $2 WHERE id = $3"
+	_, err := r.db.ExecContext(ctx, query, position, time.Now().UTC(), imageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdatePosition", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return imageResponse, nil
+}
+
+// FindContentHashById - returns the hash of the uploaded file, an empty string for the images uploaded
+// before the hash has been stored
+func (r *ImageRepository) FindContentHashById(ctx context.Context, imageId uint64) (string, error) {
+	query := "SELECT content_hash FROM dating.profile_images WHERE id = $1"
+	row := r.db.QueryRowContext(ctx, query, imageId)
+	contentHash := ""
+	err := row.Scan(&contentHash)
+	if err != nil {
+		errorMessage := r.getErrorMessage("FindContentHashById", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return "", err
+	}
+	return contentHash, nil
+}
+
+// attachVariants - loads the variants of the images with a single query
+func (r *ImageRepository) attachVariants(ctx context.Context, list []*response.ImageResponseDto) error {
+	imageIds := make([]uint64, 0, len(list))
+	for _, image := range list {
+		imageIds = append(imageIds, image.Id)
+	}
+	variantMap, err := r.selectVariantMap(ctx, imageIds)
+	if err != nil {
+		return err
+	}
+	for _, image := range list {
+		image.Variants = variantMap[image.Id]
+	}
+	return nil
+}
+
+func (r *ImageRepository) attachRepositoryVariants(
+	ctx context.Context, list []*response.ImageResponseRepositoryDto) error {
+	imageIds := make([]uint64, 0, len(list))
+	for _, image := range list {
+		imageIds = append(imageIds, image.Id)
+	}
+	variantMap, err := r.selectVariantMap(ctx, imageIds)
+	if err != nil {
+		return err
+	}
+	for _, image := range list {
+		image.Variants = variantMap[image.Id]
+	}
+	return nil
+}
+
+func (r *ImageRepository) selectVariantMap(
+	ctx context.Context, imageIds []uint64) (map[uint64][]*response.ImageVariantResponseDto, error) {
+	variantList, err := NewImageVariantRepository(r.logger, r.db).SelectListByImageIds(ctx, imageIds)
+	if err != nil {
+		return nil, err
+	}
+	variantMap := make(map[uint64][]*response.ImageVariantResponseDto, len(imageIds))
+	for _, variant := range variantList {
+		variantMap[variant.ImageId] = append(variantMap[variant.ImageId], variant)
+	}
+	return variantMap, nil
+}
+
 func (r *ImageRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
 	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
 		errorFilePathImage)
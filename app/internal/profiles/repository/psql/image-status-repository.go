@@ -2,13 +2,14 @@ package psql
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
 	"go.uber.org/zap"
+	"time"
 )
 
 const (
@@ -17,10 +18,10 @@ const (
 
 type ImageStatusRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewImageStatusRepository(l logger.Logger, db *sql.DB) *ImageStatusRepository {
+func NewImageStatusRepository(l logger.Logger, db DBTX) *ImageStatusRepository {
 	return &ImageStatusRepository{
 		logger: l,
 		db:     db,
@@ -30,10 +31,10 @@ func NewImageStatusRepository(l logger.Logger, db *sql.DB) *ImageStatusRepositor
 func (r *ImageStatusRepository) Add(
 	ctx context.Context, p *request.ImageStatusAddRequestRepositoryDto) (*response.ResponseDto, error) {
 	query := "INSERT INTO dating.profile_image_statuses (image_id, is_blocked, is_primary, is_private," +
-		" created_at, updated_at)" +
-		" VALUES ($1, $2, $3, $4, $5, $6) RETURNING id"
-	row := r.db.QueryRowContext(ctx, query, &p.ImageId, &p.IsBlocked, &p.IsPrimary, &p.IsPrivate, &p.CreatedAt,
-		&p.UpdatedAt)
+		" moderation_status, created_at, updated_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id"
+	row := r.db.QueryRowContext(ctx, query, &p.ImageId, &p.IsBlocked, &p.IsPrimary, &p.IsPrivate,
+		&p.ModerationStatus, &p.CreatedAt, &p.UpdatedAt)
 	id := uint64(0)
 	err := row.Scan(&id)
 	if err != nil {
@@ -49,11 +50,13 @@ func (r *ImageStatusRepository) Add(
 
 func (r *ImageStatusRepository) FindById(ctx context.Context, id uint64) (*entity.ImageStatusEntity, error) {
 	p := &entity.ImageStatusEntity{}
-	query := "SELECT id, is_blocked, is_primary, is_private, created_at, updated_at" +
+	query := "SELECT id, image_id, is_blocked, is_primary, is_private, moderation_status, moderation_reason," +
+		" moderated_at, created_at, updated_at" +
 		" FROM dating.profile_image_statuses" +
 		" WHERE id = $1"
 	row := r.db.QueryRowContext(ctx, query, id)
-	err := row.Scan(&p.Id, &p.IsBlocked, &p.IsPrimary, &p.IsPrivate, &p.CreatedAt, &p.UpdatedAt)
+	err := row.Scan(&p.Id, &p.ImageId, &p.IsBlocked, &p.IsPrimary, &p.IsPrivate, &p.ModerationStatus,
+		&p.ModerationReason, &p.ModeratedAt, &p.CreatedAt, &p.UpdatedAt)
 	if err != nil {
 		errorMessage := r.getErrorMessage("FindById", "Scan")
 		r.logger.Debug(errorMessage, zap.Error(err))
@@ -62,6 +65,137 @@ func (r *ImageStatusRepository) FindById(ctx context.Context, id uint64) (*entit
 	return p, nil
 }
 
+// UpdateBlocked - the decision of an admin, it overrides the result of the automatic moderation
+func (r *ImageStatusRepository) UpdateBlocked(
+	ctx context.Context, imageId uint64, isBlocked bool) (*response.ResponseDto, error) {
+	moderationStatus := enum.ImageModerationStatusApproved
+	if isBlocked {
+		moderationStatus = enum.ImageModerationStatusBlocked
+	}
+	query := "UPDATE dating.profile_image_statuses SET is_blocked = $1, moderation_status = $2, moderated_at = $3," +
+		" updated_at = $3 WHERE image_id = $4"
+	_, err := r.db.ExecContext(ctx, query, isBlocked, moderationStatus, time.Now().UTC(), imageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateBlocked", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageStatusResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return imageStatusResponse, nil
+}
+
+func (r *ImageStatusRepository) UpdatePrimary(
+	ctx context.Context, imageId uint64, isPrimary bool) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_image_statuses SET is_primary = $1, updated_at = $2 WHERE image_id = $3"
+	_, err := r.db.ExecContext(ctx, query, isPrimary, time.Now().UTC(), imageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdatePrimary", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageStatusResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return imageStatusResponse, nil
+}
+
+func (r *ImageStatusRepository) UpdatePrivate(
+	ctx context.Context, imageId uint64, isPrivate bool) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_image_statuses SET is_private = $1, updated_at = $2 WHERE image_id = $3"
+	_, err := r.db.ExecContext(ctx, query, isPrivate, time.Now().UTC(), imageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdatePrivate", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageStatusResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return imageStatusResponse, nil
+}
+
+// UpdatePendingModeration - stores the result of the automatic moderation, a blocked image is hidden like
+// the one blocked by an admin. The image moderated in the meantime, e.g. by an admin, is left as is.
+// Returns whether the result has been stored
+func (r *ImageStatusRepository) UpdatePendingModeration(
+	ctx context.Context, p *request.ImageStatusUpdateModerationRequestRepositoryDto) (bool, error) {
+	isBlocked := p.ModerationStatus == enum.ImageModerationStatusBlocked
+	query := "UPDATE dating.profile_image_statuses SET is_blocked = $1, moderation_status = $2," +
+		" moderation_reason = $3, moderated_at = $4, updated_at = $4 WHERE image_id = $5 AND moderation_status = $6"
+	result, err := r.db.ExecContext(ctx, query, isBlocked, p.ModerationStatus, p.ModerationReason, p.ModeratedAt,
+		p.ImageId, enum.ImageModerationStatusPending)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdatePendingModeration", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdatePendingModeration", "RowsAffected")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// UpdateModerationAttempt - counts the attempt to moderate the image, the image isn't taken again
+// until nextAttemptAt
+func (r *ImageStatusRepository) UpdateModerationAttempt(
+	ctx context.Context, imageId uint64, nextAttemptAt time.Time) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_image_statuses SET moderation_attempts = moderation_attempts + 1," +
+		" moderation_next_attempt_at = $1 WHERE image_id = $2"
+	_, err := r.db.ExecContext(ctx, query, nextAttemptAt, imageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateModerationAttempt", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageStatusResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return imageStatusResponse, nil
+}
+
+// SelectPendingList - locks the images waiting for the moderation whose next attempt is due,
+// the rows locked by another worker are skipped. The image is checked by its full variant,
+// an image uploaded before the variants has the only key built from its name
+func (r *ImageStatusRepository) SelectPendingList(
+	ctx context.Context, now time.Time, limit uint64) ([]*entity.ImageModerationEntity, error) {
+	query := "SELECT pi.id, pi.telegram_user_id, pi.name," +
+		" COALESCE((SELECT piv.key FROM dating.profile_image_variants piv" +
+		" WHERE piv.image_id = pi.id AND piv.variant = 'full')," +
+		" '/profiles/' || pi.telegram_user_id || '/images/' || pi.name) AS key," +
+		" pi.size, pi.content_hash, pis.moderation_attempts" +
+		" FROM dating.profile_image_statuses pis" +
+		" JOIN dating.profile_images pi ON pi.id = pis.image_id" +
+		" WHERE pis.moderation_status = $1" +
+		" AND (pis.moderation_next_attempt_at IS NULL OR pis.moderation_next_attempt_at <= $2)" +
+		" ORDER BY pis.moderation_next_attempt_at NULLS FIRST, pis.id" +
+		" LIMIT $3" +
+		" FOR UPDATE OF pis SKIP LOCKED"
+	rows, err := r.db.QueryContext(ctx, query, enum.ImageModerationStatusPending, now, limit)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectPendingList", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*entity.ImageModerationEntity, 0)
+	for rows.Next() {
+		p := &entity.ImageModerationEntity{}
+		err := rows.Scan(&p.ImageId, &p.TelegramUserId, &p.Name, &p.Key, &p.Size, &p.ContentHash, &p.Attempts)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectPendingList", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
 func (r *ImageStatusRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
 	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
 		errorFilePathImageStatus)
@@ -0,0 +1,132 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathImageVariant = "internal/repository/psql/image-variant-repository.go"
+)
+
+type ImageVariantRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewImageVariantRepository(l logger.Logger, db DBTX) *ImageVariantRepository {
+	return &ImageVariantRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+func (r *ImageVariantRepository) Add(
+	ctx context.Context, p *request.ImageVariantAddRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.profile_image_variants (image_id, variant, key, url, width, height, size, created_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	_, err := r.db.ExecContext(ctx, query, &p.ImageId, &p.Variant, &p.Key, &p.Url, &p.Width, &p.Height, &p.Size,
+		&p.CreatedAt)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	variantResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return variantResponse, nil
+}
+
+// UpdateUrlByKey - the public url of the variant, it is empty while the image is private
+func (r *ImageVariantRepository) UpdateUrlByKey(ctx context.Context, key, url string) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_image_variants SET url = $1 WHERE key = $2"
+	_, err := r.db.ExecContext(ctx, query, url, key)
+	if err != nil {
+		errorMessage := r.getErrorMessage("UpdateUrlByKey", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	variantResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return variantResponse, nil
+}
+
+// SelectListByImageIds - returns the variants of the images from the smallest to the largest one
+func (r *ImageVariantRepository) SelectListByImageIds(
+	ctx context.Context, imageIds []uint64) ([]*response.ImageVariantResponseDto, error) {
+	list := make([]*response.ImageVariantResponseDto, 0)
+	if len(imageIds) == 0 {
+		return list, nil
+	}
+	ids := make([]int64, 0, len(imageIds))
+	for _, id := range imageIds {
+		ids = append(ids, int64(id))
+	}
+	query := "SELECT image_id, variant, key, url, width, height" +
+		" FROM dating.profile_image_variants" +
+		" WHERE image_id = ANY($1)" +
+		" ORDER BY image_id, width, id"
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListByImageIds", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		p := &response.ImageVariantResponseDto{}
+		err := rows.Scan(&p.ImageId, &p.Variant, &p.Key, &p.Url, &p.Width, &p.Height)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByImageIds", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+// SelectObjectList - returns the storage keys of all the variants, they are compared with the storage
+// by the reconciliation
+func (r *ImageVariantRepository) SelectObjectList(ctx context.Context) ([]*entity.ImageObjectEntity, error) {
+	query := "SELECT image_id, key, created_at" +
+		" FROM dating.profile_image_variants" +
+		" ORDER BY id"
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectObjectList", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*entity.ImageObjectEntity, 0)
+	for rows.Next() {
+		p := &entity.ImageObjectEntity{}
+		err := rows.Scan(&p.ImageId, &p.Key, &p.CreatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectObjectList", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	if err := rows.Err(); err != nil {
+		errorMessage := r.getErrorMessage("SelectObjectList", "rows.Err")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *ImageVariantRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathImageVariant)
+}
@@ -18,10 +18,10 @@ const (
 
 type LikeRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewLikeRepository(l logger.Logger, db *sql.DB) *LikeRepository {
+func NewLikeRepository(l logger.Logger, db DBTX) *LikeRepository {
 	return &LikeRepository{
 		logger: l,
 		db:     db,
@@ -116,6 +116,73 @@ func (r *LikeRepository) FindLastLike(ctx context.Context, telegramUserId string
 	return p, nil
 }
 
+func (r *LikeRepository) FindLike(
+	ctx context.Context, telegramUserId, likedTelegramUserId string) (*entity.LikeEntity, error) {
+	p := &entity.LikeEntity{}
+	query := "SELECT id, telegram_user_id, liked_telegram_user_id, is_liked, created_at, updated_at " +
+		" FROM dating.profile_likes" +
+		" WHERE telegram_user_id = $1 AND liked_telegram_user_id = $2" +
+		" ORDER BY updated_at DESC LIMIT 1"
+	row := r.db.QueryRowContext(ctx, query, telegramUserId, likedTelegramUserId)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.LikedTelegramUserId, &p.IsLiked, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindLike", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+// SelectListByLikedTelegramUserId - returns the users who have liked the user, the latest likes first.
+// Likes of blocked and frozen profiles and of the profiles blocked by the user are skipped
+func (r *LikeRepository) SelectListByLikedTelegramUserId(
+	ctx context.Context, likedTelegramUserId string, limit uint64) (*response.LikeListResponseDto, error) {
+	query := "SELECT pl.telegram_user_id, p.display_name, COALESCE(pt.username, '')," +
+		" COALESCE((SELECT COALESCE(piv.url, pi.url) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" LEFT JOIN dating.profile_image_variants piv ON piv.image_id = pi.id AND piv.variant = 'thumbnail'" +
+		" WHERE pi.telegram_user_id = pl.telegram_user_id AND" +
+		" pis.is_blocked = false AND pis.is_private = false AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id LIMIT 1), '') AS url," +
+		" pl.updated_at" +
+		" FROM dating.profile_likes pl" +
+		" JOIN dating.profiles p ON p.telegram_user_id = pl.telegram_user_id" +
+		" JOIN dating.profile_statuses ps ON ps.telegram_user_id = pl.telegram_user_id" +
+		" LEFT JOIN dating.profile_telegrams pt ON pt.user_id = pl.telegram_user_id" +
+		" WHERE pl.liked_telegram_user_id = $1 AND pl.is_liked = true" +
+		" AND ps.is_blocked = false AND ps.is_frozen = false" +
+		" AND NOT EXISTS (SELECT 1 FROM dating.profile_blocks pb" +
+		" WHERE pb.telegram_user_id = $1 AND pb.blocked_telegram_user_id = pl.telegram_user_id" +
+		" AND pb.is_blocked = true)" +
+		" ORDER BY pl.updated_at DESC" +
+		" LIMIT $2"
+	rows, err := r.db.QueryContext(ctx, query, likedTelegramUserId, limit)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListByLikedTelegramUserId", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	content := make([]*response.LikeListItemResponseDto, 0)
+	for rows.Next() {
+		p := &response.LikeListItemResponseDto{}
+		err := rows.Scan(&p.TelegramUserId, &p.DisplayName, &p.Username, &p.Url, &p.UpdatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByLikedTelegramUserId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			continue
+		}
+		content = append(content, p)
+	}
+	likeList := &response.LikeListResponseDto{
+		Content: content,
+	}
+	return likeList, nil
+}
+
 func (r *LikeRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
 	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
 		errorFilePathILike)
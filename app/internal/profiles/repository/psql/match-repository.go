@@ -0,0 +1,156 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathMatch = "internal/repository/psql/match-repository.go"
+)
+
+type MatchRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewMatchRepository(l logger.Logger, db DBTX) *MatchRepository {
+	return &MatchRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+// LockPair - takes a transaction level advisory lock on the pair of users, so that two
+// concurrent likes between the same users are serialized. Must be called inside a transaction
+func (r *MatchRepository) LockPair(ctx context.Context, telegramUserId, matchedTelegramUserId string) error {
+	if matchedTelegramUserId < telegramUserId {
+		telegramUserId, matchedTelegramUserId = matchedTelegramUserId, telegramUserId
+	}
+	query := "SELECT pg_advisory_xact_lock(hashtext($1))"
+	_, err := r.db.ExecContext(ctx, query, telegramUserId+":"+matchedTelegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("LockPair", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Add - creates a match and returns its id. Returns 0 if the pair has already been matched
+func (r *MatchRepository) Add(ctx context.Context, p *request.MatchAddRequestRepositoryDto) (uint64, error) {
+	query := "INSERT INTO dating.profile_matches (telegram_user_id, matched_telegram_user_id, created_at)" +
+		" VALUES ($1, $2, $3)" +
+		" ON CONFLICT (telegram_user_id, matched_telegram_user_id) DO NOTHING" +
+		" RETURNING id"
+	row := r.db.QueryRowContext(ctx, query, &p.TelegramUserId, &p.MatchedTelegramUserId, &p.CreatedAt)
+	id := uint64(0)
+	err := row.Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		errorMessage := r.getErrorMessage("Add", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *MatchRepository) Delete(
+	ctx context.Context, telegramUserId, matchedTelegramUserId string) (*response.ResponseDto, error) {
+	query := "DELETE FROM dating.profile_matches" +
+		" WHERE (telegram_user_id = $1 AND matched_telegram_user_id = $2)" +
+		" OR (telegram_user_id = $2 AND matched_telegram_user_id = $1)"
+	_, err := r.db.ExecContext(ctx, query, telegramUserId, matchedTelegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Delete", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	matchResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return matchResponse, nil
+}
+
+func (r *MatchRepository) FindMatch(
+	ctx context.Context, telegramUserId, matchedTelegramUserId string) (*entity.MatchEntity, error) {
+	p := &entity.MatchEntity{}
+	query := "SELECT id, telegram_user_id, matched_telegram_user_id, created_at" +
+		" FROM dating.profile_matches" +
+		" WHERE (telegram_user_id = $1 AND matched_telegram_user_id = $2)" +
+		" OR (telegram_user_id = $2 AND matched_telegram_user_id = $1)"
+	row := r.db.QueryRowContext(ctx, query, telegramUserId, matchedTelegramUserId)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.MatchedTelegramUserId, &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindMatch", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *MatchRepository) SelectListByTelegramUserId(
+	ctx context.Context, telegramUserId string) (*response.MatchListResponseDto, error) {
+	query := "WITH user_matches AS (" +
+		"SELECT CASE WHEN pm.telegram_user_id = $1 THEN pm.matched_telegram_user_id" +
+		" ELSE pm.telegram_user_id END AS matched_telegram_user_id, pm.created_at" +
+		" FROM dating.profile_matches pm" +
+		" WHERE pm.telegram_user_id = $1 OR pm.matched_telegram_user_id = $1" +
+		" )" +
+		" SELECT um.matched_telegram_user_id, p.display_name, COALESCE(pt.username, '')," +
+		" COALESCE((SELECT COALESCE(piv.url, pi.url) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" LEFT JOIN dating.profile_image_variants piv ON piv.image_id = pi.id AND piv.variant = 'thumbnail'" +
+		" WHERE pi.telegram_user_id = um.matched_telegram_user_id AND" +
+		" pis.is_blocked = false AND pis.is_private = false AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id LIMIT 1), '') AS url," +
+		" um.created_at" +
+		" FROM user_matches um" +
+		" JOIN dating.profiles p ON p.telegram_user_id = um.matched_telegram_user_id" +
+		" JOIN dating.profile_statuses ps ON ps.telegram_user_id = um.matched_telegram_user_id" +
+		" LEFT JOIN dating.profile_telegrams pt ON pt.user_id = um.matched_telegram_user_id" +
+		" WHERE ps.is_blocked = false AND ps.is_frozen = false" +
+		" AND NOT EXISTS (SELECT 1 FROM dating.profile_blocks pb" +
+		" WHERE pb.telegram_user_id = $1 AND pb.blocked_telegram_user_id = um.matched_telegram_user_id" +
+		" AND pb.is_blocked = true)" +
+		" ORDER BY um.created_at DESC"
+	rows, err := r.db.QueryContext(ctx, query, telegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	content := make([]*response.MatchListItemResponseDto, 0)
+	for rows.Next() {
+		p := &response.MatchListItemResponseDto{}
+		err := rows.Scan(&p.MatchedTelegramUserId, &p.DisplayName, &p.Username, &p.Url, &p.CreatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			continue
+		}
+		content = append(content, p)
+	}
+	matchList := &response.MatchListResponseDto{
+		Content: content,
+	}
+	return matchList, nil
+}
+
+func (r *MatchRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathMatch)
+}
@@ -0,0 +1,111 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathMessage = "internal/repository/psql/message-repository.go"
+)
+
+type MessageRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewMessageRepository(l logger.Logger, db DBTX) *MessageRepository {
+	return &MessageRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+func (r *MessageRepository) Add(
+	ctx context.Context, p *request.MessageAddRequestRepositoryDto) (*entity.MessageEntity, error) {
+	query := "INSERT INTO dating.messages (conversation_id, sender_telegram_user_id, recipient_telegram_user_id," +
+		" text, is_read, created_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6)" +
+		" RETURNING id"
+	row := r.db.QueryRowContext(ctx, query, &p.ConversationId, &p.SenderTelegramUserId, &p.RecipientTelegramUserId,
+		&p.Text, &p.IsRead, &p.CreatedAt)
+	id := uint64(0)
+	err := row.Scan(&id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	messageEntity := &entity.MessageEntity{
+		Id:                      id,
+		ConversationId:          p.ConversationId,
+		SenderTelegramUserId:    p.SenderTelegramUserId,
+		RecipientTelegramUserId: p.RecipientTelegramUserId,
+		Text:                    p.Text,
+		IsRead:                  p.IsRead,
+		CreatedAt:               p.CreatedAt,
+	}
+	return messageEntity, nil
+}
+
+// SelectListByConversationId - returns messages from the newest to the oldest. When BeforeId is set
+// only messages older than it are returned (keyset pagination)
+func (r *MessageRepository) SelectListByConversationId(
+	ctx context.Context, p *request.MessageGetListRequestRepositoryDto) ([]*entity.MessageEntity, error) {
+	query := "SELECT id, conversation_id, sender_telegram_user_id, recipient_telegram_user_id, text, is_read," +
+		" read_at, created_at" +
+		" FROM dating.messages" +
+		" WHERE conversation_id = $1 AND ($2 = 0 OR id < $2)" +
+		" ORDER BY id DESC" +
+		" LIMIT $3"
+	rows, err := r.db.QueryContext(ctx, query, p.ConversationId, p.BeforeId, p.Limit)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectListByConversationId", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	messageList := make([]*entity.MessageEntity, 0)
+	for rows.Next() {
+		m := &entity.MessageEntity{}
+		err := rows.Scan(&m.Id, &m.ConversationId, &m.SenderTelegramUserId, &m.RecipientTelegramUserId, &m.Text,
+			&m.IsRead, &m.ReadAt, &m.CreatedAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByConversationId", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		messageList = append(messageList, m)
+	}
+	return messageList, nil
+}
+
+// MarkRead - marks as read the messages received by the user up to LastReadMessageId,
+// or all of them when LastReadMessageId is not set
+func (r *MessageRepository) MarkRead(
+	ctx context.Context, p *request.MessageMarkReadRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "UPDATE dating.messages SET is_read = true, read_at = $1" +
+		" WHERE conversation_id = $2 AND recipient_telegram_user_id = $3 AND is_read = false" +
+		" AND ($4 = 0 OR id <= $4)"
+	_, err := r.db.ExecContext(ctx, query, &p.ReadAt, &p.ConversationId, &p.RecipientTelegramUserId,
+		&p.LastReadMessageId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("MarkRead", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	messageResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return messageResponse, nil
+}
+
+func (r *MessageRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathMessage)
+}
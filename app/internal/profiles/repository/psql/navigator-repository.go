@@ -19,10 +19,10 @@ const (
 
 type NavigatorRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewNavigatorRepository(l logger.Logger, db *sql.DB) *NavigatorRepository {
+func NewNavigatorRepository(l logger.Logger, db DBTX) *NavigatorRepository {
 	return &NavigatorRepository{
 		logger: l,
 		db:     db,
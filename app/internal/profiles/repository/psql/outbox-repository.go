@@ -0,0 +1,114 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathOutbox = "internal/repository/psql/outbox-repository.go"
+)
+
+type OutboxRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewOutboxRepository(l logger.Logger, db DBTX) *OutboxRepository {
+	return &OutboxRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+func (r *OutboxRepository) Add(
+	ctx context.Context, p *request.OutboxAddRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.outbox (topic, message_key, payload, is_sent, next_attempt_at, created_at)" +
+		" VALUES ($1, $2, $3, $4, $5, $6)"
+	_, err := r.db.ExecContext(ctx, query, &p.Topic, &p.MessageKey, &p.Payload, &p.IsSent, &p.NextAttemptAt,
+		&p.CreatedAt)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	outboxResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return outboxResponse, nil
+}
+
+// SelectPendingList - returns the unsent rows that are due. The rows are locked until the end of the
+// transaction and the rows locked by another relay are skipped, so a row is published by one relay at a time
+func (r *OutboxRepository) SelectPendingList(
+	ctx context.Context, now time.Time, limit uint64) ([]*entity.OutboxEntity, error) {
+	query := "SELECT id, topic, message_key, payload, is_sent, attempts, last_error, next_attempt_at, created_at," +
+		" sent_at" +
+		" FROM dating.outbox" +
+		" WHERE is_sent = false AND next_attempt_at <= $1" +
+		" ORDER BY id" +
+		" LIMIT $2" +
+		" FOR UPDATE SKIP LOCKED"
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		errorMessage := r.getErrorMessage("SelectPendingList", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	outboxList := make([]*entity.OutboxEntity, 0)
+	for rows.Next() {
+		p := &entity.OutboxEntity{}
+		err := rows.Scan(&p.Id, &p.Topic, &p.MessageKey, &p.Payload, &p.IsSent, &p.Attempts, &p.LastError,
+			&p.NextAttemptAt, &p.CreatedAt, &p.SentAt)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectPendingList", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		outboxList = append(outboxList, p)
+	}
+	return outboxList, nil
+}
+
+func (r *OutboxRepository) MarkSent(ctx context.Context, id uint64, sentAt time.Time) (*response.ResponseDto, error) {
+	query := "UPDATE dating.outbox SET is_sent = true, attempts = attempts + 1, last_error = NULL, sent_at = $1" +
+		" WHERE id = $2"
+	_, err := r.db.ExecContext(ctx, query, sentAt, id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("MarkSent", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	outboxResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return outboxResponse, nil
+}
+
+func (r *OutboxRepository) MarkFailed(
+	ctx context.Context, p *request.OutboxMarkFailedRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "UPDATE dating.outbox SET attempts = attempts + 1, last_error = $1, next_attempt_at = $2" +
+		" WHERE id = $3"
+	_, err := r.db.ExecContext(ctx, query, &p.LastError, &p.NextAttemptAt, &p.Id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("MarkFailed", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	outboxResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return outboxResponse, nil
+}
+
+func (r *OutboxRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathOutbox)
+}
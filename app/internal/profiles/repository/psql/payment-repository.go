@@ -18,10 +18,10 @@ const (
 
 type PaymentRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewPaymentRepository(l logger.Logger, db *sql.DB) *PaymentRepository {
+func NewPaymentRepository(l logger.Logger, db DBTX) *PaymentRepository {
 	return &PaymentRepository{
 		logger: l,
 		db:     db,
@@ -0,0 +1,91 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathProfileListSnapshot = "internal/repository/psql/profile-list-snapshot-repository.go"
+)
+
+type ProfileListSnapshotRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewProfileListSnapshotRepository(l logger.Logger, db DBTX) *ProfileListSnapshotRepository {
+	return &ProfileListSnapshotRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+// Add - stores the ranked feed of the viewer and returns its id
+func (r *ProfileListSnapshotRepository) Add(
+	ctx context.Context, p *request.ProfileListSnapshotAddRequestRepositoryDto) (uint64, error) {
+	query := "INSERT INTO dating.profile_list_snapshots (telegram_user_id, candidate_telegram_user_ids, created_at)" +
+		" VALUES ($1, $2, $3)" +
+		" RETURNING id"
+	var id uint64
+	err := r.db.QueryRowContext(ctx, query, p.TelegramUserId, pq.Array(p.CandidateTelegramUserIds),
+		p.CreatedAt).Scan(&id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "QueryRowContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return id, nil
+}
+
+// DeleteExpired - deletes the feeds created before createdBefore, the cursors of their pages are invalid
+func (r *ProfileListSnapshotRepository) DeleteExpired(
+	ctx context.Context, createdBefore time.Time) (*response.ResponseDto, error) {
+	query := "DELETE FROM dating.profile_list_snapshots WHERE created_at < $1"
+	_, err := r.db.ExecContext(ctx, query, createdBefore)
+	if err != nil {
+		errorMessage := r.getErrorMessage("DeleteExpired", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+// FindPage - returns limit candidates of the feed after offset, nil when the viewer has no such feed
+// created since createdAfter
+func (r *ProfileListSnapshotRepository) FindPage(ctx context.Context, id uint64, telegramUserId string,
+	createdAfter time.Time, offset, limit uint64) (*entity.ProfileListSnapshotEntity, error) {
+	p := &entity.ProfileListSnapshotEntity{}
+	// The arrays of postgres are indexed from 1 and their slices include the upper bound
+	query := "SELECT id, telegram_user_id, COALESCE(array_length(candidate_telegram_user_ids, 1), 0)," +
+		" candidate_telegram_user_ids[$3:$4], created_at" +
+		" FROM dating.profile_list_snapshots" +
+		" WHERE id = $1 AND telegram_user_id = $2 AND created_at >= $5"
+	row := r.db.QueryRowContext(ctx, query, id, telegramUserId, offset+1, offset+limit, createdAfter)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.TotalEntities, pq.Array(&p.CandidateTelegramUserIds), &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindPage", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *ProfileListSnapshotRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathProfileListSnapshot)
+}
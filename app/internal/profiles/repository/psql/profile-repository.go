@@ -9,6 +9,7 @@ import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 	"time"
 )
@@ -17,6 +18,49 @@ const (
 	errorFilePath          = "internal/repository/psql/profile-repository.go"
 	ErrNotRowsFoundMessage = "profiles not found"
 	ErrNotRowFoundMessage  = "profile not found"
+	// profileCandidatesQuery - the profiles of the discovery feed: the filter of the viewer accepts the candidate
+	// and the filter of the candidate accepts the viewer. $1 - the viewer, $2-$5 - the filter of the viewer,
+	// $6 - only the liked ones, $7 - only the online ones
+	profileCandidatesQuery = "WITH viewer AS (" +
+		" SELECT age, gender FROM dating.profiles WHERE telegram_user_id = $1" +
+		" )," +
+		" filtered_profiles AS (" +
+		" SELECT p.telegram_user_id, p.last_online," +
+		" COALESCE(" +
+		" ST_Distance(" +
+		" (SELECT location FROM dating.profile_navigators WHERE telegram_user_id = p.telegram_user_id)::geography," +
+		" ST_SetSRID(ST_Force2D(ST_MakePoint(" +
+		" (SELECT ST_X(location) FROM dating.profile_navigators WHERE telegram_user_id = $1)," +
+		" (SELECT ST_Y(location) FROM dating.profile_navigators WHERE telegram_user_id = $1)" +
+		" )), 4326)::geography), NULL::numeric) AS distance," +
+		" COALESCE(pl.is_liked, false) AS is_liked," +
+		" pf.distance AS filter_distance," +
+		" COALESCE(LEAST(v.age - pf.age_from, pf.age_to - v.age) / NULLIF((pf.age_to - pf.age_from) / 2.0, 0), 1)" +
+		" AS age_margin" +
+		" FROM dating.profiles p" +
+		" CROSS JOIN viewer v" +
+		" JOIN dating.profile_statuses ps ON ps.telegram_user_id = p.telegram_user_id" +
+		" JOIN dating.profile_settings pst ON pst.telegram_user_id = p.telegram_user_id" +
+		" JOIN dating.profile_filters pf ON pf.telegram_user_id = p.telegram_user_id" +
+		" LEFT JOIN dating.profile_likes pl ON pl.telegram_user_id = $1" +
+		" AND pl.liked_telegram_user_id  = p.telegram_user_id" +
+		" LEFT JOIN dating.profile_blocks pb ON pb.telegram_user_id = $1" +
+		" AND pb.blocked_telegram_user_id = p.telegram_user_id" +
+		" WHERE ps.is_frozen = false AND ps.is_blocked = false AND" +
+		" (p.age BETWEEN $3 AND $4) AND" +
+		" ($2 = 'all' OR p.gender = $2) AND p.telegram_user_id <> $1 AND" +
+		" (v.age BETWEEN pf.age_from AND pf.age_to) AND" +
+		" (pf.search_gender = 'all' OR pf.search_gender = v.gender) AND" +
+		" (pb.id IS NULL OR pb.is_blocked = false)" +
+		" AND p.last_online >= NOW() AT TIME ZONE 'UTC' - INTERVAL '1 month'" +
+		" AND ($7 = false OR p.last_online >= NOW() AT TIME ZONE 'UTC' - INTERVAL '5 minutes')" +
+		" )," +
+		" candidates AS (" +
+		" SELECT telegram_user_id, last_online, distance, is_liked, filter_distance, age_margin" +
+		" FROM filtered_profiles" +
+		" WHERE (distance IS NULL OR (distance < $5 * 1000 AND distance < filter_distance * 1000))" +
+		" AND ($6 = false OR is_liked = true)" +
+		" )"
 )
 
 var (
@@ -26,10 +70,10 @@ var (
 
 type ProfileRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewProfileRepository(l logger.Logger, db *sql.DB) *ProfileRepository {
+func NewProfileRepository(l logger.Logger, db DBTX) *ProfileRepository {
 	return &ProfileRepository{
 		logger: l,
 		db:     db,
@@ -323,48 +367,42 @@ func (r *ProfileRepository) GetShortInfo(
 	return p, nil
 }
 
+// SelectList - selects up to pr.Limit of the nearest candidates of the discovery feed with the signals
+// they are ranked by, the candidates are ranked by the service and the ranked list is stored as the snapshot
+// of the feed. The signals are selected only for the nearest candidates
 func (r *ProfileRepository) SelectList(ctx context.Context,
 	pr *request.ProfileGetListRequestRepositoryDto) (*response.ProfileListResponseRepositoryDto, error) {
 	telegramUserId := pr.TelegramUserId
-	offset := (pr.Page - 1) * pr.Size
-	query := "WITH filtered_profiles AS (" +
-		" SELECT p.id, p.telegram_user_id, p.age, p.gender, ps.is_blocked, ps.is_frozen," +
-		" p.created_at, p.updated_at, p.last_online," +
-		" COALESCE(" +
-		" ST_Distance(" +
-		" (SELECT location FROM dating.profile_navigators WHERE telegram_user_id = p.telegram_user_id)::geography," +
-		" ST_SetSRID(ST_Force2D(ST_MakePoint(" +
-		" (SELECT ST_X(location) FROM dating.profile_navigators WHERE telegram_user_id = $1)," +
-		" (SELECT ST_Y(location) FROM dating.profile_navigators WHERE telegram_user_id = $1)" +
-		" )), 4326)::geography), NULL::numeric) AS distance," +
-		" (SELECT url FROM dating.profile_images pi" +
-		" JOIN dating.profile_image_statuses pis ON pi.id = pis.id" +
-		" WHERE pi.telegram_user_id = p.telegram_user_id AND pis.is_blocked = false AND pis.is_private = false" +
-		" ORDER BY pi.created_at DESC LIMIT 1) AS url," +
-		" COALESCE(pl.is_liked, false) AS is_liked" +
-		" FROM dating.profiles p" +
-		" JOIN dating.profile_statuses ps ON ps.telegram_user_id = p.telegram_user_id" +
-		" JOIN dating.profile_settings pst ON pst.telegram_user_id = p.telegram_user_id" +
-		" LEFT JOIN dating.profile_navigators pn ON pn.telegram_user_id = p.telegram_user_id" +
-		" LEFT JOIN dating.profile_likes pl ON pl.telegram_user_id = $1" +
-		" AND pl.liked_telegram_user_id  = p.telegram_user_id" +
-		" LEFT JOIN dating.profile_blocks pb ON pb.telegram_user_id = $1" +
-		" AND pb.blocked_telegram_user_id = p.telegram_user_id" +
-		" WHERE ps.is_frozen = false AND ps.is_blocked = false AND" +
-		" (p.age BETWEEN $3 AND $4) AND" +
-		" ($2 = 'all' OR p.gender = $2) AND p.telegram_user_id <> $1 AND" +
-		" (pb.id IS NULL OR pb.is_blocked = false)" +
-		" AND p.last_online >= NOW() AT TIME ZONE 'UTC' - INTERVAL '1 month'" +
-		" AND ($9 = false OR p.last_online >= NOW() AT TIME ZONE 'UTC' - INTERVAL '5 minutes')" +
+	query := profileCandidatesQuery + "," +
+		" nearest_candidates AS (" +
+		" SELECT telegram_user_id, last_online, distance, is_liked, filter_distance, age_margin" +
+		" FROM candidates" +
+		" ORDER BY CASE WHEN distance IS NULL THEN 1 ELSE 0 END, distance ASC, last_online DESC, telegram_user_id" +
+		" LIMIT $8" +
 		" )" +
-		" SELECT telegram_user_id, last_online, distance, url, is_liked" +
-		" FROM filtered_profiles" +
-		" WHERE distance IS NULL OR (distance < $5 * 1000 AND distance IS NOT NULL)" +
-		" AND ($8 = false OR is_liked = true)" +
-		" ORDER BY CASE WHEN distance IS NULL THEN 1 ELSE 0 END, distance ASC, last_online DESC" +
-		" LIMIT $6 OFFSET $7"
+		" SELECT c.telegram_user_id, c.last_online, c.distance," +
+		" COALESCE((SELECT COALESCE(piv.url, pi.url) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" LEFT JOIN dating.profile_image_variants piv ON piv.image_id = pi.id AND piv.variant = 'thumbnail'" +
+		" WHERE pi.telegram_user_id = c.telegram_user_id AND pis.is_blocked = false AND pis.is_private = false" +
+		" AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id LIMIT 1), '') AS url," +
+		" c.is_liked," +
+		" (SELECT COUNT(*) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" WHERE pi.telegram_user_id = c.telegram_user_id AND pis.is_blocked = false AND pis.is_private = false" +
+		" AND pis.moderation_status = 'approved') AS image_count," +
+		" COALESCE(p.description, '') <> '' AS has_description," +
+		" c.filter_distance, c.age_margin," +
+		" EXISTS (SELECT 1 FROM dating.profile_likes plv WHERE plv.telegram_user_id = c.telegram_user_id" +
+		" AND plv.liked_telegram_user_id = $1 AND plv.is_liked = true) AS has_liked_viewer," +
+		" (SELECT COUNT(*) FROM dating.profile_likes plc WHERE plc.telegram_user_id = c.telegram_user_id" +
+		" AND plc.is_liked = true" +
+		" AND plc.created_at >= NOW() AT TIME ZONE 'UTC' - INTERVAL '1 month') AS like_count" +
+		" FROM nearest_candidates c" +
+		" JOIN dating.profiles p ON p.telegram_user_id = c.telegram_user_id"
 	rows, err := r.db.QueryContext(ctx, query, telegramUserId, pr.SearchGender, pr.AgeFrom, pr.AgeTo, pr.Distance,
-		pr.Size, offset, pr.IsLiked, pr.IsOnline)
+		pr.IsLiked, pr.IsOnline, pr.Limit)
 	if err != nil {
 		errorMessage := r.getErrorMessage("SelectListByTelegramUserId",
 			"QueryContext")
@@ -372,10 +410,11 @@ func (r *ProfileRepository) SelectList(ctx context.Context,
 		return nil, err
 	}
 	defer rows.Close()
-	content := make([]*response.ProfileListItemResponseDto, 0)
+	content := make([]*entity.ProfileCandidateEntity, 0)
 	for rows.Next() {
-		p := response.ProfileListItemResponseDto{}
-		err := rows.Scan(&p.TelegramUserId, &p.LastOnline, &p.Distance, &p.Url, &p.IsLiked)
+		p := entity.ProfileCandidateEntity{}
+		err := rows.Scan(&p.TelegramUserId, &p.LastOnline, &p.Distance, &p.Url, &p.IsLiked, &p.ImageCount,
+			&p.HasDescription, &p.FilterDistance, &p.AgeMargin, &p.HasLikedViewer, &p.LikeCount)
 		if err != nil {
 			errorMessage := r.getErrorMessage("SelectListByTelegramUserId", "Scan")
 			r.logger.Info(errorMessage, zap.Error(ErrNotRowsFound))
@@ -384,34 +423,62 @@ func (r *ProfileRepository) SelectList(ctx context.Context,
 
 		content = append(content, &p)
 	}
-	totalEntities, err := r.getTotalEntities(ctx, telegramUserId, pr.SearchGender, pr.AgeFrom, pr.AgeTo)
-	if err != nil {
-		return nil, err
-	}
-	paginationEntity := entity.GetPagination(pr.Page, pr.Page, totalEntities)
-	paginationProfileEntityList := &response.ProfileListResponseRepositoryDto{
-		PaginationEntity: paginationEntity,
-		Content:          content,
+	profileList := &response.ProfileListResponseRepositoryDto{
+		Content: content,
 	}
-	return paginationProfileEntityList, nil
+	return profileList, nil
 }
 
-func (r *ProfileRepository) getTotalEntities(
-	ctx context.Context, telegramUserId, searchGender string, ageFrom, ageTo uint64) (uint64, error) {
-	query := "SELECT COUNT(*)" +
+// SelectListByTelegramUserIds - selects the profiles of a page of the discovery feed in the given order.
+// The distance, the photo and the like are current, the profiles frozen or blocked since the feed was ranked
+// are skipped
+func (r *ProfileRepository) SelectListByTelegramUserIds(ctx context.Context,
+	viewerTelegramUserId string, telegramUserIds []string) ([]*response.ProfileListItemResponseDto, error) {
+	query := "SELECT p.telegram_user_id," +
+		" ST_Distance(pn.location::geography, vn.location::geography) AS distance," +
+		" COALESCE((SELECT COALESCE(piv.url, pi.url) FROM dating.profile_images pi" +
+		" JOIN dating.profile_image_statuses pis ON pi.id = pis.image_id" +
+		" LEFT JOIN dating.profile_image_variants piv ON piv.image_id = pi.id AND piv.variant = 'thumbnail'" +
+		" WHERE pi.telegram_user_id = p.telegram_user_id AND pis.is_blocked = false AND pis.is_private = false" +
+		" AND pis.moderation_status = 'approved'" +
+		" ORDER BY pis.is_primary DESC, pi.position, pi.id LIMIT 1), '') AS url," +
+		" COALESCE(pl.is_liked, false) AS is_liked," +
+		" p.last_online" +
 		" FROM dating.profiles p" +
-		" JOIN dating.profile_statuses ps ON p.telegram_user_id = ps.telegram_user_id" +
-		" WHERE ps.is_frozen = false AND ps.is_blocked = false AND" +
-		" (p.age BETWEEN $3 AND $4) AND" +
-		" ($2 = 'all' OR p.gender = $2) AND p.telegram_user_id <> $1"
-	var totalEntities uint64
-	err := r.db.QueryRowContext(ctx, query, telegramUserId, searchGender, ageFrom, ageTo).Scan(&totalEntities)
+		" JOIN dating.profile_statuses ps ON ps.telegram_user_id = p.telegram_user_id" +
+		" LEFT JOIN dating.profile_navigators pn ON pn.telegram_user_id = p.telegram_user_id" +
+		" LEFT JOIN dating.profile_navigators vn ON vn.telegram_user_id = $1" +
+		" LEFT JOIN dating.profile_likes pl ON pl.telegram_user_id = $1" +
+		" AND pl.liked_telegram_user_id = p.telegram_user_id" +
+		" LEFT JOIN dating.profile_blocks pb ON pb.telegram_user_id = $1" +
+		" AND pb.blocked_telegram_user_id = p.telegram_user_id" +
+		" WHERE p.telegram_user_id = ANY($2) AND ps.is_frozen = false AND ps.is_blocked = false" +
+		" AND (pb.id IS NULL OR pb.is_blocked = false)" +
+		" ORDER BY array_position($2, p.telegram_user_id::text)"
+	rows, err := r.db.QueryContext(ctx, query, viewerTelegramUserId, pq.Array(telegramUserIds))
 	if err != nil {
-		errorMessage := r.getErrorMessage("getTotalEntities", "QueryRowContext")
+		errorMessage := r.getErrorMessage("SelectListByTelegramUserIds", "QueryContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+	content := make([]*response.ProfileListItemResponseDto, 0, len(telegramUserIds))
+	for rows.Next() {
+		p := response.ProfileListItemResponseDto{}
+		err := rows.Scan(&p.TelegramUserId, &p.Distance, &p.Url, &p.IsLiked, &p.LastOnline)
+		if err != nil {
+			errorMessage := r.getErrorMessage("SelectListByTelegramUserIds", "Scan")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+		content = append(content, &p)
+	}
+	if err := rows.Err(); err != nil {
+		errorMessage := r.getErrorMessage("SelectListByTelegramUserIds", "rows.Err")
 		r.logger.Debug(errorMessage, zap.Error(err))
-		return 0, err
+		return nil, err
 	}
-	return totalEntities, nil
+	return content, nil
 }
 
 func (r *ProfileRepository) UpdateLastOnline(
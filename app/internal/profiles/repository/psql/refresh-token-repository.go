@@ -0,0 +1,105 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathRefreshToken = "internal/repository/psql/refresh-token-repository.go"
+)
+
+type RefreshTokenRepository struct {
+	logger logger.Logger
+	db     DBTX
+}
+
+func NewRefreshTokenRepository(l logger.Logger, db DBTX) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		logger: l,
+		db:     db,
+	}
+}
+
+func (r *RefreshTokenRepository) Add(
+	ctx context.Context, p *request.RefreshTokenAddRequestRepositoryDto) (*response.ResponseDto, error) {
+	query := "INSERT INTO dating.profile_refresh_tokens (telegram_user_id, token_hash, expires_at, created_at)" +
+		" VALUES ($1, $2, $3, $4)"
+	_, err := r.db.ExecContext(ctx, query, &p.TelegramUserId, &p.TokenHash, &p.ExpiresAt, &p.CreatedAt)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Add", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	refreshTokenResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return refreshTokenResponse, nil
+}
+
+// FindByTokenHash - returns the refresh token locked until the end of the transaction, so that
+// the same refresh token can't be rotated twice concurrently. Returns nil if the token doesn't exist
+func (r *RefreshTokenRepository) FindByTokenHash(
+	ctx context.Context, tokenHash string) (*entity.RefreshTokenEntity, error) {
+	p := &entity.RefreshTokenEntity{}
+	query := "SELECT id, telegram_user_id, token_hash, expires_at, revoked_at, created_at" +
+		" FROM dating.profile_refresh_tokens" +
+		" WHERE token_hash = $1" +
+		" FOR UPDATE"
+	row := r.db.QueryRowContext(ctx, query, tokenHash)
+	err := row.Scan(&p.Id, &p.TelegramUserId, &p.TokenHash, &p.ExpiresAt, &p.RevokedAt, &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		errorMessage := r.getErrorMessage("FindByTokenHash", "Scan")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *RefreshTokenRepository) Revoke(
+	ctx context.Context, id uint64, revokedAt time.Time) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_refresh_tokens SET revoked_at = $1" +
+		" WHERE id = $2 AND revoked_at IS NULL"
+	_, err := r.db.ExecContext(ctx, query, revokedAt, id)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Revoke", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	refreshTokenResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return refreshTokenResponse, nil
+}
+
+func (r *RefreshTokenRepository) RevokeListByTelegramUserId(
+	ctx context.Context, telegramUserId string, revokedAt time.Time) (*response.ResponseDto, error) {
+	query := "UPDATE dating.profile_refresh_tokens SET revoked_at = $1" +
+		" WHERE telegram_user_id = $2 AND revoked_at IS NULL"
+	_, err := r.db.ExecContext(ctx, query, revokedAt, telegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("RevokeListByTelegramUserId", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	refreshTokenResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return refreshTokenResponse, nil
+}
+
+func (r *RefreshTokenRepository) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathRefreshToken)
+}
@@ -2,7 +2,6 @@ package psql
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
@@ -17,10 +16,10 @@ const (
 
 type SettingsRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewSettingsRepository(l logger.Logger, db *sql.DB) *SettingsRepository {
+func NewSettingsRepository(l logger.Logger, db DBTX) *SettingsRepository {
 	return &SettingsRepository{
 		logger: l,
 		db:     db,
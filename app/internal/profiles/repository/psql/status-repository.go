@@ -19,10 +19,10 @@ const (
 
 type StatusRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewStatusRepository(l logger.Logger, db *sql.DB) *StatusRepository {
+func NewStatusRepository(l logger.Logger, db DBTX) *StatusRepository {
 	return &StatusRepository{
 		logger: l,
 		db:     db,
@@ -77,6 +77,19 @@ func (r *StatusRepository) Block(
 	return r.FindByTelegramUserId(ctx, telegramUserId)
 }
 
+func (r *StatusRepository) Unblock(
+	ctx context.Context, telegramUserId string) (*entity.StatusEntity, error) {
+	query := "UPDATE dating.profile_statuses SET is_blocked = $1, updated_at = $2 WHERE telegram_user_id = $3"
+	updatedAt := time.Now().UTC()
+	_, err := r.db.ExecContext(ctx, query, false, updatedAt, telegramUserId)
+	if err != nil {
+		errorMessage := r.getErrorMessage("Unblock", "ExecContext")
+		r.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	return r.FindByTelegramUserId(ctx, telegramUserId)
+}
+
 func (r *StatusRepository) Freeze(
 	ctx context.Context, telegramUserId string) (*entity.StatusEntity, error) {
 	query := "UPDATE dating.profile_statuses SET is_frozen = $1, updated_at = $2 WHERE telegram_user_id = $3"
@@ -2,7 +2,6 @@ package psql
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
@@ -17,10 +16,10 @@ const (
 
 type TelegramRepository struct {
 	logger logger.Logger
-	db     *sql.DB
+	db     DBTX
 }
 
-func NewTelegramRepository(l logger.Logger, db *sql.DB) *TelegramRepository {
+func NewTelegramRepository(l logger.Logger, db DBTX) *TelegramRepository {
 	return &TelegramRepository{
 		logger: l,
 		db:     db,
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service/mapper"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	errorFilePathAdminService = "internal/profiles/service/admin-service.go"
+	defaultAdminListPage      = 1
+	defaultAdminListSize      = 20
+	maxAdminListSize          = 100
+)
+
+var (
+	ErrAdminNotFound            = errors.New("admin not found")
+	ErrAdminForbidden           = errors.New("admin role does not allow this action")
+	ErrComplaintDecisionInvalid = errors.New("complaint decision must be upheld or dismissed")
+	ErrComplaintNotUnderReview  = errors.New("there are no complaints under review for the user")
+)
+
+type AdminService struct {
+	logger logger.Logger
+	uwf    *UnitOfWorkFactory
+}
+
+func NewAdminService(l logger.Logger, uwf *UnitOfWorkFactory) *AdminService {
+	return &AdminService{
+		logger: l,
+		uwf:    uwf,
+	}
+}
+
+func (s *AdminService) GetComplaintList(
+	ctx context.Context, pr *request.AdminComplaintGetListRequestDto) (*response.ComplaintListResponseDto, error) {
+	var complaintList *response.ComplaintListResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		admin, err := s.authorize(ctx, unitOfWork, pr.AdminTelegramUserId, enum.AdminActionGetComplaintList)
+		if err != nil {
+			return err
+		}
+		adminMapper := &mapper.AdminMapper{}
+		complaintListRequest := adminMapper.MapToComplaintListRequest(pr)
+		complaintListRequest.Page, complaintListRequest.Size = s.getPageAndSize(pr.Page, pr.Size)
+		complaintList, err = unitOfWork.ComplaintRepository().SelectList(ctx, complaintListRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetComplaintList", "ComplaintRepository().SelectList")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return s.addAudit(ctx, unitOfWork, adminMapper.MapToAuditAddRequest(
+			admin, enum.AdminActionGetComplaintList, pr.CriminalTelegramUserId, nil, ""))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return complaintList, nil
+}
+
+// GetProfile - returns the profile with all its images, including the private and the blocked ones
+func (s *AdminService) GetProfile(
+	ctx context.Context, adminTelegramUserId, telegramUserId string) (*response.AdminProfileResponseDto, error) {
+	var profileResponse *response.AdminProfileResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		admin, err := s.authorize(ctx, unitOfWork, adminTelegramUserId, enum.AdminActionGetProfile)
+		if err != nil {
+			return err
+		}
+		profile, err := unitOfWork.ProfileRepository().GetProfile(ctx, telegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetProfile", "ProfileRepository().GetProfile")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		images, err := unitOfWork.ImageRepository().SelectListDetailByTelegramUserId(ctx, telegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetProfile", "ImageRepository().SelectListDetailByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		complaintCount, err := unitOfWork.ComplaintRepository().GetCountByCriminalTelegramUserId(ctx, telegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetProfile", "ComplaintRepository().GetCountByCriminalTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		adminMapper := &mapper.AdminMapper{}
+		profileResponse = adminMapper.MapToProfileResponse(profile, images, complaintCount)
+		return s.addAudit(ctx, unitOfWork, adminMapper.MapToAuditAddRequest(
+			admin, enum.AdminActionGetProfile, &telegramUserId, nil, ""))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profileResponse, nil
+}
+
+func (s *AdminService) BlockProfile(
+	ctx context.Context, pr *request.AdminProfileBlockRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		admin, err := s.authorize(ctx, unitOfWork, pr.AdminTelegramUserId, enum.AdminActionBlockProfile)
+		if err != nil {
+			return err
+		}
+		if err := s.updateProfileBlocked(ctx, unitOfWork, pr.TelegramUserId, true, pr.Reason); err != nil {
+			return err
+		}
+		adminMapper := &mapper.AdminMapper{}
+		return s.addAudit(ctx, unitOfWork, adminMapper.MapToAuditAddRequest(
+			admin, enum.AdminActionBlockProfile, &pr.TelegramUserId, nil, pr.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.ResponseDto{Success: true}, nil
+}
+
+func (s *AdminService) UnblockProfile(
+	ctx context.Context, pr *request.AdminProfileBlockRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		admin, err := s.authorize(ctx, unitOfWork, pr.AdminTelegramUserId, enum.AdminActionUnblockProfile)
+		if err != nil {
+			return err
+		}
+		_, err = unitOfWork.StatusRepository().Unblock(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UnblockProfile", "StatusRepository().Unblock")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		adminMapper := &mapper.AdminMapper{}
+		return s.addAudit(ctx, unitOfWork, adminMapper.MapToAuditAddRequest(
+			admin, enum.AdminActionUnblockProfile, &pr.TelegramUserId, nil, pr.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.ResponseDto{Success: true}, nil
+}
+
+func (s *AdminService) BlockImage(
+	ctx context.Context, pr *request.AdminImageBlockRequestDto) (*response.ResponseDto, error) {
+	return s.updateImageBlocked(ctx, pr, enum.AdminActionBlockImage, true)
+}
+
+func (s *AdminService) UnblockImage(
+	ctx context.Context, pr *request.AdminImageBlockRequestDto) (*response.ResponseDto, error) {
+	return s.updateImageBlocked(ctx, pr, enum.AdminActionUnblockImage, false)
+}
+
+// ReviewComplaints - upholds or dismisses all the complaints under review against the user and
+// decides the open appeal. The user stays blocked while any complaint against him is upheld
+func (s *AdminService) ReviewComplaints(
+	ctx context.Context, pr *request.AdminComplaintReviewRequestDto) (*response.ResponseDto, error) {
+	if !enum.ComplaintStatusUnderReview.CanTransitionTo(pr.Decision) {
+		return nil, ErrComplaintDecisionInvalid
+	}
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		admin, err := s.authorize(ctx, unitOfWork, pr.AdminTelegramUserId, enum.AdminActionReviewComplaints)
+		if err != nil {
+			return err
+		}
+		complaintMapper := &mapper.ComplaintMapper{}
+		updateStatusRequest := complaintMapper.MapToUpdateStatusRequest(
+			pr.TelegramUserId, enum.ComplaintStatusUnderReview, pr.Decision, &admin.TelegramUserId)
+		reviewed, err := unitOfWork.ComplaintRepository().UpdateStatusByCriminalTelegramUserId(ctx, updateStatusRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("ReviewComplaints",
+				"ComplaintRepository().UpdateStatusByCriminalTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if reviewed == 0 {
+			return ErrComplaintNotUnderReview
+		}
+		countUpheld, err := unitOfWork.ComplaintRepository().GetCountByCriminalTelegramUserIdAndStatus(
+			ctx, pr.TelegramUserId, enum.ComplaintStatusUpheld)
+		if err != nil {
+			errorMessage := s.getErrorMessage("ReviewComplaints",
+				"ComplaintRepository().GetCountByCriminalTelegramUserIdAndStatus")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if err := s.updateProfileBlocked(ctx, unitOfWork, pr.TelegramUserId, countUpheld > 0, pr.Reason); err != nil {
+			return err
+		}
+		appeal, err := unitOfWork.ComplaintAppealRepository().FindOpenByTelegramUserId(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("ReviewComplaints",
+				"ComplaintAppealRepository().FindOpenByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if appeal != nil {
+			appealStatus := enum.ComplaintAppealStatusRejected
+			if pr.Decision == enum.ComplaintStatusDismissed {
+				appealStatus = enum.ComplaintAppealStatusAccepted
+			}
+			_, err = unitOfWork.ComplaintAppealRepository().UpdateStatus(ctx, appeal.Id, appealStatus,
+				admin.TelegramUserId)
+			if err != nil {
+				errorMessage := s.getErrorMessage("ReviewComplaints", "ComplaintAppealRepository().UpdateStatus")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+		}
+		adminMapper := &mapper.AdminMapper{}
+		return s.addAudit(ctx, unitOfWork, adminMapper.MapToAuditAddRequest(
+			admin, enum.AdminActionReviewComplaints, &pr.TelegramUserId, nil, pr.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.ResponseDto{Success: true}, nil
+}
+
+func (s *AdminService) GetAuditList(
+	ctx context.Context, pr *request.AdminAuditGetListRequestDto) (*response.AdminAuditListResponseDto, error) {
+	var auditList *response.AdminAuditListResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if _, err := s.authorize(ctx, unitOfWork, pr.AdminTelegramUserId, enum.AdminActionGetAuditList); err != nil {
+			return err
+		}
+		adminMapper := &mapper.AdminMapper{}
+		auditListRequest := adminMapper.MapToAuditListRequest(pr)
+		auditListRequest.Page, auditListRequest.Size = s.getPageAndSize(pr.Page, pr.Size)
+		var err error
+		auditList, err = unitOfWork.AdminAuditRepository().SelectList(ctx, auditListRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetAuditList", "AdminAuditRepository().SelectList")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return auditList, nil
+}
+
+func (s *AdminService) updateImageBlocked(ctx context.Context, pr *request.AdminImageBlockRequestDto,
+	action enum.AdminAction, isBlocked bool) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		admin, err := s.authorize(ctx, unitOfWork, pr.AdminTelegramUserId, action)
+		if err != nil {
+			return err
+		}
+		image, err := unitOfWork.ImageRepository().FindById(ctx, pr.ImageId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("updateImageBlocked", "ImageRepository().FindById")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err = unitOfWork.ImageStatusRepository().UpdateBlocked(ctx, pr.ImageId, isBlocked)
+		if err != nil {
+			errorMessage := s.getErrorMessage("updateImageBlocked", "ImageStatusRepository().UpdateBlocked")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if isBlocked {
+			if err := s.addImageHashToBlocklist(ctx, unitOfWork, pr.ImageId, pr.Reason); err != nil {
+				return err
+			}
+		}
+		adminMapper := &mapper.AdminMapper{}
+		return s.addAudit(ctx, unitOfWork, adminMapper.MapToAuditAddRequest(
+			admin, action, &image.TelegramUserId, &pr.ImageId, pr.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.ResponseDto{Success: true}, nil
+}
+
+// addImageHashToBlocklist - prevents the blocked file from being uploaded again,
+// the images uploaded before the hash has been stored are skipped
+func (s *AdminService) addImageHashToBlocklist(
+	ctx context.Context, unitOfWork *UnitOfWork, imageId uint64, reason string) error {
+	contentHash, err := unitOfWork.ImageRepository().FindContentHashById(ctx, imageId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addImageHashToBlocklist", "ImageRepository().FindContentHashById")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	if contentHash == "" {
+		return nil
+	}
+	_, err = unitOfWork.ImageHashBlocklistRepository().Add(ctx, contentHash, reason)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addImageHashToBlocklist", "ImageHashBlocklistRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// updateProfileBlocked - blocks the user with a notification or unblocks him, nothing is done
+// if the user is already in the requested state
+func (s *AdminService) updateProfileBlocked(ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string,
+	isBlocked bool, reason string) error {
+	status, err := unitOfWork.StatusRepository().FindByTelegramUserId(ctx, telegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("updateProfileBlocked", "StatusRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	if status.IsBlocked == isBlocked {
+		return nil
+	}
+	if !isBlocked {
+		_, err = unitOfWork.StatusRepository().Unblock(ctx, telegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("updateProfileBlocked", "StatusRepository().Unblock")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	}
+	_, err = unitOfWork.StatusRepository().Block(ctx, telegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("updateProfileBlocked", "StatusRepository().Block")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return s.addModerationNotification(ctx, unitOfWork, telegramUserId, reason)
+}
+
+// authorize - checks that the user is an admin and that the role allows the action
+func (s *AdminService) authorize(ctx context.Context, unitOfWork *UnitOfWork, adminTelegramUserId string,
+	action enum.AdminAction) (*entity.AdminEntity, error) {
+	admin, err := unitOfWork.AdminRepository().FindByTelegramUserId(ctx, adminTelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("authorize", "AdminRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	if admin == nil {
+		return nil, ErrAdminNotFound
+	}
+	if !admin.Role.IsAllowed(action) {
+		return nil, ErrAdminForbidden
+	}
+	return admin, nil
+}
+
+func (s *AdminService) addAudit(
+	ctx context.Context, unitOfWork *UnitOfWork, pr *request.AdminAuditAddRequestRepositoryDto) error {
+	_, err := unitOfWork.AdminAuditRepository().Add(ctx, pr)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addAudit", "AdminAuditRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// addModerationNotification - stores in the outbox the event that the profile has been blocked
+func (s *AdminService) addModerationNotification(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId, reason string) error {
+	eventMapper := &mapper.EventMapper{}
+	e := eventMapper.MapToProfileBlocked(telegramUserId, reason)
+	payload, err := proto.Marshal(e)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addModerationNotification", "proto.Marshal")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	outboxMapper := &mapper.OutboxMapper{}
+	outboxRequest := outboxMapper.MapToAddRequest(eventTopic, e.GetRecipientTelegramUserId(), payload)
+	_, err = unitOfWork.OutboxRepository().Add(ctx, outboxRequest)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addModerationNotification", "OutboxRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *AdminService) getPageAndSize(page, size uint64) (uint64, uint64) {
+	if page == 0 {
+		page = defaultAdminListPage
+	}
+	if size == 0 {
+		size = defaultAdminListSize
+	}
+	if size > maxAdminListSize {
+		size = maxAdminListSize
+	}
+	return page, size
+}
+
+func (s *AdminService) getErrorMessage(repositoryMethodName, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathAdminService)
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service/mapper"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+const (
+	errorFilePathImageModerationWorker = "internal/profiles/service/image-moderation-worker.go"
+	imageModerationBatchSize           = 10
+	imageModerationPollInterval        = 5 * time.Second
+	imageModerationMaxAttempts         = 5
+	imageModerationRetryBaseDelay      = time.Minute
+	imageModerationRetryMaxDelay       = time.Hour
+	imageModerationFailedReason        = "the image can't be checked"
+)
+
+// ImageModerator - decides whether an uploaded image may be shown to the other users.
+// An error means that the decision can't be made now and the image is moderated again later
+type ImageModerator interface {
+	Moderate(ctx context.Context, image *entity.ImageModerationEntity,
+		content []byte) (*entity.ImageModerationResultEntity, error)
+}
+
+// ImageLoader - loads the content of an uploaded image by its key in the object storage
+type ImageLoader interface {
+	Load(ctx context.Context, key string) ([]byte, error)
+}
+
+// ImageModerationWorker - moderates the pending images. The moderators are applied in order
+// and the first rejection blocks the image, the owner is notified about the result through the outbox
+type ImageModerationWorker struct {
+	logger     logger.Logger
+	uwf        *UnitOfWorkFactory
+	loader     ImageLoader
+	moderators []ImageModerator
+}
+
+func NewImageModerationWorker(l logger.Logger, uwf *UnitOfWorkFactory, il ImageLoader,
+	moderators ...ImageModerator) *ImageModerationWorker {
+	return &ImageModerationWorker{
+		logger:     l,
+		uwf:        uwf,
+		loader:     il,
+		moderators: moderators,
+	}
+}
+
+// Run - moderates the pending images until ctx is canceled
+func (w *ImageModerationWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(imageModerationPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			for {
+				count, err := w.ModerateBatch(ctx)
+				if err != nil {
+					errorMessage := w.getErrorMessage("Run", "ModerateBatch")
+					w.logger.Debug(errorMessage, zap.Error(err))
+					break
+				}
+				if count < imageModerationBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// ModerateBatch - moderates one batch of the pending images and returns the number of the taken ones.
+// The images are taken by a short transaction that counts the attempt and postpones the next one,
+// so the content is loaded and checked without holding the locks. An image that can't be loaded or checked
+// is taken again after the backoff and fails after imageModerationMaxAttempts
+func (w *ImageModerationWorker) ModerateBatch(ctx context.Context) (int, error) {
+	imageList, err := w.takePendingList(ctx)
+	if err != nil {
+		errorMessage := w.getErrorMessage("ModerateBatch", "takePendingList")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	for _, image := range imageList {
+		result, err := w.moderate(ctx, image)
+		if err != nil {
+			errorMessage := w.getErrorMessage("ModerateBatch", "moderate")
+			w.logger.Debug(errorMessage, zap.Error(err), zap.Uint64("imageId", image.ImageId),
+				zap.Uint64("attempts", image.Attempts))
+			if image.Attempts < imageModerationMaxAttempts {
+				continue
+			}
+			result = &entity.ImageModerationResultEntity{
+				IsFailed: true,
+				Reason:   imageModerationFailedReason,
+			}
+		}
+		err = w.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+			return w.applyResult(ctx, unitOfWork, image, result)
+		})
+		if err != nil {
+			errorMessage := w.getErrorMessage("ModerateBatch", "applyResult")
+			w.logger.Debug(errorMessage, zap.Error(err))
+			return 0, err
+		}
+	}
+	return len(imageList), nil
+}
+
+// takePendingList - takes the pending images whose next attempt is due and counts the attempt
+func (w *ImageModerationWorker) takePendingList(ctx context.Context) ([]*entity.ImageModerationEntity, error) {
+	var imageList []*entity.ImageModerationEntity
+	err := w.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		now := time.Now().UTC()
+		var err error
+		imageList, err = unitOfWork.ImageStatusRepository().SelectPendingList(ctx, now, imageModerationBatchSize)
+		if err != nil {
+			errorMessage := w.getErrorMessage("takePendingList", "ImageStatusRepository().SelectPendingList")
+			w.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		for _, image := range imageList {
+			nextAttemptAt := now.Add(w.getRetryDelay(image.Attempts))
+			_, err := unitOfWork.ImageStatusRepository().UpdateModerationAttempt(ctx, image.ImageId, nextAttemptAt)
+			if err != nil {
+				errorMessage := w.getErrorMessage("takePendingList",
+					"ImageStatusRepository().UpdateModerationAttempt")
+				w.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			image.Attempts++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imageList, nil
+}
+
+// getRetryDelay - doubles the delay after every failed attempt up to imageModerationRetryMaxDelay
+func (w *ImageModerationWorker) getRetryDelay(attempts uint64) time.Duration {
+	delay := imageModerationRetryBaseDelay
+	for i := uint64(0); i < attempts; i++ {
+		delay *= 2
+		if delay >= imageModerationRetryMaxDelay {
+			return imageModerationRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+func (w *ImageModerationWorker) moderate(
+	ctx context.Context, image *entity.ImageModerationEntity) (*entity.ImageModerationResultEntity, error) {
+	content, err := w.loader.Load(ctx, image.Key)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range w.moderators {
+		result, err := m.Moderate(ctx, image, content)
+		if err != nil {
+			return nil, err
+		}
+		if !result.IsApproved {
+			return result, nil
+		}
+	}
+	return &entity.ImageModerationResultEntity{
+		IsApproved: true,
+	}, nil
+}
+
+func (w *ImageModerationWorker) applyResult(ctx context.Context, unitOfWork *UnitOfWork,
+	image *entity.ImageModerationEntity, result *entity.ImageModerationResultEntity) error {
+	imageStatusMapper := &mapper.ImageStatusMapper{}
+	updateRequest := imageStatusMapper.MapToUpdateModerationRequest(image.ImageId, result)
+	isUpdated, err := unitOfWork.ImageStatusRepository().UpdatePendingModeration(ctx, updateRequest)
+	if err != nil {
+		errorMessage := w.getErrorMessage("applyResult", "ImageStatusRepository().UpdatePendingModeration")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	// The image has been moderated by an admin while it was checked
+	if !isUpdated {
+		return nil
+	}
+	return w.addModerationNotification(ctx, unitOfWork, image, result)
+}
+
+// addModerationNotification - stores in the outbox the event with the result of the moderation
+func (w *ImageModerationWorker) addModerationNotification(ctx context.Context, unitOfWork *UnitOfWork,
+	image *entity.ImageModerationEntity, result *entity.ImageModerationResultEntity) error {
+	telegramEntity, err := unitOfWork.TelegramRepository().FindByTelegramUserId(ctx, image.TelegramUserId)
+	if err != nil {
+		errorMessage := w.getErrorMessage("addModerationNotification",
+			"TelegramRepository().FindByTelegramUserId")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	eventMapper := &mapper.EventMapper{}
+	e := eventMapper.MapToImageModerated(image.TelegramUserId, image.ImageId, result, telegramEntity.LanguageCode)
+	payload, err := proto.Marshal(e)
+	if err != nil {
+		errorMessage := w.getErrorMessage("addModerationNotification", "proto.Marshal")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	outboxMapper := &mapper.OutboxMapper{}
+	outboxRequest := outboxMapper.MapToAddRequest(eventTopic, e.GetRecipientTelegramUserId(), payload)
+	if _, err := unitOfWork.OutboxRepository().Add(ctx, outboxRequest); err != nil {
+		errorMessage := w.getErrorMessage("addModerationNotification", "OutboxRepository().Add")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (w *ImageModerationWorker) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathImageModerationWorker)
+}
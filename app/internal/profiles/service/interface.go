@@ -5,8 +5,21 @@ import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"io"
+	"time"
 )
 
+type AdminRepository interface {
+	FindByTelegramUserId(ctx context.Context, telegramUserId string) (*entity.AdminEntity, error)
+}
+
+type AdminAuditRepository interface {
+	Add(ctx context.Context, p *request.AdminAuditAddRequestRepositoryDto) (*response.ResponseDto, error)
+	SelectList(ctx context.Context,
+		pr *request.AdminAuditGetListRequestRepositoryDto) (*response.AdminAuditListResponseDto, error)
+}
+
 type ProfileRepository interface {
 	Add(ctx context.Context, p *request.ProfileAddRequestRepositoryDto) (*response.ResponseDto, error)
 	Update(ctx context.Context, p *request.ProfileUpdateRequestRepositoryDto) (*response.ResponseDto, error)
@@ -17,9 +30,19 @@ type ProfileRepository interface {
 	GetShortInfo(ctx context.Context, telegramUserId string) (*response.ProfileShortInfoResponseRepositoryDto, error)
 	SelectList(ctx context.Context,
 		pr *request.ProfileGetListRequestRepositoryDto) (*response.ProfileListResponseRepositoryDto, error)
+	SelectListByTelegramUserIds(ctx context.Context,
+		viewerTelegramUserId string, telegramUserIds []string) ([]*response.ProfileListItemResponseDto, error)
 	UpdateLastOnline(ctx context.Context, p *request.ProfileUpdateLastOnlineRequestRepositoryDto) error
 }
 
+// ProfileListSnapshotRepository - stores the ranked discovery feeds of the viewers, their pages are read by the cursor
+type ProfileListSnapshotRepository interface {
+	Add(ctx context.Context, p *request.ProfileListSnapshotAddRequestRepositoryDto) (uint64, error)
+	DeleteExpired(ctx context.Context, createdBefore time.Time) (*response.ResponseDto, error)
+	FindPage(ctx context.Context, id uint64, telegramUserId string,
+		createdAfter time.Time, offset, limit uint64) (*entity.ProfileListSnapshotEntity, error)
+}
+
 type NavigatorRepository interface {
 	Add(ctx context.Context, p *request.NavigatorAddRequestRepositoryDto) (*response.ResponseDto, error)
 	Update(ctx context.Context, p *request.NavigatorUpdateRequestRepositoryDto) (*response.ResponseDto, error)
@@ -50,11 +73,60 @@ type ImageRepository interface {
 	SelectListAllByTelegramUserId(ctx context.Context, telegramUserId string) ([]*response.ImageResponseDto, error)
 	SelectListPublicByTelegramUserId(ctx context.Context, telegramUserId string) ([]*response.ImageResponseDto, error)
 	SelectListByTelegramUserId(ctx context.Context, telegramUserId string) ([]*response.ImageResponseDto, error)
+	SelectListVisibleByTelegramUserId(
+		ctx context.Context, telegramUserId, viewerTelegramUserId string) ([]*response.ImageResponseDto, error)
+	SelectListDetailByTelegramUserId(
+		ctx context.Context, telegramUserId string) ([]*response.ImageResponseRepositoryDto, error)
+	FindContentHashById(ctx context.Context, imageId uint64) (string, error)
+	UpdatePosition(ctx context.Context, imageId uint64, position uint64) (*response.ResponseDto, error)
+	UpdateUrl(ctx context.Context, imageId uint64, url string) (*response.ResponseDto, error)
 }
 
 type ImageStatusRepository interface {
 	Add(ctx context.Context, p *request.ImageStatusAddRequestRepositoryDto) (*response.ResponseDto, error)
 	FindById(ctx context.Context, id uint64) (*entity.ImageStatusEntity, error)
+	UpdateBlocked(ctx context.Context, imageId uint64, isBlocked bool) (*response.ResponseDto, error)
+	UpdatePrimary(ctx context.Context, imageId uint64, isPrimary bool) (*response.ResponseDto, error)
+	UpdatePrivate(ctx context.Context, imageId uint64, isPrivate bool) (*response.ResponseDto, error)
+	UpdatePendingModeration(ctx context.Context,
+		p *request.ImageStatusUpdateModerationRequestRepositoryDto) (bool, error)
+	UpdateModerationAttempt(ctx context.Context, imageId uint64, nextAttemptAt time.Time) (*response.ResponseDto, error)
+	SelectPendingList(ctx context.Context, now time.Time, limit uint64) ([]*entity.ImageModerationEntity, error)
+}
+
+type ImageHashBlocklistRepository interface {
+	Add(ctx context.Context, hash, reason string) (*response.ResponseDto, error)
+	Exists(ctx context.Context, hash string) (bool, error)
+}
+
+type ImageGrantRepository interface {
+	Add(ctx context.Context, telegramUserId, viewerTelegramUserId string) (*response.ResponseDto, error)
+	Delete(ctx context.Context, telegramUserId, viewerTelegramUserId string) (*response.ResponseDto, error)
+	DeletePair(ctx context.Context, telegramUserId, otherTelegramUserId string) (*response.ResponseDto, error)
+	SelectListByTelegramUserId(ctx context.Context, telegramUserId string) ([]*entity.ImageGrantEntity, error)
+}
+
+type ImageVariantRepository interface {
+	Add(ctx context.Context, p *request.ImageVariantAddRequestRepositoryDto) (*response.ResponseDto, error)
+	SelectListByImageIds(ctx context.Context, imageIds []uint64) ([]*response.ImageVariantResponseDto, error)
+	UpdateUrlByKey(ctx context.Context, key, url string) (*response.ResponseDto, error)
+	SelectObjectList(ctx context.Context) ([]*entity.ImageObjectEntity, error)
+}
+
+// ObjectStorage - stores the files of the images by their keys, e.g. /profiles/{telegramUserId}/images/...
+type ObjectStorage interface {
+	Upload(ctx context.Context, key string, body io.Reader, contentType string) error
+	Delete(ctx context.Context, key string) error
+	Download(ctx context.Context, key string) (io.ReadCloser, error)
+	Url(key string) string
+	PresignGetUrl(ctx context.Context, key string, expires time.Duration) (string, error)
+	SetPublic(ctx context.Context, key string, isPublic bool) error
+	List(ctx context.Context, prefix string) ([]*entity.StorageObjectEntity, error)
+}
+
+// ProfileRanker - orders the candidates of the discovery feed for the viewer
+type ProfileRanker interface {
+	Rank(viewer *entity.RankingViewerEntity, candidates []*entity.ProfileCandidateEntity, now time.Time)
 }
 
 type LikeRepository interface {
@@ -63,6 +135,48 @@ type LikeRepository interface {
 	DeleteRelatedProfiles(ctx context.Context, id string) (*response.ResponseDto, error)
 	FindById(ctx context.Context, id uint64) (*entity.LikeEntity, error)
 	FindLastLike(ctx context.Context, telegramUserId string) (*entity.LikeEntity, error)
+	FindLike(ctx context.Context, telegramUserId, likedTelegramUserId string) (*entity.LikeEntity, error)
+	SelectListByLikedTelegramUserId(
+		ctx context.Context, likedTelegramUserId string, limit uint64) (*response.LikeListResponseDto, error)
+}
+
+type MatchRepository interface {
+	LockPair(ctx context.Context, telegramUserId, matchedTelegramUserId string) error
+	Add(ctx context.Context, p *request.MatchAddRequestRepositoryDto) (uint64, error)
+	Delete(ctx context.Context, telegramUserId, matchedTelegramUserId string) (*response.ResponseDto, error)
+	FindMatch(ctx context.Context, telegramUserId, matchedTelegramUserId string) (*entity.MatchEntity, error)
+	SelectListByTelegramUserId(ctx context.Context, telegramUserId string) (*response.MatchListResponseDto, error)
+}
+
+type ConversationRepository interface {
+	Add(ctx context.Context, p *request.ConversationAddRequestRepositoryDto) (uint64, error)
+	UpdateLastMessage(ctx context.Context,
+		p *request.ConversationUpdateLastMessageRequestRepositoryDto) (*response.ResponseDto, error)
+	FindById(ctx context.Context, id uint64) (*entity.ConversationEntity, error)
+	SelectListByTelegramUserId(
+		ctx context.Context, telegramUserId string) (*response.ConversationListResponseDto, error)
+}
+
+type MessageRepository interface {
+	Add(ctx context.Context, p *request.MessageAddRequestRepositoryDto) (*entity.MessageEntity, error)
+	SelectListByConversationId(
+		ctx context.Context, p *request.MessageGetListRequestRepositoryDto) ([]*entity.MessageEntity, error)
+	MarkRead(ctx context.Context, p *request.MessageMarkReadRequestRepositoryDto) (*response.ResponseDto, error)
+}
+
+type OutboxRepository interface {
+	Add(ctx context.Context, p *request.OutboxAddRequestRepositoryDto) (*response.ResponseDto, error)
+	SelectPendingList(ctx context.Context, now time.Time, limit uint64) ([]*entity.OutboxEntity, error)
+	MarkSent(ctx context.Context, id uint64, sentAt time.Time) (*response.ResponseDto, error)
+	MarkFailed(ctx context.Context, p *request.OutboxMarkFailedRequestRepositoryDto) (*response.ResponseDto, error)
+}
+
+type RefreshTokenRepository interface {
+	Add(ctx context.Context, p *request.RefreshTokenAddRequestRepositoryDto) (*response.ResponseDto, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshTokenEntity, error)
+	Revoke(ctx context.Context, id uint64, revokedAt time.Time) (*response.ResponseDto, error)
+	RevokeListByTelegramUserId(
+		ctx context.Context, telegramUserId string, revokedAt time.Time) (*response.ResponseDto, error)
 }
 
 type BlockRepository interface {
@@ -79,12 +193,31 @@ type ComplaintRepository interface {
 	DeleteRelatedProfiles(ctx context.Context, id string) (*response.ResponseDto, error)
 	GetCountUserComplaintsByToday(ctx context.Context, telegramUserId string) (uint64, error)
 	GetCountUserComplaintsByCurrentMonth(ctx context.Context, telegramUserId string) (uint64, error)
+	GetCountByCriminalTelegramUserId(ctx context.Context, criminalTelegramUserId string) (uint64, error)
+	SelectList(ctx context.Context,
+		pr *request.ComplaintGetListRequestRepositoryDto) (*response.ComplaintListResponseDto, error)
+	GetWeightByCriminalTelegramUserId(
+		ctx context.Context, criminalTelegramUserId string, complaintType enum.Complaint) (float64, error)
+	GetCountByTelegramUserIdAndStatus(
+		ctx context.Context, telegramUserId string, status enum.ComplaintStatus) (uint64, error)
+	GetCountByCriminalTelegramUserIdAndStatus(
+		ctx context.Context, criminalTelegramUserId string, status enum.ComplaintStatus) (uint64, error)
+	UpdateStatusByCriminalTelegramUserId(
+		ctx context.Context, p *request.ComplaintUpdateStatusRequestRepositoryDto) (uint64, error)
+}
+
+type ComplaintAppealRepository interface {
+	Add(ctx context.Context, p *request.ComplaintAppealAddRequestRepositoryDto) (*response.ResponseDto, error)
+	FindOpenByTelegramUserId(ctx context.Context, telegramUserId string) (*entity.ComplaintAppealEntity, error)
+	UpdateStatus(ctx context.Context, id uint64, status enum.ComplaintAppealStatus,
+		reviewedBy string) (*response.ResponseDto, error)
 }
 
 type StatusRepository interface {
 	Add(ctx context.Context, p *request.StatusAddRequestRepositoryDto) (*response.ResponseDto, error)
 	Update(ctx context.Context, p *request.StatusUpdateRequestRepositoryDto) (*entity.StatusEntity, error)
 	Block(ctx context.Context, telegramUserId string) (*entity.StatusEntity, error)
+	Unblock(ctx context.Context, telegramUserId string) (*entity.StatusEntity, error)
 	Freeze(ctx context.Context, telegramUserId string) (*entity.StatusEntity, error)
 	Restore(ctx context.Context, telegramUserId string) (*entity.StatusEntity, error)
 	UpdateSettings(
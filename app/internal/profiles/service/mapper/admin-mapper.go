@@ -0,0 +1,66 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"time"
+)
+
+type AdminMapper struct {
+}
+
+func (pm *AdminMapper) MapToAuditAddRequest(
+	admin *entity.AdminEntity, action enum.AdminAction, targetTelegramUserId *string, targetImageId *uint64,
+	reason string) *request.AdminAuditAddRequestRepositoryDto {
+	return &request.AdminAuditAddRequestRepositoryDto{
+		AdminTelegramUserId:  admin.TelegramUserId,
+		Role:                 admin.Role,
+		Action:               action,
+		TargetTelegramUserId: targetTelegramUserId,
+		TargetImageId:        targetImageId,
+		Reason:               reason,
+		CreatedAt:            time.Now().UTC(),
+	}
+}
+
+func (pm *AdminMapper) MapToComplaintListRequest(
+	pr *request.AdminComplaintGetListRequestDto) *request.ComplaintGetListRequestRepositoryDto {
+	return &request.ComplaintGetListRequestRepositoryDto{
+		TelegramUserId:         pr.TelegramUserId,
+		CriminalTelegramUserId: pr.CriminalTelegramUserId,
+		Type:                   pr.Type,
+		DateFrom:               pr.DateFrom,
+		DateTo:                 pr.DateTo,
+		Status:                 pr.Status,
+		Page:                   pr.Page,
+		Size:                   pr.Size,
+	}
+}
+
+func (pm *AdminMapper) MapToAuditListRequest(
+	pr *request.AdminAuditGetListRequestDto) *request.AdminAuditGetListRequestRepositoryDto {
+	return &request.AdminAuditGetListRequestRepositoryDto{
+		ActorTelegramUserId:  pr.ActorTelegramUserId,
+		TargetTelegramUserId: pr.TargetTelegramUserId,
+		Page:                 pr.Page,
+		Size:                 pr.Size,
+	}
+}
+
+func (pm *AdminMapper) MapToProfileResponse(
+	p *response.ProfileResponseRepositoryDto, images []*response.ImageResponseRepositoryDto,
+	complaintCount uint64) *response.AdminProfileResponseDto {
+	return &response.AdminProfileResponseDto{
+		TelegramUserId: p.TelegramUserId,
+		DisplayName:    p.DisplayName,
+		Age:            p.Age,
+		Gender:         p.Gender,
+		Description:    p.Description,
+		IsBlocked:      p.Status.IsBlocked,
+		IsFrozen:       p.Status.IsFrozen,
+		ComplaintCount: complaintCount,
+		Images:         images,
+	}
+}
@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
 	"time"
 )
 
@@ -9,13 +10,37 @@ type ComplaintMapper struct {
 }
 
 func (pm *ComplaintMapper) MapToAddRequest(
-	pr *request.ComplaintAddRequestDto) *request.ComplaintAddRequestRepositoryDto {
+	pr *request.ComplaintAddRequestDto, weight float64) *request.ComplaintAddRequestRepositoryDto {
 	return &request.ComplaintAddRequestRepositoryDto{
 		TelegramUserId:         pr.TelegramUserId,
 		CriminalTelegramUserId: pr.CriminalTelegramUserId,
 		Type:                   pr.Type,
 		Description:            pr.Description,
+		Status:                 enum.ComplaintStatusOpen,
+		Weight:                 weight,
 		CreatedAt:              time.Now().UTC(),
 		UpdatedAt:              time.Now().UTC(),
 	}
 }
+
+func (pm *ComplaintMapper) MapToAppealAddRequest(
+	pr *request.ComplaintAppealAddRequestDto) *request.ComplaintAppealAddRequestRepositoryDto {
+	return &request.ComplaintAppealAddRequestRepositoryDto{
+		TelegramUserId: pr.TelegramUserId,
+		Description:    pr.Description,
+		Status:         enum.ComplaintAppealStatusOpen,
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
+	}
+}
+
+func (pm *ComplaintMapper) MapToUpdateStatusRequest(criminalTelegramUserId string,
+	previousStatus, status enum.ComplaintStatus, reviewedBy *string) *request.ComplaintUpdateStatusRequestRepositoryDto {
+	return &request.ComplaintUpdateStatusRequestRepositoryDto{
+		CriminalTelegramUserId: criminalTelegramUserId,
+		PreviousStatus:         previousStatus,
+		Status:                 status,
+		ReviewedBy:             reviewedBy,
+		UpdatedAt:              time.Now().UTC(),
+	}
+}
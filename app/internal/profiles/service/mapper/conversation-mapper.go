@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"time"
+)
+
+type ConversationMapper struct {
+}
+
+// MapToAddRequest - the pair is stored in a canonical order, so that both users
+// writing to each other always share one conversation
+func (pm *ConversationMapper) MapToAddRequest(
+	telegramUserId, peerTelegramUserId string) *request.ConversationAddRequestRepositoryDto {
+	if peerTelegramUserId < telegramUserId {
+		telegramUserId, peerTelegramUserId = peerTelegramUserId, telegramUserId
+	}
+	return &request.ConversationAddRequestRepositoryDto{
+		TelegramUserId:     telegramUserId,
+		PeerTelegramUserId: peerTelegramUserId,
+		CreatedAt:          time.Now().UTC(),
+		UpdatedAt:          time.Now().UTC(),
+	}
+}
+
+func (pm *ConversationMapper) MapToUpdateLastMessageRequest(
+	m *entity.MessageEntity) *request.ConversationUpdateLastMessageRequestRepositoryDto {
+	return &request.ConversationUpdateLastMessageRequestRepositoryDto{
+		Id:            m.ConversationId,
+		LastMessageId: m.Id,
+		LastMessageAt: m.CreatedAt,
+		UpdatedAt:     time.Now().UTC(),
+	}
+}
@@ -0,0 +1,107 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/events"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type EventMapper struct {
+}
+
+func (pm *EventMapper) MapToLikeReceived(recipientTelegramUserId string, telegramEntity *entity.TelegramEntity,
+	userImageUrl, message, languageCode string) *events.Event {
+	e := pm.newEvent(events.EventType_EVENT_TYPE_LIKE_RECEIVED, recipientTelegramUserId)
+	e.Payload = &events.Event_LikeReceived{
+		LikeReceived: &events.LikeReceived{
+			TelegramUserId: telegramEntity.UserId,
+			Username:       telegramEntity.UserName,
+			UserImageUrl:   userImageUrl,
+			Message:        message,
+			LanguageCode:   languageCode,
+		},
+	}
+	return e
+}
+
+func (pm *EventMapper) MapToMatchCreated(recipientTelegramUserId string, telegramEntity *entity.TelegramEntity,
+	userImageUrl, message string) *events.Event {
+	e := pm.newEvent(events.EventType_EVENT_TYPE_MATCH_CREATED, recipientTelegramUserId)
+	e.Payload = &events.Event_MatchCreated{
+		MatchCreated: &events.MatchCreated{
+			TelegramUserId: telegramEntity.UserId,
+			Username:       telegramEntity.UserName,
+			UserImageUrl:   userImageUrl,
+			Message:        message,
+		},
+	}
+	return e
+}
+
+func (pm *EventMapper) MapToMessageReceived(messageEntity *entity.MessageEntity,
+	telegramEntity *entity.TelegramEntity, userImageUrl string) *events.Event {
+	e := pm.newEvent(events.EventType_EVENT_TYPE_MESSAGE_RECEIVED, messageEntity.RecipientTelegramUserId)
+	e.Payload = &events.Event_MessageReceived{
+		MessageReceived: &events.MessageReceived{
+			ConversationId:       messageEntity.ConversationId,
+			MessageId:            messageEntity.Id,
+			SenderTelegramUserId: messageEntity.SenderTelegramUserId,
+			Username:             telegramEntity.UserName,
+			UserImageUrl:         userImageUrl,
+			Text:                 messageEntity.Text,
+		},
+	}
+	return e
+}
+
+func (pm *EventMapper) MapToProfileBlocked(telegramUserId, reason string) *events.Event {
+	e := pm.newEvent(events.EventType_EVENT_TYPE_PROFILE_BLOCKED, telegramUserId)
+	e.Payload = &events.Event_ProfileBlocked{
+		ProfileBlocked: &events.ProfileBlocked{
+			Reason: reason,
+		},
+	}
+	return e
+}
+
+func (pm *EventMapper) MapToPaymentSucceeded(
+	r *request.PaymentAddRequestRepositoryDto, languageCode string) *events.Event {
+	e := pm.newEvent(events.EventType_EVENT_TYPE_PAYMENT_SUCCEEDED, r.TelegramUserId)
+	e.Payload = &events.Event_PaymentSucceeded{
+		PaymentSucceeded: &events.PaymentSucceeded{
+			Price:          r.Price,
+			Currency:       r.Currency,
+			Tariff:         r.Tariff,
+			AvailableUntil: timestamppb.New(r.AvailableUntil),
+			LanguageCode:   languageCode,
+		},
+	}
+	return e
+}
+
+func (pm *EventMapper) MapToImageModerated(telegramUserId string, imageId uint64,
+	r *entity.ImageModerationResultEntity, languageCode string) *events.Event {
+	e := pm.newEvent(events.EventType_EVENT_TYPE_IMAGE_MODERATED, telegramUserId)
+	e.Payload = &events.Event_ImageModerated{
+		ImageModerated: &events.ImageModerated{
+			ImageId:      imageId,
+			IsApproved:   r.IsApproved,
+			Reason:       r.Reason,
+			LanguageCode: languageCode,
+		},
+	}
+	return e
+}
+
+func (pm *EventMapper) newEvent(eventType events.EventType, recipientTelegramUserId string) *events.Event {
+	return &events.Event{
+		Id:                      uuid.NewString(),
+		Type:                    eventType,
+		Version:                 events.EventVersion_EVENT_VERSION_V1,
+		CreatedAt:               timestamppb.New(time.Now().UTC()),
+		RecipientTelegramUserId: recipientTelegramUserId,
+	}
+}
@@ -13,5 +13,6 @@ func (pm *ImageMapper) MapToResponse(i *response.ImageResponseRepositoryDto) *re
 		TelegramUserId: i.TelegramUserId,
 		Name:           i.Name,
 		Url:            i.Url,
+		Variants:       i.Variants,
 	}
 }
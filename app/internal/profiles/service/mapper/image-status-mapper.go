@@ -2,6 +2,8 @@ package mapper
 
 import (
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
 	"time"
 )
 
@@ -10,11 +12,28 @@ type ImageStatusMapper struct {
 
 func (pm *ImageStatusMapper) MapToAddRequest(imageId uint64) *request.ImageStatusAddRequestRepositoryDto {
 	return &request.ImageStatusAddRequestRepositoryDto{
-		ImageId:   imageId,
-		IsBlocked: false,
-		IsPrimary: false,
-		IsPrivate: false,
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		ImageId:          imageId,
+		IsBlocked:        false,
+		IsPrimary:        false,
+		IsPrivate:        false,
+		ModerationStatus: enum.ImageModerationStatusPending,
+		CreatedAt:        time.Now().UTC(),
+		UpdatedAt:        time.Now().UTC(),
+	}
+}
+
+func (pm *ImageStatusMapper) MapToUpdateModerationRequest(
+	imageId uint64, r *entity.ImageModerationResultEntity) *request.ImageStatusUpdateModerationRequestRepositoryDto {
+	status := enum.ImageModerationStatusApproved
+	if r.IsFailed {
+		status = enum.ImageModerationStatusFailed
+	} else if !r.IsApproved {
+		status = enum.ImageModerationStatusBlocked
+	}
+	return &request.ImageStatusUpdateModerationRequestRepositoryDto{
+		ImageId:          imageId,
+		ModerationStatus: status,
+		ModerationReason: r.Reason,
+		ModeratedAt:      time.Now().UTC(),
 	}
 }
@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"time"
+)
+
+type MatchMapper struct {
+}
+
+// MapToAddRequest - the pair is stored in a canonical order, so that both users
+// liking each other always hit the same row
+func (pm *MatchMapper) MapToAddRequest(
+	telegramUserId, matchedTelegramUserId string) *request.MatchAddRequestRepositoryDto {
+	if matchedTelegramUserId < telegramUserId {
+		telegramUserId, matchedTelegramUserId = matchedTelegramUserId, telegramUserId
+	}
+	return &request.MatchAddRequestRepositoryDto{
+		TelegramUserId:        telegramUserId,
+		MatchedTelegramUserId: matchedTelegramUserId,
+		CreatedAt:             time.Now().UTC(),
+	}
+}
@@ -0,0 +1,55 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"strings"
+	"time"
+)
+
+type MessageMapper struct {
+}
+
+func (pm *MessageMapper) MapToAddRequest(
+	conversationId uint64, pr *request.MessageAddRequestDto) *request.MessageAddRequestRepositoryDto {
+	return &request.MessageAddRequestRepositoryDto{
+		ConversationId:          conversationId,
+		SenderTelegramUserId:    pr.TelegramUserId,
+		RecipientTelegramUserId: pr.RecipientTelegramUserId,
+		Text:                    strings.TrimSpace(pr.Text),
+		IsRead:                  false,
+		CreatedAt:               time.Now().UTC(),
+	}
+}
+
+func (pm *MessageMapper) MapToGetListRequest(
+	conversationId, beforeId, limit uint64) *request.MessageGetListRequestRepositoryDto {
+	return &request.MessageGetListRequestRepositoryDto{
+		ConversationId: conversationId,
+		BeforeId:       beforeId,
+		Limit:          limit,
+	}
+}
+
+func (pm *MessageMapper) MapToMarkReadRequest(
+	pr *request.MessageMarkReadRequestDto) *request.MessageMarkReadRequestRepositoryDto {
+	return &request.MessageMarkReadRequestRepositoryDto{
+		ConversationId:          pr.ConversationId,
+		RecipientTelegramUserId: pr.TelegramUserId,
+		LastReadMessageId:       pr.LastReadMessageId,
+		ReadAt:                  time.Now().UTC(),
+	}
+}
+
+func (pm *MessageMapper) MapToResponse(m *entity.MessageEntity) *response.MessageResponseDto {
+	return &response.MessageResponseDto{
+		Id:                      m.Id,
+		ConversationId:          m.ConversationId,
+		SenderTelegramUserId:    m.SenderTelegramUserId,
+		RecipientTelegramUserId: m.RecipientTelegramUserId,
+		Text:                    m.Text,
+		IsRead:                  m.IsRead,
+		CreatedAt:               m.CreatedAt,
+	}
+}
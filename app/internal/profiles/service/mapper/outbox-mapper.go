@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"time"
+)
+
+type OutboxMapper struct {
+}
+
+func (pm *OutboxMapper) MapToAddRequest(topic, messageKey string, payload []byte) *request.OutboxAddRequestRepositoryDto {
+	return &request.OutboxAddRequestRepositoryDto{
+		Topic:         topic,
+		MessageKey:    messageKey,
+		Payload:       payload,
+		IsSent:        false,
+		NextAttemptAt: time.Now().UTC(),
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+func (pm *OutboxMapper) MapToMarkFailedRequest(
+	id uint64, err error, nextAttemptAt time.Time) *request.OutboxMarkFailedRequestRepositoryDto {
+	return &request.OutboxMarkFailedRequestRepositoryDto{
+		Id:            id,
+		LastError:     err.Error(),
+		NextAttemptAt: nextAttemptAt,
+	}
+}
@@ -123,16 +123,15 @@ func (pm *ProfileMapper) MapToUpdateRequest(
 	}
 }
 
-func (pm *ProfileMapper) MapToListRequest(
-	pr *request.ProfileGetListRequestDto, f *entity.FilterEntity) *request.ProfileGetListRequestRepositoryDto {
+func (pm *ProfileMapper) MapToListRequest(pr *request.ProfileGetListRequestDto, f *entity.FilterEntity,
+	limit uint64) *request.ProfileGetListRequestRepositoryDto {
 	return &request.ProfileGetListRequestRepositoryDto{
 		TelegramUserId: pr.TelegramUserId,
 		SearchGender:   f.SearchGender,
 		AgeFrom:        f.AgeFrom,
 		AgeTo:          f.AgeTo,
 		Distance:       f.Distance,
-		Page:           f.Page,
-		Size:           f.Size,
+		Limit:          limit,
 		IsLiked:        f.IsLiked,
 		IsOnline:       f.IsOnline,
 	}
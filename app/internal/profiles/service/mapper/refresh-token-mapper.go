@@ -0,0 +1,19 @@
+package mapper
+
+import (
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"time"
+)
+
+type RefreshTokenMapper struct {
+}
+
+func (pm *RefreshTokenMapper) MapToAddRequest(
+	telegramUserId, tokenHash string, expiresAt time.Time) *request.RefreshTokenAddRequestRepositoryDto {
+	return &request.RefreshTokenAddRequestRepositoryDto{
+		TelegramUserId: telegramUserId,
+		TokenHash:      tokenHash,
+		ExpiresAt:      expiresAt.UTC(),
+		CreatedAt:      time.Now().UTC(),
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service/mapper"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathOutboxRelay  = "internal/profiles/service/outbox-relay.go"
+	outboxRelayBatchSize      = 100
+	outboxRelayPollInterval   = time.Second
+	outboxRelayRetryBaseDelay = time.Second
+	outboxRelayRetryMaxDelay  = 5 * time.Minute
+)
+
+// OutboxPublisher - the destination of the outbox rows, implemented by *kafka.Writer
+type OutboxPublisher interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// OutboxRelay - publishes the rows stored in the outbox by the transactions of the service.
+// A row is marked as sent only after it has been written to Kafka, so the delivery is at least once
+type OutboxRelay struct {
+	logger    logger.Logger
+	uwf       *UnitOfWorkFactory
+	publisher OutboxPublisher
+}
+
+func NewOutboxRelay(l logger.Logger, uwf *UnitOfWorkFactory, p OutboxPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		logger:    l,
+		uwf:       uwf,
+		publisher: p,
+	}
+}
+
+// Run - relays the outbox until ctx is canceled
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(outboxRelayPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			for {
+				count, err := r.RelayBatch(ctx)
+				if err != nil {
+					errorMessage := r.getErrorMessage("Run", "RelayBatch")
+					r.logger.Debug(errorMessage, zap.Error(err))
+					break
+				}
+				// A full batch means there may be more pending rows, they are relayed without waiting
+				if count < outboxRelayBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RelayBatch - publishes one batch of the pending rows by a single write and returns the number
+// of the sent rows. The failed rows are scheduled for a retry with an exponential backoff,
+// the rest of the batch is marked as sent
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	count := 0
+	err := r.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		outboxList, err := unitOfWork.OutboxRepository().SelectPendingList(ctx, time.Now().UTC(),
+			outboxRelayBatchSize)
+		if err != nil {
+			errorMessage := r.getErrorMessage("RelayBatch", "OutboxRepository().SelectPendingList")
+			r.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if len(outboxList) == 0 {
+			return nil
+		}
+		publishErrors := r.publish(ctx, outboxList)
+		outboxMapper := &mapper.OutboxMapper{}
+		for i, o := range outboxList {
+			if err := publishErrors[i]; err != nil {
+				errorMessage := r.getErrorMessage("RelayBatch", "publish")
+				r.logger.Debug(errorMessage, zap.Error(err), zap.Uint64("id", o.Id))
+				nextAttemptAt := time.Now().UTC().Add(r.getRetryDelay(o.Attempts))
+				failedRequest := outboxMapper.MapToMarkFailedRequest(o.Id, err, nextAttemptAt)
+				if _, err := unitOfWork.OutboxRepository().MarkFailed(ctx, failedRequest); err != nil {
+					errorMessage := r.getErrorMessage("RelayBatch", "OutboxRepository().MarkFailed")
+					r.logger.Debug(errorMessage, zap.Error(err))
+					return err
+				}
+				continue
+			}
+			if _, err := unitOfWork.OutboxRepository().MarkSent(ctx, o.Id, time.Now().UTC()); err != nil {
+				errorMessage := r.getErrorMessage("RelayBatch", "OutboxRepository().MarkSent")
+				r.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// publish - writes the rows by a single call and returns the error of every row, nil for the written ones.
+// kafka.WriteErrors tells which of the messages failed, any other error fails all of them
+func (r *OutboxRelay) publish(ctx context.Context, outboxList []*entity.OutboxEntity) []error {
+	messages := make([]kafka.Message, 0, len(outboxList))
+	for _, o := range outboxList {
+		messages = append(messages, kafka.Message{
+			Topic: o.Topic,
+			Key:   []byte(o.MessageKey),
+			Value: o.Payload,
+		})
+	}
+	publishErrors := make([]error, len(outboxList))
+	err := r.publisher.WriteMessages(ctx, messages...)
+	if err == nil {
+		return publishErrors
+	}
+	var writeErrors kafka.WriteErrors
+	if errors.As(err, &writeErrors) && len(writeErrors) == len(outboxList) {
+		copy(publishErrors, writeErrors)
+		return publishErrors
+	}
+	for i := range publishErrors {
+		publishErrors[i] = err
+	}
+	return publishErrors
+}
+
+// getRetryDelay - doubles the delay after every failed attempt up to outboxRelayRetryMaxDelay
+func (r *OutboxRelay) getRetryDelay(attempts uint64) time.Duration {
+	delay := outboxRelayRetryBaseDelay
+	for i := uint64(0); i < attempts; i++ {
+		delay *= 2
+		if delay >= outboxRelayRetryMaxDelay {
+			return outboxRelayRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+func (r *OutboxRelay) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathOutboxRelay)
+}
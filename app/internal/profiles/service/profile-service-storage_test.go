@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/config"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/storage"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testTelegramUserId = "1"
+
+// testStatusRepository - every profile exists and is not frozen
+type testStatusRepository struct {
+	StatusRepository
+}
+
+func (r *testStatusRepository) CheckProfileExists(
+	ctx context.Context, telegramUserId string) (*response.CheckExistsDto, error) {
+	return &response.CheckExistsDto{TelegramUserId: telegramUserId}, nil
+}
+
+// testStorageList - the image upload and delete run against every backend that works offline
+func testStorageList(t *testing.T) map[string]ObjectStorage {
+	return map[string]ObjectStorage{
+		"memory": storage.NewMemoryStorage("http://localhost"),
+		"local":  storage.NewLocalStorage(t.TempDir(), "http://localhost"),
+	}
+}
+
+func newTestProfileService(t *testing.T, obs ObjectStorage, imageUploadMaxSize int64) *ProfileService {
+	l, err := logger.New(logger.GetDefaultLevel())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ProfileService{
+		logger:           l,
+		config:           &config.Config{ImageUploadMaxSize: imageUploadMaxSize},
+		storage:          obs,
+		statusRepository: &testStatusRepository{},
+	}
+}
+
+func newTestImage(t *testing.T) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 48))
+	for x := 0; x < 64; x++ {
+		for y := 0; y < 48; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 4), G: uint8(y * 5), B: uint8(x * y), A: 255})
+		}
+	}
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		t.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func listStorageKeys(t *testing.T, obs ObjectStorage) []string {
+	list, err := obs.List(context.Background(), "/profiles/")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	keys := make([]string, 0, len(list))
+	for _, object := range list {
+		keys = append(keys, object.Key)
+	}
+	return keys
+}
+
+func TestProfileServiceImageUploadDelete(t *testing.T) {
+	for name, obs := range testStorageList(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestProfileService(t, obs, 1<<20)
+			filePath := filepath.Join(t.TempDir(), "photo.png")
+			if err := os.WriteFile(filePath, newTestImage(t), 0644); err != nil {
+				t.Fatal(err)
+			}
+			imageConverted, err := s.convertUploadedImage(ctx, testTelegramUserId, filePath, "my photo.png", "hash")
+			if err != nil {
+				t.Fatalf("convertUploadedImage: %v", err)
+			}
+			if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+				t.Errorf("the uploaded file is left after the conversion: %v", err)
+			}
+			if len(imageConverted.Variants) != len(enum.ImageVariants) {
+				t.Fatalf("variants = %d, want %d", len(imageConverted.Variants), len(enum.ImageVariants))
+			}
+			keys := listStorageKeys(t, obs)
+			if len(keys) != len(enum.ImageVariants) {
+				t.Fatalf("stored objects = %v", keys)
+			}
+			variants := make([]*response.ImageVariantResponseDto, 0, len(imageConverted.Variants))
+			for _, variant := range imageConverted.Variants {
+				if !strings.HasPrefix(variant.Key, "/profiles/"+testTelegramUserId+"/images/") {
+					t.Errorf("variant key = %q", variant.Key)
+				}
+				if variant.Url != obs.Url(variant.Key) {
+					t.Errorf("variant url = %q, want %q", variant.Url, obs.Url(variant.Key))
+				}
+				variants = append(variants, &response.ImageVariantResponseDto{Key: variant.Key})
+			}
+			if full := imageConverted.Variants[len(imageConverted.Variants)-1]; imageConverted.Url != full.Url {
+				t.Errorf("image url = %q, want the full variant %q", imageConverted.Url, full.Url)
+			}
+
+			s.deleteImageObjectList(ctx, s.getImageKeyList(testTelegramUserId, imageConverted.Name, variants))
+			if keys := listStorageKeys(t, obs); len(keys) != 0 {
+				t.Errorf("stored objects after the delete = %v", keys)
+			}
+		})
+	}
+}
+
+func TestProfileServiceImageDeleteLegacyKey(t *testing.T) {
+	for name, obs := range testStorageList(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestProfileService(t, obs, 1<<20)
+			key := "/profiles/" + testTelegramUserId + "/images/photo.webp"
+			if err := obs.Upload(ctx, key, bytes.NewReader([]byte("image")), "image/webp"); err != nil {
+				t.Fatal(err)
+			}
+			s.deleteImageObjectList(ctx, s.getImageKeyList(testTelegramUserId, "photo.webp", nil))
+			if keys := listStorageKeys(t, obs); len(keys) != 0 {
+				t.Errorf("stored objects after the delete = %v", keys)
+			}
+		})
+	}
+}
+
+func TestProfileServiceUploadImageRejected(t *testing.T) {
+	testImage := newTestImage(t)
+	tests := []struct {
+		name               string
+		content            []byte
+		imageUploadMaxSize int64
+		err                error
+	}{
+		{name: "not an image", content: []byte("<html>not an image</html>"), imageUploadMaxSize: 1 << 20,
+			err: ErrImageContentType},
+		{name: "too large", content: testImage, imageUploadMaxSize: int64(len(testImage)) - 1,
+			err: ErrImageTooLarge},
+	}
+	for name, obs := range testStorageList(t) {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				s := newTestProfileService(t, obs, tt.imageUploadMaxSize)
+				pr := &request.ImageUploadRequestDto{
+					TelegramUserId: testTelegramUserId,
+					Filename:       "photo.png",
+					Size:           int64(len(tt.content)),
+				}
+				_, err := s.UploadImage(context.Background(), pr, bytes.NewReader(tt.content))
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("UploadImage = %v, want %v", err, tt.err)
+				}
+				if keys := listStorageKeys(t, obs); len(keys) != 0 {
+					t.Errorf("stored objects after the rejected upload = %v", keys)
+				}
+			})
+		}
+	}
+}
+
+func TestProfileServiceDeleteImage(t *testing.T) {
+	ctx := context.Background()
+	obs := storage.NewMemoryStorage("http://localhost")
+	s := newTestProfileService(t, obs, 1<<20)
+	db := newTestImageDB()
+	s.uwf = NewUnitOfWorkFactory(s.logger, db.Open())
+	imageKeys := map[uint64][]string{
+		1: {"/profiles/1/images/photo/thumbnail.webp", "/profiles/1/images/photo/medium.webp",
+			"/profiles/1/images/photo/full.webp"},
+		// An image uploaded before the variants has the only key built from its name
+		2: {"/profiles/1/images/legacy.webp"},
+		3: {"/profiles/1/images/other/full.webp"},
+	}
+	for id, keys := range imageKeys {
+		image := &testImageRow{telegramUserId: testTelegramUserId, name: "legacy.webp"}
+		if id != 2 {
+			for i, key := range keys {
+				image.variants = append(image.variants, &response.ImageVariantResponseDto{
+					ImageId: id,
+					Variant: enum.ImageVariants[i+len(enum.ImageVariants)-len(keys)],
+					Key:     key,
+					Url:     obs.Url(key),
+				})
+			}
+		}
+		db.images[id] = image
+		for _, key := range keys {
+			if err := obs.Upload(ctx, key, strings.NewReader("image"), "image/webp"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for _, id := range []uint64{1, 2} {
+		if _, err := s.DeleteImage(ctx, id); err != nil {
+			t.Fatalf("DeleteImage(%d): %v", id, err)
+		}
+		if db.Exists(id) {
+			t.Errorf("the row of the image %d exists after DeleteImage", id)
+		}
+		for _, key := range imageKeys[id] {
+			if _, ok := obs.Get(key); ok {
+				t.Errorf("the object %s exists after DeleteImage(%d)", key, id)
+			}
+		}
+	}
+	if !db.Exists(3) {
+		t.Error("the row of another image is deleted")
+	}
+	if keys := listStorageKeys(t, obs); len(keys) != 1 || keys[0] != imageKeys[3][0] {
+		t.Errorf("stored objects = %v, want the object of another image", keys)
+	}
+
+	// A missing image is not found, nothing is deleted
+	if _, err := s.DeleteImage(ctx, 4); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("DeleteImage of a missing image = %v, want %v", err, sql.ErrNoRows)
+	}
+	if !db.Exists(3) || len(listStorageKeys(t, obs)) != 1 {
+		t.Error("another image is deleted by DeleteImage of a missing image")
+	}
+}
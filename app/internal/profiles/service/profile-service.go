@@ -1,58 +1,98 @@
 package service
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/events"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/config"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/request"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/service/mapper"
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/shared/enum"
 	"github.com/h2non/bimg"
 	"github.com/pkg/errors"
 	//"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 const (
-	errorFilePath          = "internal/profiles/service/profile-service.go"
-	maxCountUserComplaints = 1
+	errorFilePath             = "internal/profiles/service/profile-service.go"
+	defaultComplaintThreshold = 3
+	maxMessageLength          = 4096
+	defaultMessageListLimit   = 20
+	maxMessageListLimit       = 100
+	defaultProfileListSize    = 20
+	likeListLimit             = 20
+	eventTopic                = "like_topic"
+	imageVariantQuality       = 80
+	imageVariantContentType   = "image/webp"
+	imageSniffLength          = 512
+)
+
+var (
+	ErrLikeNotFound              = errors.New("like not found")
+	ErrConversationNotFound      = errors.New("conversation not found")
+	ErrMessageNotAllowed         = errors.New("messages are allowed only between matched users")
+	ErrEmptyMessage              = errors.New("message text is empty or too long")
+	ErrInvalidCursor             = errors.New("invalid cursor")
+	ErrRefreshTokenInvalid       = errors.New("refresh token is invalid, expired or revoked")
+	ErrComplaintAppealNotAllowed = errors.New("only a user blocked by upheld complaints can appeal")
+	ErrComplaintAppealExists     = errors.New("appeal is already waiting for a decision")
+	ErrImageNotFound             = errors.New("image not found")
+	ErrImageBlocked              = errors.New("blocked image can't be primary")
+	ErrImageOrderInvalid         = errors.New("image order must contain every image of the user exactly once")
+	ErrImageGrantNotAllowed      = errors.New("access to private images can be granted only to matched users")
+	ErrImageContentType          = errors.New("file is not a supported image")
+	ErrImageTooLarge             = errors.New("image is too large")
 )
 
 type ProfileService struct {
-	logger                logger.Logger
-	db                    *sql.DB
-	config                *config.Config
-	hub                   *entity.Hub
-	s3                    *config.S3
-	uwf                   *UnitOfWorkFactory
-	profileRepository     ProfileRepository
-	navigatorRepository   NavigatorRepository
-	filterRepository      FilterRepository
-	telegramRepository    TelegramRepository
-	imageRepository       ImageRepository
-	imageStatusRepository ImageStatusRepository
-	likeRepository        LikeRepository
-	blockRepository       BlockRepository
-	complaintRepository   ComplaintRepository
-	statusRepository      StatusRepository
-	paymentRepository     PaymentRepository
-	settingsRepository    SettingsRepository
+	logger                 logger.Logger
+	db                     *sql.DB
+	config                 *config.Config
+	storage                ObjectStorage
+	ranker                 ProfileRanker
+	uwf                    *UnitOfWorkFactory
+	profileRepository      ProfileRepository
+	navigatorRepository    NavigatorRepository
+	filterRepository       FilterRepository
+	telegramRepository     TelegramRepository
+	imageRepository        ImageRepository
+	imageStatusRepository  ImageStatusRepository
+	likeRepository         LikeRepository
+	matchRepository        MatchRepository
+	conversationRepository ConversationRepository
+	messageRepository      MessageRepository
+	blockRepository        BlockRepository
+	complaintRepository    ComplaintRepository
+	statusRepository       StatusRepository
+	paymentRepository      PaymentRepository
+	settingsRepository     SettingsRepository
 }
 
 func NewProfileService(
 	l logger.Logger,
 	db *sql.DB,
 	cfg *config.Config,
-	h *entity.Hub,
-	s3 *config.S3,
+	obs ObjectStorage,
+	rk ProfileRanker,
 	uwf *UnitOfWorkFactory,
 	pr ProfileRepository,
 	nr NavigatorRepository,
@@ -61,207 +101,203 @@ func NewProfileService(
 	ir ImageRepository,
 	isr ImageStatusRepository,
 	lr LikeRepository,
+	mr MatchRepository,
+	cvr ConversationRepository,
+	msr MessageRepository,
 	br BlockRepository,
 	cr ComplaintRepository,
 	sr StatusRepository,
 	pa PaymentRepository,
 	str SettingsRepository) *ProfileService {
 	return &ProfileService{
-		logger:                l,
-		db:                    db,
-		config:                cfg,
-		hub:                   h,
-		s3:                    s3,
-		uwf:                   uwf,
-		profileRepository:     pr,
-		navigatorRepository:   nr,
-		telegramRepository:    tr,
-		filterRepository:      fr,
-		imageRepository:       ir,
-		imageStatusRepository: isr,
-		likeRepository:        lr,
-		blockRepository:       br,
-		complaintRepository:   cr,
-		statusRepository:      sr,
-		paymentRepository:     pa,
-		settingsRepository:    str,
+		logger:                 l,
+		db:                     db,
+		config:                 cfg,
+		storage:                obs,
+		ranker:                 rk,
+		uwf:                    uwf,
+		profileRepository:      pr,
+		navigatorRepository:    nr,
+		telegramRepository:     tr,
+		filterRepository:       fr,
+		imageRepository:        ir,
+		imageStatusRepository:  isr,
+		likeRepository:         lr,
+		matchRepository:        mr,
+		conversationRepository: cvr,
+		messageRepository:      msr,
+		blockRepository:        br,
+		complaintRepository:    cr,
+		statusRepository:       sr,
+		paymentRepository:      pa,
+		settingsRepository:     str,
 	}
 }
 
 func (s *ProfileService) AddProfile(
 	ctx context.Context, pr *request.ProfileAddRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	profileMapper := &mapper.ProfileMapper{}
-	profileRequest := profileMapper.MapToAddRequest(pr)
-	profileResponse, err := unitOfWork.ProfileRepository().Add(ctx, profileRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddProfile", "ProfileRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	statusMapper := &mapper.StatusMapper{}
-	statusRequest := statusMapper.MapToAddRequest(pr)
-	_, err = unitOfWork.StatusRepository().Add(ctx, statusRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddProfile",
-			"StatusRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	if pr.Longitude != nil && pr.Latitude != nil {
-		longitude := *pr.Longitude
-		latitude := *pr.Latitude
-		navigatorMapper := &mapper.NavigatorMapper{}
-		navigatorRequest := navigatorMapper.MapToAddRequest(pr.TelegramUserId, pr.CountryCode, pr.CountryName, pr.City,
-			longitude, latitude)
-		_, err = unitOfWork.NavigatorRepository().Add(ctx, navigatorRequest)
+	var profileResponse *response.ResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		profileMapper := &mapper.ProfileMapper{}
+		profileRequest := profileMapper.MapToAddRequest(pr)
+		addResponse, err := unitOfWork.ProfileRepository().Add(ctx, profileRequest)
 		if err != nil {
-			errorMessage := s.getErrorMessage("AddProfile",
-				"NavigatorRepository().Add")
+			errorMessage := s.getErrorMessage("AddProfile", "ProfileRepository().Add")
 			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
+			return err
 		}
-	}
-	err = s.AddImageList(ctx, unitOfWork, pr.TelegramUserId, pr.Files)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddProfile", "AddImageList")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	filterMapper := &mapper.FilterMapper{}
-	filterRequest := filterMapper.MapToAddRequest(pr)
-	_, err = unitOfWork.FilterRepository().Add(ctx, filterRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddProfile", "FilterRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	telegramMapper := &mapper.TelegramMapper{}
-	telegramRequest := telegramMapper.MapToAddRequest(pr)
-	_, err = unitOfWork.TelegramRepository().Add(ctx, telegramRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddProfile", "TelegramRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	settingsMapper := &mapper.SettingsMapper{}
-	settingsRequest := settingsMapper.MapToAddRequest(pr)
-	_, err = unitOfWork.SettingsRepository().Add(ctx, settingsRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddProfile",
-			"SettingsRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	defer func() {
+		statusMapper := &mapper.StatusMapper{}
+		statusRequest := statusMapper.MapToAddRequest(pr)
+		_, err = unitOfWork.StatusRepository().Add(ctx, statusRequest)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("AddProfile", "Rollback")
+			errorMessage := s.getErrorMessage("AddProfile",
+				"StatusRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if pr.Longitude != nil && pr.Latitude != nil {
+			longitude := *pr.Longitude
+			latitude := *pr.Latitude
+			navigatorMapper := &mapper.NavigatorMapper{}
+			navigatorRequest := navigatorMapper.MapToAddRequest(pr.TelegramUserId, pr.CountryCode, pr.CountryName, pr.City,
+				longitude, latitude)
+			_, err = unitOfWork.NavigatorRepository().Add(ctx, navigatorRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("AddProfile",
+					"NavigatorRepository().Add")
 				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
 			}
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("AddProfile", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		err = s.AddImageList(ctx, unitOfWork, pr.TelegramUserId, pr.Files)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddProfile", "AddImageList")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		filterMapper := &mapper.FilterMapper{}
+		filterRequest := filterMapper.MapToAddRequest(pr)
+		_, err = unitOfWork.FilterRepository().Add(ctx, filterRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddProfile", "FilterRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		telegramMapper := &mapper.TelegramMapper{}
+		telegramRequest := telegramMapper.MapToAddRequest(pr)
+		_, err = unitOfWork.TelegramRepository().Add(ctx, telegramRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddProfile", "TelegramRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		settingsMapper := &mapper.SettingsMapper{}
+		settingsRequest := settingsMapper.MapToAddRequest(pr)
+		_, err = unitOfWork.SettingsRepository().Add(ctx, settingsRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddProfile",
+				"SettingsRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		profileResponse = addResponse
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return profileResponse, err
+	return profileResponse, nil
 }
 
 func (s *ProfileService) UpdateProfile(
 	ctx context.Context, pr *request.ProfileUpdateRequestDto) (*response.ProfileResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile", "CheckUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	if err := s.updateImageList(ctx, unitOfWork, pr.TelegramUserId, pr.Files); err != nil {
-		return nil, err
-	}
-	if pr.Longitude != nil && pr.Latitude != nil {
-		longitude := *pr.Longitude
-		latitude := *pr.Latitude
-		navigatorMapper := &mapper.NavigatorMapper{}
-		navigatorRequest := navigatorMapper.MapToUpdateRequest(pr.TelegramUserId, pr.CountryCode, pr.CountryName,
-			pr.City, longitude, latitude)
-		_, err := unitOfWork.NavigatorRepository().Update(ctx, navigatorRequest)
+	var profileResponse *response.ProfileResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("UpdateProfile", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if err := s.updateImageList(ctx, unitOfWork, pr.TelegramUserId, pr.Files); err != nil {
+			return err
+		}
+		if pr.Longitude != nil && pr.Latitude != nil {
+			longitude := *pr.Longitude
+			latitude := *pr.Latitude
+			navigatorMapper := &mapper.NavigatorMapper{}
+			navigatorRequest := navigatorMapper.MapToUpdateRequest(pr.TelegramUserId, pr.CountryCode, pr.CountryName,
+				pr.City, longitude, latitude)
+			_, err := unitOfWork.NavigatorRepository().Update(ctx, navigatorRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("UpdateProfile",
+					"NavigatorRepository().Update")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+		}
+		filterMapper := &mapper.FilterMapper{}
+		filterRequest := filterMapper.MapProfileToUpdateRequest(pr)
+		_, err := unitOfWork.FilterRepository().Update(ctx, filterRequest)
 		if err != nil {
 			errorMessage := s.getErrorMessage("UpdateProfile",
-				"NavigatorRepository().Update")
+				"FilterRepository().Update")
 			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
+			return err
 		}
-	}
-	filterMapper := &mapper.FilterMapper{}
-	filterRequest := filterMapper.MapProfileToUpdateRequest(pr)
-	_, err := unitOfWork.FilterRepository().Update(ctx, filterRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile",
-			"FilterRepository().Update")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	telegramMapper := &mapper.TelegramMapper{}
-	telegramRequest := telegramMapper.MapToUpdateRequest(pr)
-	_, err = unitOfWork.TelegramRepository().Update(ctx, telegramRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile",
-			"telegramRepository.Update")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	settingsMapper := &mapper.SettingsMapper{}
-	settingsRequest := settingsMapper.MapToUpdateRequest(pr)
-	_, err = unitOfWork.SettingsRepository().Update(ctx, settingsRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile",
-			"SettingsRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileMapper := &mapper.ProfileMapper{}
-	profileRequest := profileMapper.MapToUpdateRequest(pr)
-	_, err = unitOfWork.ProfileRepository().Update(ctx, profileRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile",
-			"ProfileRepository().Update")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileEntity, err := unitOfWork.ProfileRepository().GetProfile(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile",
-			"ProfileRepository().Update")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	imageEntityList, err := unitOfWork.ImageRepository().SelectListByTelegramUserId(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile",
-			"ImageRepository().SelectListByTelegramUserId")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	checkPremium, err := s.CheckPremium(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile", "s.CheckPremium")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileResponse := profileMapper.MapToResponse(profileEntity, imageEntityList, checkPremium.IsPremium)
-	defer func() {
+		telegramMapper := &mapper.TelegramMapper{}
+		telegramRequest := telegramMapper.MapToUpdateRequest(pr)
+		_, err = unitOfWork.TelegramRepository().Update(ctx, telegramRequest)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("UpdateProfile", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("UpdateProfile",
+				"telegramRepository.Update")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("UpdateProfile", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		settingsMapper := &mapper.SettingsMapper{}
+		settingsRequest := settingsMapper.MapToUpdateRequest(pr)
+		_, err = unitOfWork.SettingsRepository().Update(ctx, settingsRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateProfile",
+				"SettingsRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		profileMapper := &mapper.ProfileMapper{}
+		profileRequest := profileMapper.MapToUpdateRequest(pr)
+		_, err = unitOfWork.ProfileRepository().Update(ctx, profileRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateProfile",
+				"ProfileRepository().Update")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		profileEntity, err := unitOfWork.ProfileRepository().GetProfile(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateProfile",
+				"ProfileRepository().Update")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		imageEntityList, err := unitOfWork.ImageRepository().SelectListByTelegramUserId(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateProfile",
+				"ImageRepository().SelectListByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if err := s.signPrivateImageList(ctx, imageEntityList); err != nil {
+			return err
+		}
+		checkPremium, err := s.CheckPremium(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateProfile", "s.CheckPremium")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		profileResponse = profileMapper.MapToResponse(profileEntity, imageEntityList, checkPremium.IsPremium)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 	return profileResponse, nil
@@ -269,139 +305,124 @@ func (s *ProfileService) UpdateProfile(
 
 func (s *ProfileService) FreezeProfile(
 	ctx context.Context, pr *request.ProfileFreezeRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("FreezeProfile", "CheckUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	updateLastOnlineMapper := &mapper.ProfileUpdateLastOnlineMapper{}
-	updateLastOnlineRequest := updateLastOnlineMapper.MapToAddRequest(pr.TelegramUserId)
-	err := unitOfWork.ProfileRepository().UpdateLastOnline(ctx, updateLastOnlineRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("FreezeProfile",
-			"ProfileRepository().UpdateLastOnline")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	_, err = unitOfWork.StatusRepository().Freeze(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("FreezeProfile",
-			"StatusRepository().Freeze")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileResponse := &response.ResponseDto{
-		Success: true,
-	}
-	defer func() {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("FreezeProfile", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		updateLastOnlineMapper := &mapper.ProfileUpdateLastOnlineMapper{}
+		updateLastOnlineRequest := updateLastOnlineMapper.MapToAddRequest(pr.TelegramUserId)
+		err := unitOfWork.ProfileRepository().UpdateLastOnline(ctx, updateLastOnlineRequest)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("FreezeProfile", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("FreezeProfile",
+				"ProfileRepository().UpdateLastOnline")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("FreezeProfile", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		_, err = unitOfWork.StatusRepository().Freeze(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("FreezeProfile",
+				"StatusRepository().Freeze")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return profileResponse, err
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
 }
 
 func (s *ProfileService) RestoreProfile(
 	ctx context.Context, pr *request.ProfileRestoreRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("RestoreProfile", "CheckUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	_, err := unitOfWork.StatusRepository().Restore(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("RestoreProfile",
-			"StatusRepository().Restore")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileResponse := &response.ResponseDto{
-		Success: true,
-	}
-	defer func() {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("RestoreProfile", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err := unitOfWork.StatusRepository().Restore(ctx, pr.TelegramUserId)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("RestoreProfile", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("RestoreProfile",
+				"StatusRepository().Restore")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("RestoreProfile", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return profileResponse, err
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
 }
 
 func (s *ProfileService) DeleteProfile(
 	ctx context.Context, pr *request.ProfileDeleteRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile", "CheckUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	if err := s.deleteImageListByS3(ctx, unitOfWork, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile", "deleteImageListByS3")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	_, err := unitOfWork.ProfileRepository().Delete(ctx, pr)
-	if err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile",
-			"ProfileRepository().Delete")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	_, err = unitOfWork.BlockRepository().DeleteRelatedProfiles(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile",
-			"BlockRepository().DeleteRelatedProfiles")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	_, err = unitOfWork.ComplaintRepository().DeleteRelatedProfiles(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile",
-			"ComplaintRepository().DeleteRelatedProfiles")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	_, err = unitOfWork.LikeRepository().DeleteRelatedProfiles(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile",
-			"LikeRepository().DeleteRelatedProfiles")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileResponse := &response.ResponseDto{
-		Success: true,
-	}
-	defer func() {
+	var imageKeys []string
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("DeleteProfile", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		keys, err := s.selectImageKeyList(ctx, unitOfWork, pr.TelegramUserId)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("DeleteProfile", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("DeleteProfile", "selectImageKeyList")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("DeleteProfile", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		imageKeys = keys
+		_, err = unitOfWork.ProfileRepository().Delete(ctx, pr)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteProfile",
+				"ProfileRepository().Delete")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err = unitOfWork.BlockRepository().DeleteRelatedProfiles(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteProfile",
+				"BlockRepository().DeleteRelatedProfiles")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err = unitOfWork.ComplaintRepository().DeleteRelatedProfiles(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteProfile",
+				"ComplaintRepository().DeleteRelatedProfiles")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err = unitOfWork.LikeRepository().DeleteRelatedProfiles(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteProfile",
+				"LikeRepository().DeleteRelatedProfiles")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err = unitOfWork.RefreshTokenRepository().RevokeListByTelegramUserId(
+			ctx, pr.TelegramUserId, time.Now().UTC())
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteProfile",
+				"RefreshTokenRepository().RevokeListByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return profileResponse, err
+	s.deleteImageObjectList(ctx, imageKeys)
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
 }
 
 func (s *ProfileService) GetProfile(ctx context.Context, telegramUserId string,
@@ -433,6 +454,9 @@ func (s *ProfileService) GetProfile(ctx context.Context, telegramUserId string,
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
+	if err := s.signPrivateImageList(ctx, imageList); err != nil {
+		return nil, err
+	}
 	checkPremium, err := s.CheckPremium(ctx, telegramUserId)
 	if err != nil {
 		errorMessage := s.getErrorMessage("GetProfile", "s.CheckPremium")
@@ -467,13 +491,17 @@ func (s *ProfileService) GetProfileDetail(ctx context.Context, viewedTelegramUse
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	imageEntityList, err := s.imageRepository.SelectListByTelegramUserId(ctx, viewedTelegramUserId)
+	imageEntityList, err := s.imageRepository.SelectListVisibleByTelegramUserId(ctx, viewedTelegramUserId,
+		telegramUserId)
 	if err != nil {
 		errorMessage := s.getErrorMessage("GetProfileDetail",
-			"imageRepository.SelectListByTelegramUserId")
+			"imageRepository.SelectListVisibleByTelegramUserId")
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
+	if err := s.signPrivateImageList(ctx, imageEntityList); err != nil {
+		return nil, err
+	}
 	checkPremium, err := s.CheckPremium(ctx, viewedTelegramUserId)
 	if err != nil {
 		errorMessage := s.getErrorMessage("GetProfileDetail", "s.CheckPremium")
@@ -507,10 +535,14 @@ func (s *ProfileService) GetProfileShortInfo(
 
 func (s *ProfileService) GetProfileList(ctx context.Context,
 	pr *request.ProfileGetListRequestDto) (*response.ProfileListResponseDto, error) {
+	cursor, err := s.decodeProfileCursor(pr.Cursor)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
 		return nil, err
 	}
-	err := s.updateLastOnline(ctx, pr.TelegramUserId)
+	err = s.updateLastOnline(ctx, pr.TelegramUserId)
 	if err != nil {
 		errorMessage := s.getErrorMessage("GetProfileList", "updateLastOnline")
 		s.logger.Debug(errorMessage, zap.Error(err))
@@ -527,7 +559,6 @@ func (s *ProfileService) GetProfileList(ctx context.Context,
 		}
 	}
 
-	var paginationProfileEntityList *response.ProfileListResponseRepositoryDto
 	filterEntity, err := s.filterRepository.FindByTelegramUserId(ctx, pr.TelegramUserId)
 	if err != nil {
 		errorMessage := s.getErrorMessage("GetProfileList",
@@ -535,21 +566,155 @@ func (s *ProfileService) GetProfileList(ctx context.Context,
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	profileMapper := &mapper.ProfileMapper{}
-	profileRequest := profileMapper.MapToListRequest(pr, filterEntity)
-	paginationProfileEntityList, err = s.profileRepository.SelectList(ctx, profileRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("GetProfileList",
-			"profileRepository.SelectList")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	profileListResponse := &response.ProfileListResponseDto{
-		PaginationEntity: paginationProfileEntityList.PaginationEntity,
-		Content:          paginationProfileEntityList.Content,
+	size := filterEntity.Size
+	if size == 0 {
+		size = defaultProfileListSize
 	}
-	return profileListResponse, err
-}
+	// The first page ranks the nearest candidates and stores the ranked list as a new snapshot of the feed,
+	// the next pages are read from the snapshot by the cursor, so neither the new candidates nor the changed
+	// scores shift the pages. Every first page has its own snapshot, so the feeds opened on other devices
+	// stay valid until the snapshots expire
+	var candidateTelegramUserIds []string
+	if cursor == nil {
+		candidateTelegramUserIds, err = s.rankProfileList(ctx, pr, filterEntity)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetProfileList", "rankProfileList")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+	}
+	now := time.Now().UTC()
+	expiredBefore := now.Add(-s.config.ProfileListSnapshotTtl)
+	var snapshotEntity *entity.ProfileListSnapshotEntity
+	offset := uint64(0)
+	err = s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		snapshotId := uint64(0)
+		if cursor == nil {
+			_, err := unitOfWork.ProfileListSnapshotRepository().DeleteExpired(ctx, expiredBefore)
+			if err != nil {
+				errorMessage := s.getErrorMessage("GetProfileList", "ProfileListSnapshotRepository().DeleteExpired")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			snapshotRequest := &request.ProfileListSnapshotAddRequestRepositoryDto{
+				TelegramUserId:           pr.TelegramUserId,
+				CandidateTelegramUserIds: candidateTelegramUserIds,
+				CreatedAt:                now,
+			}
+			snapshotId, err = unitOfWork.ProfileListSnapshotRepository().Add(ctx, snapshotRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("GetProfileList", "ProfileListSnapshotRepository().Add")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+		} else {
+			snapshotId = cursor.SnapshotId
+			offset = cursor.Position
+		}
+		var err error
+		snapshotEntity, err = unitOfWork.ProfileListSnapshotRepository().FindPage(
+			ctx, snapshotId, pr.TelegramUserId, expiredBefore, offset, size)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetProfileList", "ProfileListSnapshotRepository().FindPage")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		// The cursors of the expired snapshots are invalid, the client starts the feed from the first page
+		if snapshotEntity == nil {
+			return ErrInvalidCursor
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	content := make([]*response.ProfileListItemResponseDto, 0, len(snapshotEntity.CandidateTelegramUserIds))
+	if len(snapshotEntity.CandidateTelegramUserIds) > 0 {
+		content, err = s.profileRepository.SelectListByTelegramUserIds(
+			ctx, pr.TelegramUserId, snapshotEntity.CandidateTelegramUserIds)
+		if err != nil {
+			errorMessage := s.getErrorMessage("GetProfileList",
+				"profileRepository.SelectListByTelegramUserIds")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return nil, err
+		}
+	}
+	position := offset + uint64(len(snapshotEntity.CandidateTelegramUserIds))
+	hasNext := position < snapshotEntity.TotalEntities
+	nextCursor := ""
+	if hasNext {
+		nextCursor = s.encodeProfileCursor(&entity.ProfileCursorEntity{
+			SnapshotId: snapshotEntity.Id,
+			Position:   position,
+		})
+	}
+	profileListResponse := &response.ProfileListResponseDto{
+		Content:            content,
+		NextCursor:         nextCursor,
+		HasNext:            hasNext,
+		TotalEntities:      snapshotEntity.TotalEntities,
+		IsTotalApproximate: snapshotEntity.TotalEntities >= s.config.RankingCandidateLimit,
+	}
+	return profileListResponse, nil
+}
+
+// rankProfileList - selects the nearest candidates of the discovery feed of the viewer and returns
+// their telegramUserIds in the ranked order
+func (s *ProfileService) rankProfileList(ctx context.Context, pr *request.ProfileGetListRequestDto,
+	filterEntity *entity.FilterEntity) ([]string, error) {
+	profileMapper := &mapper.ProfileMapper{}
+	profileRequest := profileMapper.MapToListRequest(pr, filterEntity, s.config.RankingCandidateLimit)
+	profileList, err := s.profileRepository.SelectList(ctx, profileRequest)
+	if err != nil {
+		errorMessage := s.getErrorMessage("rankProfileList", "profileRepository.SelectList")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	viewer := &entity.RankingViewerEntity{
+		TelegramUserId: pr.TelegramUserId,
+		Distance:       filterEntity.Distance,
+	}
+	candidates := profileList.Content
+	s.ranker.Rank(viewer, candidates, time.Now().UTC())
+	telegramUserIds := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		telegramUserIds = append(telegramUserIds, c.TelegramUserId)
+	}
+	return telegramUserIds, nil
+}
+
+// encodeProfileCursor - the cursor is opaque for clients, it holds the snapshot of the feed
+// and the position of the next page in it
+func (s *ProfileService) encodeProfileCursor(c *entity.ProfileCursorEntity) string {
+	cursor := strconv.FormatUint(c.SnapshotId, 10) + ":" + strconv.FormatUint(c.Position, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func (s *ProfileService) decodeProfileCursor(cursor string) (*entity.ProfileCursorEntity, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+	snapshotId, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	position, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &entity.ProfileCursorEntity{
+		SnapshotId: snapshotId,
+		Position:   position,
+	}, nil
+}
 
 func (s *ProfileService) AddImageList(
 	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string, files []*entity.FileMetadata) error {
@@ -574,15 +739,87 @@ func (s *ProfileService) AddImage(ctx context.Context, unitOfWork *UnitOfWork, t
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	imageId, err := unitOfWork.ImageRepository().Add(ctx, imageConverted)
+	if _, err := s.addImageConverted(ctx, unitOfWork, imageConverted); err != nil {
+		errorMessage := s.getErrorMessage("AddImage", "addImageConverted")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return imageResponse, nil
+}
+
+// UploadImage - adds the image streamed by the client. The type of the content is checked by its first bytes
+// before anything is stored, and the content is written to the disk by chunks, so the whole file is never
+// held in memory until the conversion
+func (s *ProfileService) UploadImage(ctx context.Context, pr *request.ImageUploadRequestDto,
+	content io.Reader) (*response.ImageResponseDto, error) {
+	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
+		errorMessage := s.getErrorMessage("UploadImage", "CheckProfileExists")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	reader := bufio.NewReaderSize(content, imageSniffLength)
+	head, err := reader.Peek(imageSniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		errorMessage := s.getErrorMessage("UploadImage", "Peek")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	if err := s.validateImageContent(head); err != nil {
+		return nil, err
+	}
+	filePath, fileName, contentHash, err := s.writeImageToFileSystem(pr.Filename, reader)
+	if err != nil {
+		errorMessage := s.getErrorMessage("UploadImage", "writeImageToFileSystem")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	imageConverted, err := s.convertUploadedImage(ctx, pr.TelegramUserId, filePath, fileName, contentHash)
+	if err != nil {
+		errorMessage := s.getErrorMessage("UploadImage", "convertUploadedImage")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	var imageId uint64
+	err = s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		imageId, err = s.addImageConverted(ctx, unitOfWork, imageConverted)
+		return err
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("AddImage", "ImageRepository().Add")
+		errorMessage := s.getErrorMessage("UploadImage", "addImageConverted")
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
+	return s.GetImageById(ctx, imageId)
+}
+
+// addImageConverted - stores the converted image with its variants, the image waits for the moderation
+func (s *ProfileService) addImageConverted(ctx context.Context, unitOfWork *UnitOfWork,
+	imageConverted *request.ImageAddRequestRepositoryDto) (uint64, error) {
+	imageId, err := unitOfWork.ImageRepository().Add(ctx, imageConverted)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addImageConverted", "ImageRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	for _, variant := range imageConverted.Variants {
+		variant.ImageId = imageId
+		if _, err := unitOfWork.ImageVariantRepository().Add(ctx, variant); err != nil {
+			errorMessage := s.getErrorMessage("addImageConverted", "ImageVariantRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return 0, err
+		}
+	}
 	imageStatusMapper := &mapper.ImageStatusMapper{}
 	imageStatusRequest := imageStatusMapper.MapToAddRequest(imageId)
-	return unitOfWork.ImageStatusRepository().Add(ctx, imageStatusRequest)
+	if _, err := unitOfWork.ImageStatusRepository().Add(ctx, imageStatusRequest); err != nil {
+		errorMessage := s.getErrorMessage("addImageConverted", "ImageStatusRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return imageId, nil
 }
 
 func (s *ProfileService) GetImageByTelegramUserId(ctx context.Context, telegramUserId, fileName string) ([]byte, error) {
@@ -623,93 +860,340 @@ func (s *ProfileService) updateImageList(
 	return s.AddImageList(ctx, unitOfWork, telegramUserId, files)
 }
 
-func (s *ProfileService) deleteImageListByS3(ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string) error {
-	imageList, err := s.imageRepository.SelectListAllByTelegramUserId(ctx, telegramUserId)
+// selectImageKeyList - returns the storage keys of all the images of the user
+func (s *ProfileService) selectImageKeyList(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string) ([]string, error) {
+	imageList, err := unitOfWork.ImageRepository().SelectListAllByTelegramUserId(ctx, telegramUserId)
 	if err != nil {
-		errorMessage := s.getErrorMessage("deleteImageListByS3",
-			"imageRepository.SelectListAllByTelegramUserId")
+		errorMessage := s.getErrorMessage("selectImageKeyList",
+			"ImageRepository().SelectListAllByTelegramUserId")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return err
+		return nil, err
+	}
+	keys := make([]string, 0)
+	for _, image := range imageList {
+		keys = append(keys, s.getImageKeyList(image.TelegramUserId, image.Name, image.Variants)...)
 	}
-	if len(imageList) > 0 {
+	return keys, nil
+}
+
+// getImageKeyList - the keys of the variants of the image, an image uploaded before the variants
+// has the only key built from its name
+func (s *ProfileService) getImageKeyList(
+	telegramUserId, name string, variants []*response.ImageVariantResponseDto) []string {
+	keys := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		keys = append(keys, variant.Key)
+	}
+	if len(keys) == 0 {
+		keys = append(keys, fmt.Sprintf("/profiles/%s/images/%s", telegramUserId, name))
+	}
+	return keys
+}
+
+// deleteImageObjectList - deletes the files of the images after their rows are deleted. A file that is not deleted
+// is only logged: it has no image anymore and is removed by the storage reconciliation
+func (s *ProfileService) deleteImageObjectList(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			errorMessage := s.getErrorMessage("deleteImageObjectList", "storage.Delete")
+			s.logger.Debug(errorMessage, zap.Error(err), zap.String("key", key))
+		}
+	}
+}
+
+func (s *ProfileService) deleteImageByDB(
+	ctx context.Context, unitOfWork *UnitOfWork, id uint64) (*response.ResponseDto, error) {
+	return unitOfWork.ImageRepository().Delete(ctx, id)
+}
+
+// DeleteImage - the row of the image is deleted first and its files only after the commit,
+// so a failure never leaves an image whose files are already gone
+func (s *ProfileService) DeleteImage(
+	ctx context.Context, id uint64) (*response.ResponseDto, error) {
+	var keys []string
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		image, err := unitOfWork.ImageRepository().FindById(ctx, id)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteImage", "ImageRepository().FindById")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		keys = s.getImageKeyList(image.TelegramUserId, image.Name, image.Variants)
+		_, err = s.deleteImageByDB(ctx, unitOfWork, id)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteImage", "deleteImageByDB")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.deleteImageObjectList(ctx, keys)
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+// SetPrimaryImage - makes the image the avatar of the user and moves it to the first position
+func (s *ProfileService) SetPrimaryImage(
+	ctx context.Context, pr *request.ImageSetPrimaryRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		imageList, err := unitOfWork.ImageRepository().SelectListDetailByTelegramUserId(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("SetPrimaryImage", "ImageRepository().SelectListDetailByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		imageIds := make([]uint64, 0, len(imageList))
+		imageIds = append(imageIds, pr.ImageId)
+		isFound := false
 		for _, image := range imageList {
-			_, err := s.deleteImageByS3(ctx, unitOfWork, image.Id)
-			if err != nil {
-				errorMessage := s.getErrorMessage("deleteImageListByS3",
-					"deleteImageByS3")
-				s.logger.Debug(errorMessage, zap.Error(err))
-				return err
+			if image.Id != pr.ImageId {
+				imageIds = append(imageIds, image.Id)
+				continue
+			}
+			if image.IsBlocked {
+				return ErrImageBlocked
 			}
+			isFound = true
 		}
+		if !isFound {
+			return ErrImageNotFound
+		}
+		return s.updateImageOrder(ctx, unitOfWork, imageIds)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
 }
 
-func (s *ProfileService) deleteImageByS3(
-	ctx context.Context, unitOfWork *UnitOfWork, id uint64) (*response.ResponseDto, error) {
-	image, err := s.imageRepository.FindById(ctx, id)
+// ReorderImages - stores the order of all the images of the user, the first image becomes the avatar
+func (s *ProfileService) ReorderImages(
+	ctx context.Context, pr *request.ImageReorderRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		imageList, err := unitOfWork.ImageRepository().SelectListDetailByTelegramUserId(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("ReorderImages", "ImageRepository().SelectListDetailByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if len(pr.ImageIds) != len(imageList) {
+			return ErrImageOrderInvalid
+		}
+		imageMap := make(map[uint64]*response.ImageResponseRepositoryDto, len(imageList))
+		for _, image := range imageList {
+			imageMap[image.Id] = image
+		}
+		for i, imageId := range pr.ImageIds {
+			image, ok := imageMap[imageId]
+			if !ok {
+				return ErrImageOrderInvalid
+			}
+			if i == 0 && image.IsBlocked {
+				return ErrImageBlocked
+			}
+			// Every image is taken once, so a repeated id is rejected
+			delete(imageMap, imageId)
+		}
+		return s.updateImageOrder(ctx, unitOfWork, pr.ImageIds)
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("deleteImageByS3",
-			"imageRepository.FindById")
-		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	// Удаление из файловой системы
-	//filePath := image.Url
-	//if err := os.Remove(filePath); err != nil {
-	//	errorMessage := s.getErrorMessage("DeleteImage", "Remove")
-	//	s.logger.Debug(errorMessage, zap.Error(err))
-	//	return nil, err
-	//}
-	// Удаление из S3 хранилища
-	pathToS3 := fmt.Sprintf("/profiles/%s/images/%s", image.TelegramUserId, image.Name)
-	if err := s.s3.Delete(pathToS3); err != nil {
-		errorMessage := s.getErrorMessage("deleteImageByS3", "s.s3.Delete")
-		s.logger.Debug(errorMessage, zap.Error(err))
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+// UpdateImagePrivacy - hides the image from the viewers without a grant or shows it to everyone
+func (s *ProfileService) UpdateImagePrivacy(
+	ctx context.Context, pr *request.ImageUpdatePrivacyRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		image, err := unitOfWork.ImageRepository().FindById(ctx, pr.ImageId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrImageNotFound
+			}
+			errorMessage := s.getErrorMessage("UpdateImagePrivacy", "ImageRepository().FindById")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if image.TelegramUserId != pr.TelegramUserId {
+			return ErrImageNotFound
+		}
+		_, err = unitOfWork.ImageStatusRepository().UpdatePrivate(ctx, pr.ImageId, pr.IsPrivate)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateImagePrivacy", "ImageStatusRepository().UpdatePrivate")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return s.updateImageAccess(ctx, unitOfWork, image, pr.IsPrivate)
+	})
+	if err != nil {
 		return nil, err
 	}
-	imageResponse := &response.ResponseDto{
+	return &response.ResponseDto{
 		Success: true,
+	}, nil
+}
+
+// updateImageAccess - a private image isn't readable by its public urls: its objects are made private
+// and the urls are cleared, the owner and the viewers with a grant get the presigned urls instead,
+// see signPrivateImageList. The full size variant is the last one and the url of the image
+func (s *ProfileService) updateImageAccess(ctx context.Context, unitOfWork *UnitOfWork,
+	image *response.ImageResponseRepositoryDto, isPrivate bool) error {
+	for _, variant := range image.Variants {
+		url := ""
+		if !isPrivate {
+			url = s.storage.Url(variant.Key)
+		}
+		if _, err := unitOfWork.ImageVariantRepository().UpdateUrlByKey(ctx, variant.Key, url); err != nil {
+			errorMessage := s.getErrorMessage("updateImageAccess", "ImageVariantRepository().UpdateUrlByKey")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
 	}
-	return imageResponse, nil
+	keys := s.getImageKeyList(image.TelegramUserId, image.Name, image.Variants)
+	url := ""
+	if !isPrivate {
+		url = s.storage.Url(keys[len(keys)-1])
+	}
+	if _, err := unitOfWork.ImageRepository().UpdateUrl(ctx, image.Id, url); err != nil {
+		errorMessage := s.getErrorMessage("updateImageAccess", "ImageRepository().UpdateUrl")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	for _, key := range keys {
+		if err := s.storage.SetPublic(ctx, key, !isPrivate); err != nil {
+			errorMessage := s.getErrorMessage("updateImageAccess", "storage.SetPublic")
+			s.logger.Debug(errorMessage, zap.Error(err), zap.String("key", key))
+			return err
+		}
+	}
+	return nil
 }
-func (s *ProfileService) deleteImageByDB(
-	ctx context.Context, unitOfWork *UnitOfWork, id uint64) (*response.ResponseDto, error) {
-	return unitOfWork.ImageRepository().Delete(ctx, id)
+
+// signPrivateImageList - replaces the urls of the private images with the presigned ones,
+// they expire after ImagePrivateUrlTtl, so a revoked grant stops working soon
+func (s *ProfileService) signPrivateImageList(ctx context.Context, imageList []*response.ImageResponseDto) error {
+	for _, image := range imageList {
+		if !image.IsPrivate {
+			continue
+		}
+		for _, variant := range image.Variants {
+			url, err := s.storage.PresignGetUrl(ctx, variant.Key, s.config.ImagePrivateUrlTtl)
+			if err != nil {
+				errorMessage := s.getErrorMessage("signPrivateImageList", "storage.PresignGetUrl")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			variant.Url = url
+		}
+		keys := s.getImageKeyList(image.TelegramUserId, image.Name, image.Variants)
+		url, err := s.storage.PresignGetUrl(ctx, keys[len(keys)-1], s.config.ImagePrivateUrlTtl)
+		if err != nil {
+			errorMessage := s.getErrorMessage("signPrivateImageList", "storage.PresignGetUrl")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		image.Url = url
+	}
+	return nil
 }
 
-func (s *ProfileService) DeleteImage(
-	ctx context.Context, id uint64) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	_, err := s.deleteImageByS3(ctx, unitOfWork, id)
+// AddImageGrant - shares the private images of the user with the viewer, only a match can be a viewer
+func (s *ProfileService) AddImageGrant(
+	ctx context.Context, pr *request.ImageGrantRequestDto) (*response.ResponseDto, error) {
+	if pr.TelegramUserId == pr.ViewerTelegramUserId {
+		return nil, ErrImageGrantNotAllowed
+	}
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		matchEntity, err := unitOfWork.MatchRepository().FindMatch(ctx, pr.TelegramUserId, pr.ViewerTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddImageGrant", "MatchRepository().FindMatch")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if matchEntity == nil {
+			return ErrImageGrantNotAllowed
+		}
+		_, err = unitOfWork.ImageGrantRepository().Add(ctx, pr.TelegramUserId, pr.ViewerTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddImageGrant", "ImageGrantRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("DeleteImage", "deleteImageByS3")
-		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	_, err = s.deleteImageByDB(ctx, unitOfWork, id)
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+func (s *ProfileService) DeleteImageGrant(
+	ctx context.Context, pr *request.ImageGrantRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		_, err := unitOfWork.ImageGrantRepository().Delete(ctx, pr.TelegramUserId, pr.ViewerTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("DeleteImageGrant", "ImageGrantRepository().Delete")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("DeleteImage", "deleteImageByDB")
-		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	responseDto := &response.ResponseDto{
+	return &response.ResponseDto{
 		Success: true,
-	}
-	defer func() {
+	}, nil
+}
+
+func (s *ProfileService) GetImageGrantList(
+	ctx context.Context, telegramUserId string) (*response.ImageGrantListResponseDto, error) {
+	var grantList []*entity.ImageGrantEntity
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		var err error
+		grantList, err = unitOfWork.ImageGrantRepository().SelectListByTelegramUserId(ctx, telegramUserId)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("DeleteImage", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("GetImageGrantList", "ImageGrantRepository().SelectListByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("DeleteImage", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return responseDto, err
+	return &response.ImageGrantListResponseDto{
+		Content: grantList,
+	}, nil
+}
+
+// updateImageOrder - numbers the images in the given order and marks the first one as primary
+func (s *ProfileService) updateImageOrder(ctx context.Context, unitOfWork *UnitOfWork, imageIds []uint64) error {
+	for i, imageId := range imageIds {
+		if _, err := unitOfWork.ImageRepository().UpdatePosition(ctx, imageId, uint64(i)); err != nil {
+			errorMessage := s.getErrorMessage("updateImageOrder", "ImageRepository().UpdatePosition")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if _, err := unitOfWork.ImageStatusRepository().UpdatePrimary(ctx, imageId, i == 0); err != nil {
+			errorMessage := s.getErrorMessage("updateImageOrder", "ImageStatusRepository().UpdatePrimary")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *ProfileService) GetFilter(ctx context.Context, telegramUserId string) (*response.FilterResponseDto, error) {
@@ -727,30 +1211,23 @@ func (s *ProfileService) GetFilter(ctx context.Context, telegramUserId string) (
 
 func (s *ProfileService) UpdateFilter(
 	ctx context.Context, req *request.FilterUpdateRequestDto) (*response.FilterResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	filterMapper := &mapper.FilterMapper{}
-	filterRequest := filterMapper.MapToUpdateRequest(req)
-	filterEntity, err := unitOfWork.FilterRepository().Update(ctx, filterRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateFilter", "filterRepository.Update")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	filterResponse := filterMapper.MapToResponse(filterEntity)
-	defer func() {
+	var filterResponse *response.FilterResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		filterMapper := &mapper.FilterMapper{}
+		filterRequest := filterMapper.MapToUpdateRequest(req)
+		filterEntity, err := unitOfWork.FilterRepository().Update(ctx, filterRequest)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("UpdateFilter", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("UpdateFilter", "filterRepository.Update")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("UpdateFilter", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		filterResponse = filterMapper.MapToResponse(filterEntity)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return filterResponse, err
+	return filterResponse, nil
 }
 
 func (s *ProfileService) GetTelegram(
@@ -772,97 +1249,146 @@ func (s *ProfileService) removeStrSpaces(str string) string {
 
 func (s *ProfileService) uploadImageToFileSystem(ctx context.Context, file *entity.FileMetadata,
 	telegramUserId string) (*request.ImageAddRequestRepositoryDto, error) {
-	directoryPath := fmt.Sprintf("static/profiles/%s/images", telegramUserId)
-	if _, err := os.Stat(directoryPath); os.IsNotExist(err) {
-		if err := os.MkdirAll(directoryPath, 0755); err != nil {
-			errorMessage := s.getErrorMessage("uploadImageToFileSystem", "MkdirAll")
-			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
-		}
-	}
-	filenameWithoutSpaces := s.removeStrSpaces(file.Filename)
-	filePath := fmt.Sprintf("%s/%s", directoryPath, filenameWithoutSpaces)
-	if err := os.WriteFile(filePath, file.Content, 0666); err != nil {
-		errorMessage := s.getErrorMessage("uploadImageToFileSystem", "WriteFile")
-		s.logger.Debug(errorMessage, zap.Error(err))
+	if err := s.validateImageContent(file.Content); err != nil {
 		return nil, err
 	}
-	newFileName, newFilePath, newFileSize, err := s.convertImage(telegramUserId, directoryPath, filePath, filenameWithoutSpaces)
+	filePath, fileName, contentHash, err := s.writeImageToFileSystem(file.Filename, bytes.NewReader(file.Content))
 	if err != nil {
-		errorMessage := s.getErrorMessage("uploadImageToFileSystem", "convertImage")
+		errorMessage := s.getErrorMessage("uploadImageToFileSystem", "writeImageToFileSystem")
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	imageConverted := &request.ImageAddRequestRepositoryDto{
-		TelegramUserId: telegramUserId,
-		Name:           newFileName,
-		Url:            newFilePath,
-		Size:           newFileSize,
-		CreatedAt:      time.Now().UTC(),
-		UpdatedAt:      time.Now().UTC(),
+	return s.convertUploadedImage(ctx, telegramUserId, filePath, fileName, contentHash)
+}
+
+// validateImageContent - checks by the first bytes of the file that it is an image the conversion supports
+func (s *ProfileService) validateImageContent(head []byte) error {
+	switch http.DetectContentType(head) {
+	case "image/jpeg", "image/png", "image/gif", "image/webp", "image/bmp":
+		return nil
 	}
-	return imageConverted, nil
+	return ErrImageContentType
 }
 
-func (s *ProfileService) convertImage(telegramUserId, directoryPath, filePath, fileName string) (string, string, int64, error) {
-	newFileName := s.replaceFileName(fileName)
-	newFilePathLocal := fmt.Sprintf("%s/%s", directoryPath, newFileName)
-	output, err := os.Create(directoryPath + "/" + newFileName)
+// writeImageToFileSystem - copies the content to a temporary file of its own by chunks and computes its hash
+// on the way. The hash is compared with the blocklist by the moderation
+func (s *ProfileService) writeImageToFileSystem(filename string, content io.Reader) (string, string, string, error) {
+	filenameWithoutSpaces := s.removeStrSpaces(filepath.Base(filename))
+	f, err := os.CreateTemp("", "profile-image-*")
 	if err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "Create")
+		errorMessage := s.getErrorMessage("writeImageToFileSystem", "CreateTemp")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+		return "", "", "", err
 	}
-	defer output.Close()
-	buffer, err := bimg.Read(filePath)
-	if err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "Read")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+	filePath := f.Name()
+	hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(f, hash), io.LimitReader(content, s.config.ImageUploadMaxSize+1))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && written > s.config.ImageUploadMaxSize {
+		err = ErrImageTooLarge
 	}
-	newFile, err := bimg.NewImage(buffer).Convert(bimg.WEBP)
 	if err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "NewImage")
+		errorMessage := s.getErrorMessage("writeImageToFileSystem", "Copy")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+		_ = s.deleteFile(filePath)
+		return "", "", "", err
 	}
-	bimg.Write(newFilePathLocal, newFile)
-	newFileInfo, err := os.Stat(newFilePathLocal)
+	return filePath, filenameWithoutSpaces, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (s *ProfileService) convertUploadedImage(ctx context.Context,
+	telegramUserId, filePath, fileName, contentHash string) (*request.ImageAddRequestRepositoryDto, error) {
+	defer func() {
+		if err := s.deleteFile(filePath); err != nil {
+			errorMessage := s.getErrorMessage("convertUploadedImage", "deleteFile")
+			s.logger.Debug(errorMessage, zap.Error(err))
+		}
+	}()
+	newFileName, variants, err := s.convertImage(ctx, telegramUserId, filePath, fileName)
 	if err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "Stat")
+		errorMessage := s.getErrorMessage("convertUploadedImage", "convertImage")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+		return nil, err
 	}
-	newFileSize := newFileInfo.Size()
-	// S3 storage
-	f, err := os.Open(newFilePathLocal)
-	if err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "os.Open")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+	full := variants[len(variants)-1]
+	imageConverted := &request.ImageAddRequestRepositoryDto{
+		TelegramUserId: telegramUserId,
+		Name:           newFileName,
+		Url:            full.Url,
+		Size:           full.Size,
+		ContentHash:    contentHash,
+		Variants:       variants,
+		CreatedAt:      time.Now().UTC(),
+		UpdatedAt:      time.Now().UTC(),
 	}
-	defer f.Close()
-	pathToS3 := fmt.Sprintf("/profiles/%s/images/%s", telegramUserId, newFileName)
-	result, err := s.s3.Upload(pathToS3, f)
+	return imageConverted, nil
+}
+
+// convertImage - converts the uploaded image to the WebP variants from enum.ImageVariants without the metadata
+// (EXIF, GPS) and uploads them to the storage by the key /profiles/{telegramUserId}/images/{name}/{variant}.webp.
+// The variants are returned in the order of enum.ImageVariants, so the last one is the full size image
+func (s *ProfileService) convertImage(ctx context.Context,
+	telegramUserId, filePath, fileName string) (string, []*request.ImageVariantAddRequestRepositoryDto, error) {
+	newFileName := s.replaceFileName(fileName)
+	buffer, err := bimg.Read(filePath)
 	if err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "s.s3.Upload")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
-	}
-	s.logger.Info(fmt.Sprintf("file uploaded to, %s", aws.StringValue(&result.Location)))
-	newFilePath := fmt.Sprintf("%s%s", s.config.S3BucketPublicDomain, pathToS3)
-	//
-	if err := s.deleteFile(filePath); err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "deleteFile")
+		errorMessage := s.getErrorMessage("convertImage", "Read")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+		return "", nil, err
 	}
-	if err := os.RemoveAll("static/profiles"); err != nil {
-		errorMessage := s.getErrorMessage("convertImage", "os.RemoveAll")
+	originalSize, err := bimg.NewImage(buffer).Size()
+	if err != nil {
+		errorMessage := s.getErrorMessage("convertImage", "Size")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return "", "", 0, err
+		return "", nil, err
 	}
-	return newFileName, newFilePath, newFileSize, nil
+	variants := make([]*request.ImageVariantAddRequestRepositoryDto, 0, len(enum.ImageVariants))
+	for _, variant := range enum.ImageVariants {
+		width := variant.MaxWidth()
+		if originalSize.Width < width {
+			width = originalSize.Width
+		}
+		newFile, err := bimg.NewImage(buffer).Process(bimg.Options{
+			Width:         width,
+			Type:          bimg.WEBP,
+			Quality:       imageVariantQuality,
+			StripMetadata: true,
+		})
+		if err != nil {
+			errorMessage := s.getErrorMessage("convertImage", "Process")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return "", nil, err
+		}
+		newFileSize, err := bimg.NewImage(newFile).Size()
+		if err != nil {
+			errorMessage := s.getErrorMessage("convertImage", "Size")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return "", nil, err
+		}
+		pathToS3 := s.getImageVariantKey(telegramUserId, newFileName, variant)
+		if err := s.storage.Upload(ctx, pathToS3, bytes.NewReader(newFile), imageVariantContentType); err != nil {
+			errorMessage := s.getErrorMessage("convertImage", "storage.Upload")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return "", nil, err
+		}
+		variants = append(variants, &request.ImageVariantAddRequestRepositoryDto{
+			Variant:   variant,
+			Key:       pathToS3,
+			Url:       s.storage.Url(pathToS3),
+			Width:     newFileSize.Width,
+			Height:    newFileSize.Height,
+			Size:      int64(len(newFile)),
+			CreatedAt: time.Now().UTC(),
+		})
+	}
+	return newFileName, variants, nil
+}
+
+func (s *ProfileService) getImageVariantKey(telegramUserId, fileName string, variant enum.ImageVariant) string {
+	name := strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	return fmt.Sprintf("/profiles/%s/images/%s/%s.webp", telegramUserId, name, variant)
 }
 
 func (s *ProfileService) deleteFile(filePath string) error {
@@ -870,231 +1396,653 @@ func (s *ProfileService) deleteFile(filePath string) error {
 }
 
 func (s *ProfileService) AddBlock(ctx context.Context, pr *request.BlockAddRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("AddBlock", "CheckUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("AddBlock", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		blockExists, err := unitOfWork.BlockRepository().FindBlock(ctx, pr.TelegramUserId, pr.BlockedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().FindBlock")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		blockMapper := &mapper.BlockMapper{}
+		if blockExists != nil {
+			blockRequest := blockMapper.MapToUpdateRequest(pr, &pr.TelegramUserId)
+			_, err = unitOfWork.BlockRepository().Update(ctx, blockRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Update")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			prForViewedUser := &request.BlockAddRequestDto{
+				TelegramUserId:        pr.BlockedTelegramUserId,
+				BlockedTelegramUserId: pr.TelegramUserId,
+			}
+			blockForViewedUserRequest := blockMapper.MapToUpdateRequest(prForViewedUser, nil)
+			_, err = unitOfWork.BlockRepository().Update(ctx, blockForViewedUserRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Update")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+		}
+		if blockExists == nil {
+			blockRequest := blockMapper.MapToAddRequest(pr, &pr.TelegramUserId)
+			_, err = unitOfWork.BlockRepository().Add(ctx, blockRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Add")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			prForViewedUser := &request.BlockAddRequestDto{
+				TelegramUserId:        pr.BlockedTelegramUserId,
+				BlockedTelegramUserId: pr.TelegramUserId,
+			}
+			blockForViewedUserRequest := blockMapper.MapToAddRequest(prForViewedUser, nil)
+			_, err := unitOfWork.BlockRepository().Add(ctx, blockForViewedUserRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Add")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+		}
+		_, err = unitOfWork.ImageGrantRepository().DeletePair(ctx, pr.TelegramUserId, pr.BlockedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddBlock", "ImageGrantRepository().DeletePair")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	blockExists, err := unitOfWork.BlockRepository().FindBlock(ctx, pr.TelegramUserId, pr.BlockedTelegramUserId)
+	blockResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return blockResponse, nil
+}
+
+func (s *ProfileService) GetBlockedList(ctx context.Context,
+	telegramUserId string) (*response.BlockedListResponseDto, error) {
+	return s.blockRepository.GetBlockedList(ctx, telegramUserId)
+}
+
+func (s *ProfileService) Unblock(ctx context.Context, p *request.UnblockRequestDto) (*response.ResponseDto, error) {
+	telegramUserId := p.TelegramUserId
+	blockedTelegramUserId := p.BlockedTelegramUserId
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), telegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("Unblock", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err := unitOfWork.BlockRepository().Unblock(ctx, p)
+		if err != nil {
+			errorMessage := s.getErrorMessage("Unblock", "BlockRepository().Unblock")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		revert := &request.UnblockRequestDto{
+			TelegramUserId:        blockedTelegramUserId,
+			BlockedTelegramUserId: telegramUserId,
+		}
+		_, err = unitOfWork.BlockRepository().Unblock(ctx, revert)
+		if err != nil {
+			errorMessage := s.getErrorMessage("Unblock", "BlockRepository().Unblock")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().FindBlock")
-		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	blockMapper := &mapper.BlockMapper{}
-	if blockExists != nil {
-		blockRequest := blockMapper.MapToUpdateRequest(pr, &pr.TelegramUserId)
-		_, err = unitOfWork.BlockRepository().Update(ctx, blockRequest)
+	blockResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return blockResponse, nil
+}
+
+func (s *ProfileService) AddLike(
+	ctx context.Context, pr *request.LikeAddRequestDto, locale string) (*response.LikeMatchResponseDto, error) {
+	var isMatch bool
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("AddLike", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if err := unitOfWork.MatchRepository().LockPair(ctx, pr.TelegramUserId, pr.LikedTelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("AddLike", "MatchRepository().LockPair")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		likeMapper := &mapper.LikeMapper{}
+		likeRequest := likeMapper.MapToAddRequest(pr)
+		_, err := unitOfWork.LikeRepository().Add(ctx, likeRequest)
 		if err != nil {
-			errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Update")
+			errorMessage := s.getErrorMessage("AddLike",
+				"LikeRepository().Add")
 			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
+			return err
 		}
-		prForViewedUser := &request.BlockAddRequestDto{
-			TelegramUserId:        pr.BlockedTelegramUserId,
-			BlockedTelegramUserId: pr.TelegramUserId,
+		isMatch, err = s.addMatchIfMutual(ctx, unitOfWork, pr.TelegramUserId, pr.LikedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddLike", "addMatchIfMutual")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-		blockForViewedUserRequest := blockMapper.MapToUpdateRequest(prForViewedUser, nil)
-		_, err = unitOfWork.BlockRepository().Update(ctx, blockForViewedUserRequest)
+		err = s.addLikeNotifications(ctx, unitOfWork, pr.TelegramUserId, pr.LikedTelegramUserId, isMatch)
 		if err != nil {
-			errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Update")
+			errorMessage := s.getErrorMessage("AddLike", "addLikeNotifications")
 			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
+			return err
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if blockExists == nil {
-		blockRequest := blockMapper.MapToAddRequest(pr, &pr.TelegramUserId)
-		_, err = unitOfWork.BlockRepository().Add(ctx, blockRequest)
+	likeResponse := &response.LikeMatchResponseDto{
+		Success: true,
+		IsMatch: isMatch,
+	}
+	return likeResponse, nil
+}
+
+func (s *ProfileService) UpdateLike(
+	ctx context.Context, pr *request.LikeUpdateRequestDto) (*response.LikeMatchResponseDto, error) {
+	var isMatch bool
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("UpdateLike", "checkUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		likeEntity, err := unitOfWork.LikeRepository().FindById(ctx, pr.Id)
 		if err != nil {
-			errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Add")
+			errorMessage := s.getErrorMessage("UpdateLike", "LikeRepository().FindById")
 			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
+			return err
 		}
-		prForViewedUser := &request.BlockAddRequestDto{
-			TelegramUserId:        pr.BlockedTelegramUserId,
-			BlockedTelegramUserId: pr.TelegramUserId,
+		if likeEntity == nil || likeEntity.TelegramUserId != pr.TelegramUserId {
+			return ErrLikeNotFound
 		}
-		blockForViewedUserRequest := blockMapper.MapToAddRequest(prForViewedUser, nil)
-		_, err := unitOfWork.BlockRepository().Add(ctx, blockForViewedUserRequest)
+		err = unitOfWork.MatchRepository().LockPair(ctx, likeEntity.TelegramUserId, likeEntity.LikedTelegramUserId)
 		if err != nil {
-			errorMessage := s.getErrorMessage("AddBlock", "BlockRepository().Add")
+			errorMessage := s.getErrorMessage("UpdateLike", "MatchRepository().LockPair")
 			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
+			return err
 		}
-	}
-	defer func() {
+		likeMapper := &mapper.LikeMapper{}
+		likeRequest := likeMapper.MapToUpdateRequest(pr)
+		_, err = unitOfWork.LikeRepository().Update(ctx, likeRequest)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("AddBlock", "Rollback")
+			errorMessage := s.getErrorMessage("UpdateLike", "likeRepository.Update")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if !pr.IsLiked {
+			_, err = unitOfWork.MatchRepository().Delete(ctx, likeEntity.TelegramUserId, likeEntity.LikedTelegramUserId)
+			if err != nil {
+				errorMessage := s.getErrorMessage("UpdateLike", "MatchRepository().Delete")
 				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
 			}
+			return nil
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("AddBlock", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		isMatch, err = s.addMatchIfMutual(ctx, unitOfWork, likeEntity.TelegramUserId, likeEntity.LikedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateLike", "addMatchIfMutual")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		err = s.addLikeNotifications(ctx, unitOfWork, likeEntity.TelegramUserId, likeEntity.LikedTelegramUserId, isMatch)
+		if err != nil {
+			errorMessage := s.getErrorMessage("UpdateLike", "addLikeNotifications")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	blockResponse := &response.ResponseDto{
+	likeResponse := &response.LikeMatchResponseDto{
 		Success: true,
+		IsMatch: isMatch,
 	}
-	return blockResponse, nil
+	return likeResponse, nil
 }
 
-func (s *ProfileService) GetBlockedList(ctx context.Context,
-	telegramUserId string) (*response.BlockedListResponseDto, error) {
-	return s.blockRepository.GetBlockedList(ctx, telegramUserId)
+// addMatchIfMutual - creates a match when the liked user has already liked the current user back.
+// Returns true only when a new match has been created
+func (s *ProfileService) addMatchIfMutual(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId, likedTelegramUserId string) (bool, error) {
+	likeBack, err := unitOfWork.LikeRepository().FindLike(ctx, likedTelegramUserId, telegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addMatchIfMutual", "LikeRepository().FindLike")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return false, err
+	}
+	if likeBack == nil || !likeBack.IsLiked {
+		return false, nil
+	}
+	blockEntity, err := unitOfWork.BlockRepository().FindBlock(ctx, telegramUserId, likedTelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addMatchIfMutual", "BlockRepository().FindBlock")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return false, err
+	}
+	if blockEntity != nil && blockEntity.IsBlocked {
+		return false, nil
+	}
+	matchMapper := &mapper.MatchMapper{}
+	matchRequest := matchMapper.MapToAddRequest(telegramUserId, likedTelegramUserId)
+	matchId, err := unitOfWork.MatchRepository().Add(ctx, matchRequest)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addMatchIfMutual", "MatchRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return false, err
+	}
+	return matchId > 0, nil
 }
 
-func (s *ProfileService) Unblock(ctx context.Context, p *request.UnblockRequestDto) (*response.ResponseDto, error) {
-	telegramUserId := p.TelegramUserId
-	blockedTelegramUserId := p.BlockedTelegramUserId
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, telegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("Unblock", "CheckUserExists")
+// addLikeNotifications - stores in the outbox the like event for the liked user, or the match
+// events for both users. They are published by the outbox relay after the commit
+func (s *ProfileService) addLikeNotifications(ctx context.Context, unitOfWork *UnitOfWork,
+	telegramUserId, likedTelegramUserId string, isMatch bool) error {
+	if !isMatch {
+		return s.addProfileNotification(ctx, unitOfWork, events.EventType_EVENT_TYPE_LIKE_RECEIVED,
+			telegramUserId, likedTelegramUserId)
+	}
+	err := s.addProfileNotification(ctx, unitOfWork, events.EventType_EVENT_TYPE_MATCH_CREATED,
+		telegramUserId, likedTelegramUserId)
+	if err != nil {
+		return err
+	}
+	return s.addProfileNotification(ctx, unitOfWork, events.EventType_EVENT_TYPE_MATCH_CREATED,
+		likedTelegramUserId, telegramUserId)
+}
+
+// addProfileNotification - stores in the outbox the event with the profile of the user who
+// liked or matched the recipient. Blocked users don't send notifications
+func (s *ProfileService) addProfileNotification(ctx context.Context, unitOfWork *UnitOfWork,
+	eventType events.EventType, telegramUserId, recipientTelegramUserId string) error {
+	statusEntity, err := unitOfWork.StatusRepository().FindByTelegramUserId(ctx, telegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addProfileNotification", "StatusRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	if statusEntity.IsBlocked {
+		return nil
+	}
+	telegramEntity, err := unitOfWork.TelegramRepository().FindByTelegramUserId(ctx, telegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addProfileNotification", "TelegramRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	recipientTelegramEntity, err := unitOfWork.TelegramRepository().FindByTelegramUserId(ctx, recipientTelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addProfileNotification", "TelegramRepository().FindByTelegramUserId")
 		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	userImageUrl, err := s.getLastImageUrl(ctx, unitOfWork, telegramUserId)
+	if err != nil {
+		return err
+	}
+	eventMapper := &mapper.EventMapper{}
+	// The sympathy message is sent only on a mutual match, a one-way like only tells that someone liked
+	if eventType == events.EventType_EVENT_TYPE_MATCH_CREATED {
+		message := s.GetMessageLike(recipientTelegramEntity.LanguageCode)
+		e := eventMapper.MapToMatchCreated(recipientTelegramUserId, telegramEntity, userImageUrl, message)
+		return s.addEventToOutbox(ctx, unitOfWork, e)
+	}
+	message := s.GetMessageLikeReceived(recipientTelegramEntity.LanguageCode)
+	e := eventMapper.MapToLikeReceived(recipientTelegramUserId, telegramEntity, userImageUrl, message,
+		recipientTelegramEntity.LanguageCode)
+	return s.addEventToOutbox(ctx, unitOfWork, e)
+}
+
+// addMessageNotification - stores in the outbox the event about a new chat message
+func (s *ProfileService) addMessageNotification(
+	ctx context.Context, unitOfWork *UnitOfWork, messageEntity *entity.MessageEntity) error {
+	telegramEntity, err := unitOfWork.TelegramRepository().FindByTelegramUserId(ctx,
+		messageEntity.SenderTelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addMessageNotification", "TelegramRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	userImageUrl, err := s.getLastImageUrl(ctx, unitOfWork, messageEntity.SenderTelegramUserId)
+	if err != nil {
+		return err
+	}
+	eventMapper := &mapper.EventMapper{}
+	e := eventMapper.MapToMessageReceived(messageEntity, telegramEntity, userImageUrl)
+	return s.addEventToOutbox(ctx, unitOfWork, e)
+}
+
+// addModerationNotification - stores in the outbox the event that the profile has been blocked
+func (s *ProfileService) addModerationNotification(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string) error {
+	eventMapper := &mapper.EventMapper{}
+	e := eventMapper.MapToProfileBlocked(telegramUserId, "profile blocked")
+	return s.addEventToOutbox(ctx, unitOfWork, e)
+}
+
+// addPaymentNotification - stores in the outbox the event that the payment has succeeded
+func (s *ProfileService) addPaymentNotification(
+	ctx context.Context, unitOfWork *UnitOfWork, pr *request.PaymentAddRequestRepositoryDto) error {
+	telegramEntity, err := unitOfWork.TelegramRepository().FindByTelegramUserId(ctx, pr.TelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addPaymentNotification", "TelegramRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	eventMapper := &mapper.EventMapper{}
+	e := eventMapper.MapToPaymentSucceeded(pr, telegramEntity.LanguageCode)
+	return s.addEventToOutbox(ctx, unitOfWork, e)
+}
+
+// addEventToOutbox - stores the event in the outbox keyed by its recipient, so the events
+// of one user keep their order in the topic
+func (s *ProfileService) addEventToOutbox(ctx context.Context, unitOfWork *UnitOfWork, e *events.Event) error {
+	payload, err := proto.Marshal(e)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addEventToOutbox", "proto.Marshal")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	outboxMapper := &mapper.OutboxMapper{}
+	outboxRequest := outboxMapper.MapToAddRequest(eventTopic, e.GetRecipientTelegramUserId(), payload)
+	_, err = unitOfWork.OutboxRepository().Add(ctx, outboxRequest)
+	if err != nil {
+		errorMessage := s.getErrorMessage("addEventToOutbox", "OutboxRepository().Add")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// getLastImageUrl - returns the url of the last public image of the user or an empty string
+func (s *ProfileService) getLastImageUrl(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string) (string, error) {
+	lastImage, err := unitOfWork.ImageRepository().FindLastByTelegramUserId(ctx, telegramUserId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		errorMessage := s.getErrorMessage("getLastImageUrl", "ImageRepository().FindLastByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return "", err
+	}
+	return lastImage.Url, nil
+}
+
+func (s *ProfileService) GetLikeList(
+	ctx context.Context, telegramUserId string) (*response.LikeListResponseDto, error) {
+	return s.likeRepository.SelectListByLikedTelegramUserId(ctx, telegramUserId, likeListLimit)
+}
+
+func (s *ProfileService) GetMatchList(
+	ctx context.Context, telegramUserId string) (*response.MatchListResponseDto, error) {
+	return s.matchRepository.SelectListByTelegramUserId(ctx, telegramUserId)
+}
+
+func (s *ProfileService) Unmatch(ctx context.Context, pr *request.UnmatchRequestDto) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("Unmatch", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		err := unitOfWork.MatchRepository().LockPair(ctx, pr.TelegramUserId, pr.MatchedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("Unmatch", "MatchRepository().LockPair")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		_, err = unitOfWork.MatchRepository().Delete(ctx, pr.TelegramUserId, pr.MatchedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("Unmatch", "MatchRepository().Delete")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		// The private images are shared only with the matches
+		_, err = unitOfWork.ImageGrantRepository().DeletePair(ctx, pr.TelegramUserId, pr.MatchedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("Unmatch", "ImageGrantRepository().DeletePair")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		// The own like is withdrawn, otherwise the next like back would restore the match
+		likeEntity, err := unitOfWork.LikeRepository().FindLike(ctx, pr.TelegramUserId, pr.MatchedTelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("Unmatch", "LikeRepository().FindLike")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if likeEntity != nil && likeEntity.IsLiked {
+			likeMapper := &mapper.LikeMapper{}
+			likeRequest := likeMapper.MapToUpdateRequest(&request.LikeUpdateRequestDto{
+				Id:             likeEntity.Id,
+				TelegramUserId: pr.TelegramUserId,
+				IsLiked:        false,
+			})
+			_, err = unitOfWork.LikeRepository().Update(ctx, likeRequest)
+			if err != nil {
+				errorMessage := s.getErrorMessage("Unmatch", "LikeRepository().Update")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	_, err := unitOfWork.BlockRepository().Unblock(ctx, p)
+	matchResponse := &response.ResponseDto{
+		Success: true,
+	}
+	return matchResponse, nil
+}
+
+func (s *ProfileService) SendMessage(
+	ctx context.Context, pr *request.MessageAddRequestDto) (*response.MessageResponseDto, error) {
+	var messageEntity *entity.MessageEntity
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		if err := s.checkProfileExists(ctx, unitOfWork.StatusRepository(), pr.TelegramUserId); err != nil {
+			errorMessage := s.getErrorMessage("SendMessage", "CheckUserExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		text := strings.TrimSpace(pr.Text)
+		if text == "" || utf8.RuneCountInString(text) > maxMessageLength {
+			return ErrEmptyMessage
+		}
+		if err := s.checkMessageAllowed(ctx, unitOfWork, pr.TelegramUserId, pr.RecipientTelegramUserId); err != nil {
+			return err
+		}
+		conversationMapper := &mapper.ConversationMapper{}
+		conversationRequest := conversationMapper.MapToAddRequest(pr.TelegramUserId, pr.RecipientTelegramUserId)
+		conversationId, err := unitOfWork.ConversationRepository().Add(ctx, conversationRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("SendMessage", "ConversationRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		messageMapper := &mapper.MessageMapper{}
+		messageRequest := messageMapper.MapToAddRequest(conversationId, pr)
+		messageEntity, err = unitOfWork.MessageRepository().Add(ctx, messageRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("SendMessage", "MessageRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		lastMessageRequest := conversationMapper.MapToUpdateLastMessageRequest(messageEntity)
+		_, err = unitOfWork.ConversationRepository().UpdateLastMessage(ctx, lastMessageRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("SendMessage", "ConversationRepository().UpdateLastMessage")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if err := s.addMessageNotification(ctx, unitOfWork, messageEntity); err != nil {
+			errorMessage := s.getErrorMessage("SendMessage", "addMessageNotification")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	messageMapper := &mapper.MessageMapper{}
+	return messageMapper.MapToResponse(messageEntity), nil
+}
+
+// checkMessageAllowed - messages are allowed only between matched users, when neither of them
+// has blocked the other one and the recipient has not been blocked by moderation
+func (s *ProfileService) checkMessageAllowed(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId, recipientTelegramUserId string) error {
+	if telegramUserId == recipientTelegramUserId {
+		return ErrMessageNotAllowed
+	}
+	matchEntity, err := unitOfWork.MatchRepository().FindMatch(ctx, telegramUserId, recipientTelegramUserId)
 	if err != nil {
-		errorMessage := s.getErrorMessage("Unblock", "BlockRepository().Unblock")
+		errorMessage := s.getErrorMessage("checkMessageAllowed", "MatchRepository().FindMatch")
 		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	if matchEntity == nil {
+		return ErrMessageNotAllowed
+	}
+	for _, pair := range [][2]string{
+		{telegramUserId, recipientTelegramUserId},
+		{recipientTelegramUserId, telegramUserId},
+	} {
+		blockEntity, err := unitOfWork.BlockRepository().FindBlock(ctx, pair[0], pair[1])
+		if err != nil {
+			errorMessage := s.getErrorMessage("checkMessageAllowed", "BlockRepository().FindBlock")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if blockEntity != nil && blockEntity.IsBlocked {
+			return ErrMessageNotAllowed
+		}
+	}
+	statusEntity, err := unitOfWork.StatusRepository().FindByTelegramUserId(ctx, recipientTelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("checkMessageAllowed", "StatusRepository().FindByTelegramUserId")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	if statusEntity == nil || statusEntity.IsBlocked {
+		return ErrMessageNotAllowed
+	}
+	return nil
+}
+
+func (s *ProfileService) GetConversationList(
+	ctx context.Context, telegramUserId string) (*response.ConversationListResponseDto, error) {
+	return s.conversationRepository.SelectListByTelegramUserId(ctx, telegramUserId)
+}
+
+func (s *ProfileService) GetMessageList(
+	ctx context.Context, pr *request.MessageGetListRequestDto) (*response.MessageListResponseDto, error) {
+	if err := s.checkConversationParticipant(ctx, pr.ConversationId, pr.TelegramUserId); err != nil {
 		return nil, err
 	}
-	revert := &request.UnblockRequestDto{
-		TelegramUserId:        blockedTelegramUserId,
-		BlockedTelegramUserId: telegramUserId,
+	beforeId, err := s.decodeMessageCursor(pr.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := pr.Limit
+	if limit == 0 {
+		limit = defaultMessageListLimit
+	}
+	if limit > maxMessageListLimit {
+		limit = maxMessageListLimit
 	}
-	_, err = unitOfWork.BlockRepository().Unblock(ctx, revert)
+	messageMapper := &mapper.MessageMapper{}
+	// One extra row is requested to know whether there is a next page
+	messageRequest := messageMapper.MapToGetListRequest(pr.ConversationId, beforeId, limit+1)
+	messageList, err := s.messageRepository.SelectListByConversationId(ctx, messageRequest)
 	if err != nil {
-		errorMessage := s.getErrorMessage("Unblock", "BlockRepository().Unblock")
+		errorMessage := s.getErrorMessage("GetMessageList", "messageRepository.SelectListByConversationId")
 		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	defer func() {
-		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("Unblock", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
-		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("Unblock", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+	hasNext := uint64(len(messageList)) > limit
+	if hasNext {
+		messageList = messageList[:limit]
 	}
-	blockResponse := &response.ResponseDto{
-		Success: true,
+	content := make([]*response.MessageResponseDto, 0, len(messageList))
+	for _, m := range messageList {
+		content = append(content, messageMapper.MapToResponse(m))
 	}
-	return blockResponse, nil
+	nextCursor := ""
+	if hasNext {
+		nextCursor = s.encodeMessageCursor(messageList[len(messageList)-1].Id)
+	}
+	messageListResponse := &response.MessageListResponseDto{
+		Content:    content,
+		NextCursor: nextCursor,
+		HasNext:    hasNext,
+	}
+	return messageListResponse, nil
 }
 
-func (s *ProfileService) AddLike(
-	ctx context.Context, pr *request.LikeAddRequestDto, locale string) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("AddLike", "CheckUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
+func (s *ProfileService) MarkRead(
+	ctx context.Context, pr *request.MessageMarkReadRequestDto) (*response.ResponseDto, error) {
+	if err := s.checkConversationParticipant(ctx, pr.ConversationId, pr.TelegramUserId); err != nil {
 		return nil, err
 	}
-	// For channel
-	//telegramProfile, err := s.telegramRepository.FindByTelegramUserId(ctx, pr.TelegramUserId)
-	//if err != nil {
-	//	errorMessage := s.getErrorMessage("AddLike",
-	//		"telegramRepository.FindByTelegramUserId")
-	//	s.logger.Debug(errorMessage, zap.Error(err))
-	//	return nil, err
-	//}
-	//statusProfile, err := s.statusRepository.FindByTelegramUserId(ctx, pr.TelegramUserId)
-	//if err != nil {
-	//	errorMessage := s.getErrorMessage("AddLike",
-	//		"statusRepository.FindByTelegramUserId")
-	//	s.logger.Debug(errorMessage, zap.Error(err))
-	//	return nil, err
-	//}
-	//lastImageProfile, err := s.imageRepository.FindLastByTelegramUserId(ctx, pr.TelegramUserId)
-	//if err != nil {
-	//	errorMessage := s.getErrorMessage("AddLike",
-	//		"imageRepository.FindLastByTelegramUserId")
-	//	s.logger.Debug(errorMessage, zap.Error(err))
-	//	return nil, err
-	//}
-	//likedTelegramProfile, err := s.telegramRepository.FindByTelegramUserId(ctx, pr.LikedTelegramUserId)
-	//if err != nil {
-	//	errorMessage := s.getErrorMessage("AddLike",
-	//		"telegramRepository.FindByTelegramUserId")
-	//	s.logger.Debug(errorMessage, zap.Error(err))
-	//	return nil, err
-	//}
-	//hc := &entity.HubContent{
-	//	LikedTelegramUserId: likedTelegramProfile.UserId,
-	//	Message:             s.GetMessageLike(locale),
-	//	Type:                "like",
-	//	UserImageUrl:        lastImageProfile.Url,
-	//	Username:            telegramProfile.UserName,
-	//}
-	//if !statusProfile.IsBlocked {
-	// For channel
-	//go func() {
-	//	s.hub.Broadcast <- hc
-	//}()
-	likeMapper := &mapper.LikeMapper{}
-	likeRequest := likeMapper.MapToAddRequest(pr)
-	likeResponse, err := unitOfWork.LikeRepository().Add(ctx, likeRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddLike",
-			"LikeRepository().Add")
+	messageMapper := &mapper.MessageMapper{}
+	messageRequest := messageMapper.MapToMarkReadRequest(pr)
+	return s.messageRepository.MarkRead(ctx, messageRequest)
+}
+
+func (s *ProfileService) checkConversationParticipant(
+	ctx context.Context, conversationId uint64, telegramUserId string) error {
+	conversationEntity, err := s.conversationRepository.FindById(ctx, conversationId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("checkConversationParticipant", "conversationRepository.FindById")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+		return err
 	}
-	defer func() {
-		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("AddLike", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
-		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("AddLike", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+	if conversationEntity == nil || (conversationEntity.TelegramUserId != telegramUserId &&
+		conversationEntity.PeerTelegramUserId != telegramUserId) {
+		return ErrConversationNotFound
 	}
-	return likeResponse, nil
+	return nil
 }
 
-func (s *ProfileService) UpdateLike(
-	ctx context.Context, pr *request.LikeUpdateRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	if err := s.CheckProfileExists(ctx, pr.TelegramUserId); err != nil {
-		errorMessage := s.getErrorMessage("UpdateLike", "checkUserExists")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+// encodeMessageCursor - the cursor is opaque for clients, it holds the id of the last returned message
+func (s *ProfileService) encodeMessageCursor(id uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(id, 10)))
+}
+
+func (s *ProfileService) decodeMessageCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
 	}
-	likeMapper := &mapper.LikeMapper{}
-	likeRequest := likeMapper.MapToUpdateRequest(pr)
-	likeResponse, err := unitOfWork.LikeRepository().Update(ctx, likeRequest)
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
 	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateLike", "likeRepository.Update")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+		return 0, ErrInvalidCursor
 	}
-	defer func() {
-		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("UpdateLike", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
-		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("UpdateLike", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+	id, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil || id == 0 {
+		return 0, ErrInvalidCursor
 	}
-	return likeResponse, nil
+	return id, nil
 }
 
 func (s *ProfileService) GetLastLike(
@@ -1104,70 +2052,179 @@ func (s *ProfileService) GetLastLike(
 
 func (s *ProfileService) AddComplaint(
 	ctx context.Context, pr *request.ComplaintAddRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	blockMapper := &mapper.BlockMapper{}
-	br := &request.BlockAddRequestDto{
-		TelegramUserId:        pr.TelegramUserId,
-		BlockedTelegramUserId: pr.CriminalTelegramUserId,
-	}
-	blockRequest := blockMapper.MapToAddRequest(br, &br.TelegramUserId)
-	_, err := unitOfWork.BlockRepository().Add(ctx, blockRequest)
+	var complaintResponse *response.ResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		blockMapper := &mapper.BlockMapper{}
+		br := &request.BlockAddRequestDto{
+			TelegramUserId:        pr.TelegramUserId,
+			BlockedTelegramUserId: pr.CriminalTelegramUserId,
+		}
+		blockRequest := blockMapper.MapToAddRequest(br, &br.TelegramUserId)
+		_, err := unitOfWork.BlockRepository().Add(ctx, blockRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaint", "BlockRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		prForViewedUser := &request.BlockAddRequestDto{
+			TelegramUserId:        pr.CriminalTelegramUserId,
+			BlockedTelegramUserId: pr.TelegramUserId,
+		}
+		blockForViewedUserRequest := blockMapper.MapToAddRequest(prForViewedUser, nil)
+		_, err = unitOfWork.BlockRepository().Add(ctx, blockForViewedUserRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaint", "BlockRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		weight, err := s.getReporterWeight(ctx, unitOfWork, pr.TelegramUserId)
+		if err != nil {
+			return err
+		}
+		complaintMapper := &mapper.ComplaintMapper{}
+		complaintRequest := complaintMapper.MapToAddRequest(pr, weight)
+		complaintResponse, err = unitOfWork.ComplaintRepository().Add(ctx, complaintRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaint",
+				"ComplaintRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return s.escalateComplaints(ctx, unitOfWork, pr.CriminalTelegramUserId, pr.Type)
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("AddComplaint", "BlockRepository().Add")
-		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	prForViewedUser := &request.BlockAddRequestDto{
-		TelegramUserId:        pr.CriminalTelegramUserId,
-		BlockedTelegramUserId: pr.TelegramUserId,
+	return complaintResponse, nil
+}
+
+// AddComplaintAppeal - the blocked user appeals the upheld complaints, the case goes back to review
+func (s *ProfileService) AddComplaintAppeal(
+	ctx context.Context, pr *request.ComplaintAppealAddRequestDto) (*response.ResponseDto, error) {
+	var appealResponse *response.ResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		status, err := unitOfWork.StatusRepository().FindByTelegramUserId(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaintAppeal", "StatusRepository().FindByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if !status.IsBlocked {
+			return ErrComplaintAppealNotAllowed
+		}
+		openAppeal, err := unitOfWork.ComplaintAppealRepository().FindOpenByTelegramUserId(ctx, pr.TelegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaintAppeal",
+				"ComplaintAppealRepository().FindOpenByTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if openAppeal != nil {
+			return ErrComplaintAppealExists
+		}
+		complaintMapper := &mapper.ComplaintMapper{}
+		updateStatusRequest := complaintMapper.MapToUpdateStatusRequest(
+			pr.TelegramUserId, enum.ComplaintStatusUpheld, enum.ComplaintStatusUnderReview, nil)
+		reopened, err := unitOfWork.ComplaintRepository().UpdateStatusByCriminalTelegramUserId(ctx, updateStatusRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaintAppeal",
+				"ComplaintRepository().UpdateStatusByCriminalTelegramUserId")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if reopened == 0 {
+			return ErrComplaintAppealNotAllowed
+		}
+		appealResponse, err = unitOfWork.ComplaintAppealRepository().Add(
+			ctx, complaintMapper.MapToAppealAddRequest(pr))
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddComplaintAppeal", "ComplaintAppealRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	blockForViewedUserRequest := blockMapper.MapToAddRequest(prForViewedUser, nil)
-	_, err = unitOfWork.BlockRepository().Add(ctx, blockForViewedUserRequest)
+	return appealResponse, nil
+}
+
+// escalateComplaints - once the weight of the distinct reporters of the reason reaches its threshold,
+// the open complaints against the user go to review and the user is blocked until the decision
+func (s *ProfileService) escalateComplaints(ctx context.Context, unitOfWork *UnitOfWork,
+	criminalTelegramUserId string, complaintType enum.Complaint) error {
+	weight, err := unitOfWork.ComplaintRepository().GetWeightByCriminalTelegramUserId(
+		ctx, criminalTelegramUserId, complaintType)
 	if err != nil {
-		errorMessage := s.getErrorMessage("AddComplaint", "BlockRepository().Add")
+		errorMessage := s.getErrorMessage("escalateComplaints",
+			"ComplaintRepository().GetWeightByCriminalTelegramUserId")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+		return err
+	}
+	if weight < s.getComplaintThreshold(complaintType) {
+		return nil
 	}
 	complaintMapper := &mapper.ComplaintMapper{}
-	complaintRequest := complaintMapper.MapToAddRequest(pr)
-	complaintResponse, err := unitOfWork.ComplaintRepository().Add(ctx, complaintRequest)
+	updateStatusRequest := complaintMapper.MapToUpdateStatusRequest(
+		criminalTelegramUserId, enum.ComplaintStatusOpen, enum.ComplaintStatusUnderReview, nil)
+	_, err = unitOfWork.ComplaintRepository().UpdateStatusByCriminalTelegramUserId(ctx, updateStatusRequest)
 	if err != nil {
-		errorMessage := s.getErrorMessage("AddComplaint",
-			"ComplaintRepository().Add")
+		errorMessage := s.getErrorMessage("escalateComplaints",
+			"ComplaintRepository().UpdateStatusByCriminalTelegramUserId")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+		return err
 	}
-	countUserComplaints, err := unitOfWork.ComplaintRepository().GetCountUserComplaintsByCurrentMonth(
-		ctx, pr.TelegramUserId)
+	criminalStatus, err := unitOfWork.StatusRepository().FindByTelegramUserId(ctx, criminalTelegramUserId)
 	if err != nil {
-		errorMessage := s.getErrorMessage("AddComplaint",
-			"complaintRepository.GetCountUserComplaintsByToday")
+		errorMessage := s.getErrorMessage("escalateComplaints", "StatusRepository().FindByTelegramUserId")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+		return err
 	}
-	if countUserComplaints >= maxCountUserComplaints {
-		_, err := unitOfWork.StatusRepository().Block(ctx, pr.CriminalTelegramUserId)
-		if err != nil {
-			errorMessage := s.getErrorMessage("AddComplaint",
-				"statusRepository().Block")
-			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
-		}
+	if criminalStatus.IsBlocked {
+		return nil
 	}
-	defer func() {
-		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("AddComplaint", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
-		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("AddComplaint", "Commit")
+	_, err = unitOfWork.StatusRepository().Block(ctx, criminalTelegramUserId)
+	if err != nil {
+		errorMessage := s.getErrorMessage("escalateComplaints", "StatusRepository().Block")
 		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
+		return err
 	}
-	return complaintResponse, nil
+	if err := s.addModerationNotification(ctx, unitOfWork, criminalTelegramUserId); err != nil {
+		errorMessage := s.getErrorMessage("escalateComplaints", "addModerationNotification")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// getReporterWeight - the trust of the reporter by the outcome of the reviewed complaints:
+// a new reporter weighs 1, the weight goes to 2 when the complaints are upheld and to 0 when dismissed
+func (s *ProfileService) getReporterWeight(
+	ctx context.Context, unitOfWork *UnitOfWork, telegramUserId string) (float64, error) {
+	countUpheld, err := unitOfWork.ComplaintRepository().GetCountByTelegramUserIdAndStatus(
+		ctx, telegramUserId, enum.ComplaintStatusUpheld)
+	if err != nil {
+		errorMessage := s.getErrorMessage("getReporterWeight", "ComplaintRepository().GetCountByTelegramUserIdAndStatus")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	countDismissed, err := unitOfWork.ComplaintRepository().GetCountByTelegramUserIdAndStatus(
+		ctx, telegramUserId, enum.ComplaintStatusDismissed)
+	if err != nil {
+		errorMessage := s.getErrorMessage("getReporterWeight", "ComplaintRepository().GetCountByTelegramUserIdAndStatus")
+		s.logger.Debug(errorMessage, zap.Error(err))
+		return 0, err
+	}
+	return 2 * float64(countUpheld+1) / float64(countUpheld+countDismissed+2), nil
+}
+
+func (s *ProfileService) getComplaintThreshold(complaintType enum.Complaint) float64 {
+	threshold, ok := s.config.ComplaintThresholds[string(complaintType)]
+	if !ok || threshold <= 0 {
+		return defaultComplaintThreshold
+	}
+	return threshold
 }
 
 func (s *ProfileService) GetStatusByTelegramUserId(
@@ -1193,34 +2250,27 @@ func (s *ProfileService) GetStatusByTelegramUserId(
 
 func (s *ProfileService) AddPayment(
 	ctx context.Context, pr *request.PaymentAddRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	paymentLast, err := unitOfWork.PaymentRepository().FindLastByTelegramUserId(ctx, pr.TelegramUserId)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddPayment",
-			"unitOfWork.PaymentRepository().Add()")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	paymentMapper := &mapper.PaymentMapper{}
-	paymentRequest := paymentMapper.MapToAddRequest(pr, paymentLast)
-	paymentResponse, err := unitOfWork.PaymentRepository().Add(ctx, paymentRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("AddPayment",
-			"unitOfWork.PaymentRepository().Add()")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	defer func() {
+	var paymentResponse *response.ResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		paymentLast, err := unitOfWork.PaymentRepository().FindLastByTelegramUserId(ctx, pr.TelegramUserId)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("AddPayment", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("AddPayment",
+				"unitOfWork.PaymentRepository().Add()")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("AddPayment", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		paymentMapper := &mapper.PaymentMapper{}
+		paymentRequest := paymentMapper.MapToAddRequest(pr, paymentLast)
+		paymentResponse, err = unitOfWork.PaymentRepository().Add(ctx, paymentRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddPayment",
+				"unitOfWork.PaymentRepository().Add()")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return s.addPaymentNotification(ctx, unitOfWork, paymentRequest)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return paymentResponse, nil
@@ -1247,27 +2297,21 @@ func (s *ProfileService) CheckPremium(
 
 func (s *ProfileService) UpdateSettings(
 	ctx context.Context, pr *request.ProfileUpdateSettingsRequestDto) (*response.ResponseDto, error) {
-	unitOfWork := s.uwf.CreateUnit()
-	statusMapper := &mapper.StatusMapper{}
-	statusRequest := statusMapper.MapToUpdateSettingsRequest(pr)
-	statusResponse, err := unitOfWork.StatusRepository().UpdateSettings(ctx, statusRequest)
-	if err != nil {
-		errorMessage := s.getErrorMessage("UpdateSettings",
-			"unitOfWork.StatusRepository().Add()")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return nil, err
-	}
-	defer func() {
+	var statusResponse *response.ResponseDto
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		statusMapper := &mapper.StatusMapper{}
+		statusRequest := statusMapper.MapToUpdateSettingsRequest(pr)
+		updateResponse, err := unitOfWork.StatusRepository().UpdateSettings(ctx, statusRequest)
 		if err != nil {
-			if err := unitOfWork.Rollback(ctx); err != nil {
-				errorMessage := s.getErrorMessage("UpdateSettings", "Rollback")
-				s.logger.Debug(errorMessage, zap.Error(err))
-			}
+			errorMessage := s.getErrorMessage("UpdateSettings",
+				"unitOfWork.StatusRepository().Add()")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
 		}
-	}()
-	if err = unitOfWork.Commit(ctx); err != nil {
-		errorMessage := s.getErrorMessage("UpdateSettings", "Commit")
-		s.logger.Debug(errorMessage, zap.Error(err))
+		statusResponse = updateResponse
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 	return statusResponse, nil
@@ -1280,70 +2324,171 @@ func (s *ProfileService) UpdateCoordinates(
 	return s.updateNavigator(ctx, pr.TelegramUserId, pr.CountryCode, pr.CountryName, pr.City, longitude, latitude)
 }
 
-func (s *ProfileService) updateLastOnline(ctx context.Context, telegramUserId string) error {
-	tx, err := s.db.Begin()
+func (s *ProfileService) AddRefreshToken(
+	ctx context.Context, pr *request.RefreshTokenAddRequestDto) (*response.ResponseDto, error) {
+	refreshTokenMapper := &mapper.RefreshTokenMapper{}
+	refreshTokenRequest := refreshTokenMapper.MapToAddRequest(pr.TelegramUserId, pr.TokenHash, pr.ExpiresAt)
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		_, err := unitOfWork.RefreshTokenRepository().Add(ctx, refreshTokenRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("AddRefreshToken", "RefreshTokenRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("updateLastOnline", "Begin")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return err
+		return nil, err
 	}
-	defer tx.Rollback()
-	updateLastOnlineMapper := &mapper.ProfileUpdateLastOnlineMapper{}
-	updateLastOnlineRequest := updateLastOnlineMapper.MapToAddRequest(telegramUserId)
-	err = s.profileRepository.UpdateLastOnline(ctx, updateLastOnlineRequest)
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+// RotateRefreshToken - revokes the refresh token and issues the new one to the same user.
+// A refresh token is single-use, presenting a revoked one means that it has leaked, so all the
+// sessions of the user are revoked and the user has to sign in with the init data again
+func (s *ProfileService) RotateRefreshToken(
+	ctx context.Context, pr *request.RefreshTokenRotateRequestDto) (string, error) {
+	telegramUserId := ""
+	isReused := false
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		now := time.Now().UTC()
+		refreshToken, err := unitOfWork.RefreshTokenRepository().FindByTokenHash(ctx, pr.TokenHash)
+		if err != nil {
+			errorMessage := s.getErrorMessage("RotateRefreshToken", "RefreshTokenRepository().FindByTokenHash")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if refreshToken == nil || refreshToken.ExpiresAt.Before(now) {
+			return ErrRefreshTokenInvalid
+		}
+		if refreshToken.RevokedAt != nil {
+			isReused = true
+			_, err := unitOfWork.RefreshTokenRepository().RevokeListByTelegramUserId(
+				ctx, refreshToken.TelegramUserId, now)
+			if err != nil {
+				errorMessage := s.getErrorMessage("RotateRefreshToken",
+					"RefreshTokenRepository().RevokeListByTelegramUserId")
+				s.logger.Debug(errorMessage, zap.Error(err))
+				return err
+			}
+			// The revocation must be committed, so the error is returned after the transaction
+			return nil
+		}
+		if _, err := unitOfWork.RefreshTokenRepository().Revoke(ctx, refreshToken.Id, now); err != nil {
+			errorMessage := s.getErrorMessage("RotateRefreshToken", "RefreshTokenRepository().Revoke")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		refreshTokenMapper := &mapper.RefreshTokenMapper{}
+		refreshTokenRequest := refreshTokenMapper.MapToAddRequest(
+			refreshToken.TelegramUserId, pr.NewTokenHash, pr.ExpiresAt)
+		if _, err := unitOfWork.RefreshTokenRepository().Add(ctx, refreshTokenRequest); err != nil {
+			errorMessage := s.getErrorMessage("RotateRefreshToken", "RefreshTokenRepository().Add")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		telegramUserId = refreshToken.TelegramUserId
+		return nil
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("updateLastOnline",
-			"profileRepository.UpdateLastOnline")
-		s.logger.Debug(errorMessage, zap.Error(err))
-		return err
+		return "", err
 	}
-	tx.Commit()
-	return nil
+	if isReused {
+		return "", ErrRefreshTokenInvalid
+	}
+	return telegramUserId, nil
 }
 
-func (s *ProfileService) updateNavigator(ctx context.Context, telegramUserId string, countryCode, countryName,
-	city *string, longitude float64, latitude float64) (*response.ResponseDto, error) {
-	tx, err := s.db.Begin()
+func (s *ProfileService) RevokeRefreshToken(ctx context.Context, tokenHash string) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		refreshToken, err := unitOfWork.RefreshTokenRepository().FindByTokenHash(ctx, tokenHash)
+		if err != nil {
+			errorMessage := s.getErrorMessage("RevokeRefreshToken", "RefreshTokenRepository().FindByTokenHash")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if refreshToken == nil {
+			return nil
+		}
+		if _, err := unitOfWork.RefreshTokenRepository().Revoke(ctx, refreshToken.Id, time.Now().UTC()); err != nil {
+			errorMessage := s.getErrorMessage("RevokeRefreshToken", "RefreshTokenRepository().Revoke")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		errorMessage := s.getErrorMessage("updateNavigator", "Begin")
-		s.logger.Debug(errorMessage, zap.Error(err))
 		return nil, err
 	}
-	defer tx.Rollback()
-	navigatorMapper := &mapper.NavigatorMapper{}
-	navigatorRequest := navigatorMapper.MapToUpdateRequest(telegramUserId, countryCode, countryName, city, longitude,
-		latitude)
-	navigatorExists, err := s.checkNavigatorExists(ctx, telegramUserId)
-	if navigatorExists != nil {
-		if countryCode != nil && countryName != nil && city != nil {
-			_, err := s.navigatorRepository.Update(ctx, navigatorRequest)
-			if err != nil {
-				errorMessage := s.getErrorMessage("updateNavigator",
-					"navigatorRepository.Update")
-				s.logger.Debug(errorMessage, zap.Error(err))
-				return nil, err
+	return &response.ResponseDto{
+		Success: true,
+	}, nil
+}
+
+func (s *ProfileService) updateLastOnline(ctx context.Context, telegramUserId string) error {
+	return s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		updateLastOnlineMapper := &mapper.ProfileUpdateLastOnlineMapper{}
+		updateLastOnlineRequest := updateLastOnlineMapper.MapToAddRequest(telegramUserId)
+		err := unitOfWork.ProfileRepository().UpdateLastOnline(ctx, updateLastOnlineRequest)
+		if err != nil {
+			errorMessage := s.getErrorMessage("updateLastOnline",
+				"ProfileRepository().UpdateLastOnline")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		return nil
+	})
+}
+
+func (s *ProfileService) updateNavigator(ctx context.Context, telegramUserId string, countryCode, countryName,
+	city *string, longitude float64, latitude float64) (*response.ResponseDto, error) {
+	err := s.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		navigatorMapper := &mapper.NavigatorMapper{}
+		navigatorRequest := navigatorMapper.MapToUpdateRequest(telegramUserId, countryCode, countryName, city, longitude,
+			latitude)
+		navigatorExists, err := unitOfWork.NavigatorRepository().CheckNavigatorExists(ctx, telegramUserId)
+		if err != nil {
+			errorMessage := s.getErrorMessage("updateNavigator",
+				"NavigatorRepository().CheckNavigatorExists")
+			s.logger.Debug(errorMessage, zap.Error(err))
+			return err
+		}
+		if navigatorExists != nil {
+			if countryCode != nil && countryName != nil && city != nil {
+				_, err := unitOfWork.NavigatorRepository().Update(ctx, navigatorRequest)
+				if err != nil {
+					errorMessage := s.getErrorMessage("updateNavigator",
+						"NavigatorRepository().Update")
+					s.logger.Debug(errorMessage, zap.Error(err))
+					return err
+				}
+			} else {
+				_, err := unitOfWork.NavigatorRepository().UpdateCoordinates(ctx, navigatorRequest)
+				if err != nil {
+					errorMessage := s.getErrorMessage("updateNavigator",
+						"NavigatorRepository().UpdateCoordinates")
+					s.logger.Debug(errorMessage, zap.Error(err))
+					return err
+				}
 			}
 		} else {
-			_, err := s.navigatorRepository.UpdateCoordinates(ctx, navigatorRequest)
+			navigatorRequest := navigatorMapper.MapToAddRequest(telegramUserId, countryCode, countryName, city, longitude,
+				latitude)
+			_, err = unitOfWork.NavigatorRepository().Add(ctx, navigatorRequest)
 			if err != nil {
 				errorMessage := s.getErrorMessage("updateNavigator",
-					"navigatorRepository.UpdateCoordinates")
+					"NavigatorRepository().Add")
 				s.logger.Debug(errorMessage, zap.Error(err))
-				return nil, err
+				return err
 			}
 		}
-	} else {
-		navigatorRequest := navigatorMapper.MapToAddRequest(telegramUserId, countryCode, countryName, city, longitude,
-			latitude)
-		_, err = s.navigatorRepository.Add(ctx, navigatorRequest)
-		if err != nil {
-			errorMessage := s.getErrorMessage("updateNavigator",
-				"navigatorRepository.Add")
-			s.logger.Debug(errorMessage, zap.Error(err))
-			return nil, err
-		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	tx.Commit()
 	navigatorResponse := &response.ResponseDto{
 		Success: true,
 	}
@@ -1379,7 +2524,14 @@ func (s *ProfileService) getErrorMessage(repositoryMethodName string, callMethod
 }
 
 func (s *ProfileService) CheckProfileExists(ctx context.Context, telegramUserId string) error {
-	p, err := s.statusRepository.CheckProfileExists(ctx, telegramUserId)
+	return s.checkProfileExists(ctx, s.statusRepository, telegramUserId)
+}
+
+// checkProfileExists - checks the profile by the status repository, inside a transaction
+// the repository of the unit of work is passed
+func (s *ProfileService) checkProfileExists(
+	ctx context.Context, statusRepository StatusRepository, telegramUserId string) error {
+	p, err := statusRepository.CheckProfileExists(ctx, telegramUserId)
 	if err != nil {
 		errorMessage := s.getErrorMessage("CheckProfileExists",
 			"statusRepository.CheckProfileExists")
@@ -1394,11 +2546,6 @@ func (s *ProfileService) CheckProfileExists(ctx context.Context, telegramUserId
 	return nil
 }
 
-func (s *ProfileService) checkNavigatorExists(
-	ctx context.Context, telegramUserId string) (*response.ResponseDto, error) {
-	return s.navigatorRepository.CheckNavigatorExists(ctx, telegramUserId)
-}
-
 func (s *ProfileService) GetMessageLike(locale string) string {
 	switch locale {
 	case "ru":
@@ -1455,3 +2602,60 @@ func (s *ProfileService) GetMessageLike(locale string) string {
 		return "There is sympathy! Start communicating"
 	}
 }
+
+func (s *ProfileService) GetMessageLikeReceived(locale string) string {
+	switch locale {
+	case "ru":
+		return "Вы кому-то понравились!"
+	case "en":
+		return "Someone liked you!"
+	case "ar":
+		return "أعجب بك شخص ما!"
+	case "be":
+		return "Вы камусьці спадабаліся!"
+	case "ca":
+		return "Has agradat a algú!"
+	case "cs":
+		return "Někomu se líbíte!"
+	case "de":
+		return "Jemand mag dich!"
+	case "es":
+		return "¡Le gustas a alguien!"
+	case "fi":
+		return "Joku tykkäsi sinusta!"
+	case "fr":
+		return "Quelqu'un vous a aimé !"
+	case "he":
+		return "מישהו אהב אותך!"
+	case "hi":
+		return "किसी ने आपको पसंद किया!"
+	case "hr":
+		return "Netko te lajkao!"
+	case "hu":
+		return "Valaki kedvel téged!"
+	case "id":
+		return "Seseorang menyukaimu!"
+	case "it":
+		return "Piaci a qualcuno!"
+	case "ja":
+		return "誰かがあなたにいいねしました！"
+	case "kk":
+		return "Сізді біреу ұнатты!"
+	case "ko":
+		return "누군가 당신을 좋아합니다!"
+	case "nl":
+		return "Iemand vindt je leuk!"
+	case "no":
+		return "Noen liker deg!"
+	case "pt":
+		return "Alguém gostou de você!"
+	case "sv":
+		return "Någon gillar dig!"
+	case "uk":
+		return "Ви комусь сподобалися!"
+	case "zh":
+		return "有人喜欢你！"
+	default:
+		return "Someone liked you!"
+	}
+}
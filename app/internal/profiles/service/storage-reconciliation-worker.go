@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorFilePathStorageReconciliationWorker = "internal/profiles/service/storage-reconciliation-worker.go"
+	storageReconciliationPrefix              = "/profiles/"
+)
+
+// StorageReconciliationWorker - compares the objects of the storage with the image variants in the database.
+// An object without a variant is orphaned and is deleted unless the run is dry. A variant without an object
+// is only reported, the image stays for the review. The objects and the variants younger than the grace period
+// are skipped, as they may belong to an upload that is not finished yet
+type StorageReconciliationWorker struct {
+	logger      logger.Logger
+	uwf         *UnitOfWorkFactory
+	storage     ObjectStorage
+	gracePeriod time.Duration
+}
+
+func NewStorageReconciliationWorker(l logger.Logger, uwf *UnitOfWorkFactory, obs ObjectStorage,
+	gracePeriod time.Duration) *StorageReconciliationWorker {
+	return &StorageReconciliationWorker{
+		logger:      l,
+		uwf:         uwf,
+		storage:     obs,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Run - reconciles the storage every interval until ctx is canceled
+func (w *StorageReconciliationWorker) Run(ctx context.Context, interval time.Duration, isDryRun bool) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := w.Reconcile(ctx, isDryRun); err != nil {
+				errorMessage := w.getErrorMessage("Run", "Reconcile")
+				w.logger.Debug(errorMessage, zap.Error(err))
+			}
+		}
+	}
+}
+
+// Reconcile - runs one reconciliation and logs its report
+func (w *StorageReconciliationWorker) Reconcile(
+	ctx context.Context, isDryRun bool) (*entity.StorageReconciliationReportEntity, error) {
+	threshold := time.Now().UTC().Add(-w.gracePeriod)
+	// The objects are listed before the variants are selected: an upload that is finished in between
+	// has its objects and its variants younger than the threshold, so it is skipped on both sides
+	objectList, err := w.storage.List(ctx, storageReconciliationPrefix)
+	if err != nil {
+		errorMessage := w.getErrorMessage("Reconcile", "storage.List")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	var imageObjectList []*entity.ImageObjectEntity
+	err = w.uwf.WithinTransaction(ctx, func(unitOfWork *UnitOfWork) error {
+		imageObjectList, err = unitOfWork.ImageVariantRepository().SelectObjectList(ctx)
+		return err
+	})
+	if err != nil {
+		errorMessage := w.getErrorMessage("Reconcile", "ImageVariantRepository().SelectObjectList")
+		w.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	report := w.compare(objectList, imageObjectList, threshold)
+	report.IsDryRun = isDryRun
+	if !isDryRun {
+		for _, object := range report.OrphanedObjects {
+			if err := w.storage.Delete(ctx, object.Key); err != nil {
+				errorMessage := w.getErrorMessage("Reconcile", "storage.Delete")
+				w.logger.Debug(errorMessage, zap.Error(err), zap.String("key", object.Key))
+				continue
+			}
+			report.DeletedCount++
+		}
+	}
+	w.logReport(report)
+	return report, nil
+}
+
+func (w *StorageReconciliationWorker) compare(objectList []*entity.StorageObjectEntity,
+	imageObjectList []*entity.ImageObjectEntity, threshold time.Time) *entity.StorageReconciliationReportEntity {
+	objectKeys := make(map[string]struct{}, len(objectList))
+	for _, object := range objectList {
+		objectKeys[object.Key] = struct{}{}
+	}
+	imageKeys := make(map[string]struct{}, len(imageObjectList))
+	for _, imageObject := range imageObjectList {
+		imageKeys[imageObject.Key] = struct{}{}
+	}
+	report := &entity.StorageReconciliationReportEntity{
+		ObjectCount:     len(objectList),
+		ImageKeyCount:   len(imageObjectList),
+		OrphanedObjects: make([]*entity.StorageObjectEntity, 0),
+		MissingObjects:  make([]*entity.ImageObjectEntity, 0),
+	}
+	for _, object := range objectList {
+		if _, ok := imageKeys[object.Key]; !ok && object.LastModified.Before(threshold) {
+			report.OrphanedObjects = append(report.OrphanedObjects, object)
+		}
+	}
+	for _, imageObject := range imageObjectList {
+		if _, ok := objectKeys[imageObject.Key]; !ok && imageObject.CreatedAt.Before(threshold) {
+			report.MissingObjects = append(report.MissingObjects, imageObject)
+		}
+	}
+	return report
+}
+
+func (w *StorageReconciliationWorker) logReport(report *entity.StorageReconciliationReportEntity) {
+	for _, object := range report.OrphanedObjects {
+		w.logger.Info("storage reconciliation: orphaned object",
+			zap.String("key", object.Key), zap.Time("lastModified", object.LastModified))
+	}
+	for _, imageObject := range report.MissingObjects {
+		w.logger.Info("storage reconciliation: missing object",
+			zap.Uint64("imageId", imageObject.ImageId), zap.String("key", imageObject.Key))
+	}
+	w.logger.Info("storage reconciliation finished",
+		zap.Bool("isDryRun", report.IsDryRun),
+		zap.Int("objectCount", report.ObjectCount),
+		zap.Int("imageKeyCount", report.ImageKeyCount),
+		zap.Int("orphanedCount", len(report.OrphanedObjects)),
+		zap.Int("missingCount", len(report.MissingObjects)),
+		zap.Int("deletedCount", report.DeletedCount))
+}
+
+func (w *StorageReconciliationWorker) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathStorageReconciliationWorker)
+}
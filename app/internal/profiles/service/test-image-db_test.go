@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/dto/response"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var errTestImageDBNotSupported = errors.New("query is not supported by the test database")
+
+// testImageDB - a database/sql driver that keeps the images in memory and answers the queries
+// of psql.ImageRepository used to delete an image. The rows are deleted on the commit
+type testImageDB struct {
+	mutex  sync.Mutex
+	images map[uint64]*testImageRow
+}
+
+type testImageRow struct {
+	telegramUserId string
+	name           string
+	variants       []*response.ImageVariantResponseDto
+}
+
+func newTestImageDB() *testImageDB {
+	return &testImageDB{
+		images: make(map[uint64]*testImageRow),
+	}
+}
+
+// Open - returns the *sql.DB whose connections share the images of the test database
+func (db *testImageDB) Open() *sql.DB {
+	return sql.OpenDB(&testImageConnector{db: db})
+}
+
+func (db *testImageDB) Exists(id uint64) bool {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	_, ok := db.images[id]
+	return ok
+}
+
+type testImageConnector struct {
+	db *testImageDB
+}
+
+func (c *testImageConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &testImageConn{db: c.db}, nil
+}
+
+func (c *testImageConnector) Driver() driver.Driver {
+	return testImageDriver{}
+}
+
+type testImageDriver struct{}
+
+func (testImageDriver) Open(name string) (driver.Conn, error) {
+	return nil, errTestImageDBNotSupported
+}
+
+type testImageConn struct {
+	db         *testImageDB
+	deletedIds []uint64
+}
+
+func (c *testImageConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("%w: %s", errTestImageDBNotSupported, query)
+}
+
+func (c *testImageConn) Close() error {
+	return nil
+}
+
+func (c *testImageConn) Begin() (driver.Tx, error) {
+	c.deletedIds = nil
+	return c, nil
+}
+
+func (c *testImageConn) Commit() error {
+	c.db.mutex.Lock()
+	defer c.db.mutex.Unlock()
+	for _, id := range c.deletedIds {
+		delete(c.db.images, id)
+	}
+	c.deletedIds = nil
+	return nil
+}
+
+func (c *testImageConn) Rollback() error {
+	c.deletedIds = nil
+	return nil
+}
+
+func (c *testImageConn) ExecContext(
+	ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if !strings.HasPrefix(query, "DELETE FROM dating.profile_images WHERE id = $1") {
+		return nil, fmt.Errorf("%w: %s", errTestImageDBNotSupported, query)
+	}
+	c.deletedIds = append(c.deletedIds, uint64(args[0].Value.(int64)))
+	return driver.RowsAffected(1), nil
+}
+
+func (c *testImageConn) QueryContext(
+	ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.mutex.Lock()
+	defer c.db.mutex.Unlock()
+	switch {
+	case strings.HasPrefix(query, "SELECT pi.id, pi.telegram_user_id, pi.name, pi.url") &&
+		strings.HasSuffix(query, "WHERE pi.id = $1"):
+		id := uint64(args[0].Value.(int64))
+		rows := &testImageRows{columns: []string{"id", "telegram_user_id", "name", "url", "size", "is_blocked",
+			"is_primary", "is_private", "created_at", "updated_at"}}
+		if image, ok := c.db.images[id]; ok {
+			now := time.Now().UTC()
+			rows.values = append(rows.values, []driver.Value{int64(id), image.telegramUserId, image.name, "",
+				int64(0), false, false, false, now, now})
+		}
+		return rows, nil
+	case strings.HasPrefix(query, "SELECT image_id, variant, key, url, width, height"):
+		rows := &testImageRows{columns: []string{"image_id", "variant", "key", "url", "width", "height"}}
+		// The ids are passed as pq.Array, e.g. {1,2}
+		for _, s := range strings.Split(strings.Trim(args[0].Value.(string), "{}"), ",") {
+			id, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			image, ok := c.db.images[id]
+			if !ok {
+				continue
+			}
+			for _, variant := range image.variants {
+				rows.values = append(rows.values, []driver.Value{int64(id), string(variant.Variant), variant.Key,
+					variant.Url, int64(variant.Width), int64(variant.Height)})
+			}
+		}
+		return rows, nil
+	}
+	return nil, fmt.Errorf("%w: %s", errTestImageDBNotSupported, query)
+}
+
+type testImageRows struct {
+	columns []string
+	values  [][]driver.Value
+	index   int
+}
+
+func (r *testImageRows) Columns() []string {
+	return r.columns
+}
+
+func (r *testImageRows) Close() error {
+	return nil
+}
+
+func (r *testImageRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.index])
+	r.index++
+	return nil
+}
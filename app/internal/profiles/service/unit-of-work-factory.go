@@ -1,9 +1,16 @@
 package service
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/logger"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/repository/psql"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathUnitOfWorkFactory = "internal/profiles/service/unit-of-work-factory.go"
 )
 
 type UnitOfWorkFactory struct {
@@ -18,25 +25,80 @@ func NewUnitOfWorkFactory(log logger.Logger, db *sql.DB) *UnitOfWorkFactory {
 	}
 }
 
-func (factory *UnitOfWorkFactory) CreateUnit() *UnitOfWork {
-	tx, err := factory.db.Begin()
+// CreateUnit - begins a transaction and returns the repositories bound to it
+func (factory *UnitOfWorkFactory) CreateUnit(ctx context.Context) (*UnitOfWork, error) {
+	tx, err := factory.db.BeginTx(ctx, nil)
 	if err != nil {
-		panic(err)
+		errorMessage := factory.getErrorMessage("CreateUnit", "BeginTx")
+		factory.logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
 	}
-
 	return NewUnitOfWork(
 		tx,
-		psql.NewBlockRepository(factory.logger, factory.db),
-		psql.NewComplaintRepository(factory.logger, factory.db),
-		psql.NewFilterRepository(factory.logger, factory.db),
-		psql.NewImageRepository(factory.logger, factory.db),
-		psql.NewImageStatusRepository(factory.logger, factory.db),
-		psql.NewLikeRepository(factory.logger, factory.db),
-		psql.NewNavigatorRepository(factory.logger, factory.db),
-		psql.NewProfileRepository(factory.logger, factory.db),
-		psql.NewTelegramRepository(factory.logger, factory.db),
-		psql.NewStatusRepository(factory.logger, factory.db),
-		psql.NewPaymentRepository(factory.logger, factory.db),
-		psql.NewSettingsRepository(factory.logger, factory.db),
-	)
+		psql.NewAdminRepository(factory.logger, tx),
+		psql.NewAdminAuditRepository(factory.logger, tx),
+		psql.NewBlockRepository(factory.logger, tx),
+		psql.NewComplaintRepository(factory.logger, tx),
+		psql.NewComplaintAppealRepository(factory.logger, tx),
+		psql.NewConversationRepository(factory.logger, tx),
+		psql.NewFilterRepository(factory.logger, tx),
+		psql.NewImageRepository(factory.logger, tx),
+		psql.NewImageStatusRepository(factory.logger, tx),
+		psql.NewImageHashBlocklistRepository(factory.logger, tx),
+		psql.NewImageGrantRepository(factory.logger, tx),
+		psql.NewImageVariantRepository(factory.logger, tx),
+		psql.NewLikeRepository(factory.logger, tx),
+		psql.NewMatchRepository(factory.logger, tx),
+		psql.NewMessageRepository(factory.logger, tx),
+		psql.NewNavigatorRepository(factory.logger, tx),
+		psql.NewOutboxRepository(factory.logger, tx),
+		psql.NewProfileRepository(factory.logger, tx),
+		psql.NewProfileListSnapshotRepository(factory.logger, tx),
+		psql.NewRefreshTokenRepository(factory.logger, tx),
+		psql.NewTelegramRepository(factory.logger, tx),
+		psql.NewStatusRepository(factory.logger, tx),
+		psql.NewPaymentRepository(factory.logger, tx),
+		psql.NewSettingsRepository(factory.logger, tx),
+	), nil
+}
+
+// WithinTransaction - runs fn inside a single transaction. The transaction is committed
+// when fn returns nil and rolled back when fn returns an error or panics
+func (factory *UnitOfWorkFactory) WithinTransaction(
+	ctx context.Context, fn func(unitOfWork *UnitOfWork) error) error {
+	unitOfWork, err := factory.CreateUnit(ctx)
+	if err != nil {
+		return err
+	}
+	if err := factory.run(ctx, unitOfWork, fn); err != nil {
+		if rollbackErr := unitOfWork.Rollback(ctx); rollbackErr != nil {
+			errorMessage := factory.getErrorMessage("WithinTransaction", "Rollback")
+			factory.logger.Debug(errorMessage, zap.Error(rollbackErr))
+		}
+		return err
+	}
+	// A failed commit ends the transaction, so there is nothing to roll back
+	if err := unitOfWork.Commit(ctx); err != nil {
+		errorMessage := factory.getErrorMessage("WithinTransaction", "Commit")
+		factory.logger.Debug(errorMessage, zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// run - calls fn and rolls the transaction back if fn panics
+func (factory *UnitOfWorkFactory) run(
+	ctx context.Context, unitOfWork *UnitOfWork, fn func(unitOfWork *UnitOfWork) error) error {
+	defer func() {
+		if p := recover(); p != nil {
+			_ = unitOfWork.Rollback(ctx)
+			panic(p)
+		}
+	}()
+	return fn(unitOfWork)
+}
+
+func (factory *UnitOfWorkFactory) getErrorMessage(repositoryMethodName, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathUnitOfWorkFactory)
 }
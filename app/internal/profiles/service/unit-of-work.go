@@ -6,52 +6,96 @@ import (
 )
 
 type UnitOfWork struct {
-	tx                    *sql.Tx
-	blockRepository       BlockRepository
-	complaintRepository   ComplaintRepository
-	filterRepository      FilterRepository
-	imageRepository       ImageRepository
-	imageStatusRepository ImageStatusRepository
-	likeRepository        LikeRepository
-	navigatorRepository   NavigatorRepository
-	profileRepository     ProfileRepository
-	telegramRepository    TelegramRepository
-	statusRepository      StatusRepository
-	paymentRepository     PaymentRepository
-	settingsRepository    SettingsRepository
+	tx                            *sql.Tx
+	adminRepository               AdminRepository
+	adminAuditRepository          AdminAuditRepository
+	blockRepository               BlockRepository
+	complaintRepository           ComplaintRepository
+	complaintAppealRepository     ComplaintAppealRepository
+	conversationRepository        ConversationRepository
+	filterRepository              FilterRepository
+	imageRepository               ImageRepository
+	imageStatusRepository         ImageStatusRepository
+	imageHashBlocklistRepository  ImageHashBlocklistRepository
+	imageGrantRepository          ImageGrantRepository
+	imageVariantRepository        ImageVariantRepository
+	likeRepository                LikeRepository
+	matchRepository               MatchRepository
+	messageRepository             MessageRepository
+	navigatorRepository           NavigatorRepository
+	outboxRepository              OutboxRepository
+	profileRepository             ProfileRepository
+	profileListSnapshotRepository ProfileListSnapshotRepository
+	refreshTokenRepository        RefreshTokenRepository
+	telegramRepository            TelegramRepository
+	statusRepository              StatusRepository
+	paymentRepository             PaymentRepository
+	settingsRepository            SettingsRepository
 }
 
 func NewUnitOfWork(
 	tx *sql.Tx,
+	ar AdminRepository,
+	aar AdminAuditRepository,
 	br BlockRepository,
 	cr ComplaintRepository,
+	car ComplaintAppealRepository,
+	cvr ConversationRepository,
 	fr FilterRepository,
 	ir ImageRepository,
 	isr ImageStatusRepository,
+	ihr ImageHashBlocklistRepository,
+	igr ImageGrantRepository,
+	ivr ImageVariantRepository,
 	lr LikeRepository,
+	mr MatchRepository,
+	msr MessageRepository,
 	nr NavigatorRepository,
+	obr OutboxRepository,
 	pr ProfileRepository,
+	plsr ProfileListSnapshotRepository,
+	rtr RefreshTokenRepository,
 	tr TelegramRepository,
 	sr StatusRepository,
 	pa PaymentRepository,
 	str SettingsRepository) *UnitOfWork {
 	return &UnitOfWork{
-		tx:                    tx,
-		blockRepository:       br,
-		complaintRepository:   cr,
-		filterRepository:      fr,
-		imageRepository:       ir,
-		imageStatusRepository: isr,
-		likeRepository:        lr,
-		navigatorRepository:   nr,
-		profileRepository:     pr,
-		telegramRepository:    tr,
-		statusRepository:      sr,
-		paymentRepository:     pa,
-		settingsRepository:    str,
+		tx:                            tx,
+		adminRepository:               ar,
+		adminAuditRepository:          aar,
+		blockRepository:               br,
+		complaintRepository:           cr,
+		complaintAppealRepository:     car,
+		conversationRepository:        cvr,
+		filterRepository:              fr,
+		imageRepository:               ir,
+		imageStatusRepository:         isr,
+		imageHashBlocklistRepository:  ihr,
+		imageGrantRepository:          igr,
+		imageVariantRepository:        ivr,
+		likeRepository:                lr,
+		matchRepository:               mr,
+		messageRepository:             msr,
+		navigatorRepository:           nr,
+		outboxRepository:              obr,
+		profileRepository:             pr,
+		profileListSnapshotRepository: plsr,
+		refreshTokenRepository:        rtr,
+		telegramRepository:            tr,
+		statusRepository:              sr,
+		paymentRepository:             pa,
+		settingsRepository:            str,
 	}
 }
 
+func (unit *UnitOfWork) AdminRepository() AdminRepository {
+	return unit.adminRepository
+}
+
+func (unit *UnitOfWork) AdminAuditRepository() AdminAuditRepository {
+	return unit.adminAuditRepository
+}
+
 func (unit *UnitOfWork) BlockRepository() BlockRepository {
 	return unit.blockRepository
 }
@@ -60,6 +104,14 @@ func (unit *UnitOfWork) ComplaintRepository() ComplaintRepository {
 	return unit.complaintRepository
 }
 
+func (unit *UnitOfWork) ComplaintAppealRepository() ComplaintAppealRepository {
+	return unit.complaintAppealRepository
+}
+
+func (unit *UnitOfWork) ConversationRepository() ConversationRepository {
+	return unit.conversationRepository
+}
+
 func (unit *UnitOfWork) FilterRepository() FilterRepository {
 	return unit.filterRepository
 }
@@ -72,18 +124,50 @@ func (unit *UnitOfWork) ImageStatusRepository() ImageStatusRepository {
 	return unit.imageStatusRepository
 }
 
+func (unit *UnitOfWork) ImageHashBlocklistRepository() ImageHashBlocklistRepository {
+	return unit.imageHashBlocklistRepository
+}
+
+func (unit *UnitOfWork) ImageGrantRepository() ImageGrantRepository {
+	return unit.imageGrantRepository
+}
+
+func (unit *UnitOfWork) ImageVariantRepository() ImageVariantRepository {
+	return unit.imageVariantRepository
+}
+
 func (unit *UnitOfWork) LikeRepository() LikeRepository {
 	return unit.likeRepository
 }
 
+func (unit *UnitOfWork) MatchRepository() MatchRepository {
+	return unit.matchRepository
+}
+
+func (unit *UnitOfWork) MessageRepository() MessageRepository {
+	return unit.messageRepository
+}
+
 func (unit *UnitOfWork) NavigatorRepository() NavigatorRepository {
 	return unit.navigatorRepository
 }
 
+func (unit *UnitOfWork) OutboxRepository() OutboxRepository {
+	return unit.outboxRepository
+}
+
 func (unit *UnitOfWork) ProfileRepository() ProfileRepository {
 	return unit.profileRepository
 }
 
+func (unit *UnitOfWork) ProfileListSnapshotRepository() ProfileListSnapshotRepository {
+	return unit.profileListSnapshotRepository
+}
+
+func (unit *UnitOfWork) RefreshTokenRepository() RefreshTokenRepository {
+	return unit.refreshTokenRepository
+}
+
 func (unit *UnitOfWork) TelegramRepository() TelegramRepository {
 	return unit.telegramRepository
 }
@@ -0,0 +1,20 @@
+package enum
+
+type AdminAction string
+
+const (
+	AdminActionGetComplaintList AdminAction = "get_complaint_list"
+	AdminActionGetProfile       AdminAction = "get_profile"
+	AdminActionBlockProfile     AdminAction = "block_profile"
+	AdminActionUnblockProfile   AdminAction = "unblock_profile"
+	AdminActionBlockImage       AdminAction = "block_image"
+	AdminActionUnblockImage     AdminAction = "unblock_image"
+	AdminActionReviewComplaints AdminAction = "review_complaints"
+	AdminActionGetAuditList     AdminAction = "get_audit_list"
+)
+
+func (a AdminAction) IsValid() bool {
+	return a == AdminActionGetComplaintList || a == AdminActionGetProfile || a == AdminActionBlockProfile ||
+		a == AdminActionUnblockProfile || a == AdminActionBlockImage || a == AdminActionUnblockImage ||
+		a == AdminActionReviewComplaints || a == AdminActionGetAuditList
+}
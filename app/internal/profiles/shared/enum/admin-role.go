@@ -0,0 +1,28 @@
+package enum
+
+type AdminRole string
+
+const (
+	AdminRoleModerator AdminRole = "moderator"
+	AdminRoleSupport   AdminRole = "support"
+	AdminRoleOwner     AdminRole = "owner"
+)
+
+func (r AdminRole) IsValid() bool {
+	return r == AdminRoleModerator || r == AdminRoleSupport || r == AdminRoleOwner
+}
+
+// IsAllowed - support only reads the complaints and the profiles, moderator also blocks the users
+// and the images, owner can do everything including reading the audit trail
+func (r AdminRole) IsAllowed(action AdminAction) bool {
+	switch r {
+	case AdminRoleOwner:
+		return action.IsValid()
+	case AdminRoleModerator:
+		return action.IsValid() && action != AdminActionGetAuditList
+	case AdminRoleSupport:
+		return action == AdminActionGetComplaintList || action == AdminActionGetProfile
+	default:
+		return false
+	}
+}
@@ -0,0 +1,13 @@
+package enum
+
+type ComplaintAppealStatus string
+
+const (
+	ComplaintAppealStatusOpen     ComplaintAppealStatus = "open"
+	ComplaintAppealStatusAccepted ComplaintAppealStatus = "accepted"
+	ComplaintAppealStatusRejected ComplaintAppealStatus = "rejected"
+)
+
+func (s ComplaintAppealStatus) IsValid() bool {
+	return s == ComplaintAppealStatusOpen || s == ComplaintAppealStatusAccepted || s == ComplaintAppealStatusRejected
+}
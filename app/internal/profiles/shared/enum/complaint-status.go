@@ -0,0 +1,30 @@
+package enum
+
+type ComplaintStatus string
+
+const (
+	ComplaintStatusOpen        ComplaintStatus = "open"
+	ComplaintStatusUnderReview ComplaintStatus = "under_review"
+	ComplaintStatusUpheld      ComplaintStatus = "upheld"
+	ComplaintStatusDismissed   ComplaintStatus = "dismissed"
+)
+
+func (s ComplaintStatus) IsValid() bool {
+	return s == ComplaintStatusOpen || s == ComplaintStatusUnderReview || s == ComplaintStatusUpheld ||
+		s == ComplaintStatusDismissed
+}
+
+// CanTransitionTo - open complaints go to review once the threshold of their reason is reached,
+// a moderator upholds or dismisses them, an appeal of the blocked user reopens the upheld ones
+func (s ComplaintStatus) CanTransitionTo(next ComplaintStatus) bool {
+	switch s {
+	case ComplaintStatusOpen:
+		return next == ComplaintStatusUnderReview
+	case ComplaintStatusUnderReview:
+		return next == ComplaintStatusUpheld || next == ComplaintStatusDismissed
+	case ComplaintStatusUpheld:
+		return next == ComplaintStatusUnderReview
+	default:
+		return false
+	}
+}
@@ -0,0 +1,16 @@
+package enum
+
+type ImageModerationStatus string
+
+const (
+	ImageModerationStatusPending  ImageModerationStatus = "pending"
+	ImageModerationStatusApproved ImageModerationStatus = "approved"
+	ImageModerationStatusBlocked  ImageModerationStatus = "blocked"
+	// ImageModerationStatusFailed - the image couldn't be loaded or checked after all the attempts
+	ImageModerationStatusFailed ImageModerationStatus = "failed"
+)
+
+func (s ImageModerationStatus) IsValid() bool {
+	return s == ImageModerationStatusPending || s == ImageModerationStatusApproved ||
+		s == ImageModerationStatusBlocked || s == ImageModerationStatusFailed
+}
@@ -0,0 +1,28 @@
+package enum
+
+type ImageVariant string
+
+const (
+	ImageVariantThumbnail ImageVariant = "thumbnail"
+	ImageVariantMedium    ImageVariant = "medium"
+	ImageVariantFull      ImageVariant = "full"
+)
+
+// ImageVariants - the variants generated for every uploaded image
+var ImageVariants = []ImageVariant{ImageVariantThumbnail, ImageVariantMedium, ImageVariantFull}
+
+func (v ImageVariant) IsValid() bool {
+	return v == ImageVariantThumbnail || v == ImageVariantMedium || v == ImageVariantFull
+}
+
+// MaxWidth - the width the variant is scaled down to, a smaller image is never enlarged
+func (v ImageVariant) MaxWidth() int {
+	switch v {
+	case ImageVariantThumbnail:
+		return 320
+	case ImageVariantMedium:
+		return 800
+	default:
+		return 1920
+	}
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidKey     = errors.New("object key is outside of the storage")
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+// LocalStorage - stores the objects as the files of a directory, it is used for the local development.
+// The directory is expected to be served by baseUrl, e.g. by the static handler of the http server
+type LocalStorage struct {
+	directory string
+	baseUrl   string
+}
+
+func NewLocalStorage(directory, baseUrl string) *LocalStorage {
+	return &LocalStorage{
+		directory: directory,
+		baseUrl:   strings.TrimSuffix(baseUrl, "/"),
+	}
+}
+
+func (s *LocalStorage) Upload(ctx context.Context, key string, body io.Reader, contentType string) error {
+	filePath, err := s.getFilePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, body); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.getFilePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := s.getFilePath(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *LocalStorage) Url(key string) string {
+	return s.baseUrl + "/" + strings.TrimPrefix(key, "/")
+}
+
+// PresignGetUrl - the files are served without the credentials, so the plain url is returned
+func (s *LocalStorage) PresignGetUrl(ctx context.Context, key string, expires time.Duration) (string, error) {
+	return s.Url(key), nil
+}
+
+// SetPublic - the files are served without the credentials, so the access can't be restricted
+func (s *LocalStorage) SetPublic(ctx context.Context, key string, isPublic bool) error {
+	_, err := s.getFilePath(key)
+	return err
+}
+
+// List - walks the files of the directory, the keys are the paths of the files relative to it
+func (s *LocalStorage) List(ctx context.Context, prefix string) ([]*entity.StorageObjectEntity, error) {
+	list := make([]*entity.StorageObjectEntity, 0)
+	err := filepath.WalkDir(s.directory, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && filePath == s.directory {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		relativePath, err := filepath.Rel(s.directory, filePath)
+		if err != nil {
+			return err
+		}
+		key := "/" + filepath.ToSlash(relativePath)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		list = append(list, &entity.StorageObjectEntity{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime().UTC(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// getFilePath - the key is cleaned as an absolute path, so it can't point outside of the directory
+func (s *LocalStorage) getFilePath(key string) (string, error) {
+	cleanKey := filepath.Clean("/" + key)
+	if cleanKey == "/" {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.directory, cleanKey), nil
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorageUploadDelete(t *testing.T) {
+	ctx := context.Background()
+	directory := t.TempDir()
+	s := NewLocalStorage(directory, "http://localhost/static/")
+	key := "/profiles/1/images/photo/full.webp"
+	if err := s.Upload(ctx, key, strings.NewReader("image"), "image/webp"); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(directory, "profiles", "1", "images", "photo", "full.webp"))
+	if err != nil || string(content) != "image" {
+		t.Fatalf("uploaded file = %q, %v", content, err)
+	}
+	if url := s.Url(key); url != "http://localhost/static/profiles/1/images/photo/full.webp" {
+		t.Errorf("Url = %q", url)
+	}
+	list, err := s.List(ctx, "/profiles/1/")
+	if err != nil || len(list) != 1 || list[0].Key != key || list[0].Size != 5 {
+		t.Fatalf("List = %v, %v", list, err)
+	}
+	body, err := s.Download(ctx, key)
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	content, err = io.ReadAll(body)
+	body.Close()
+	if err != nil || string(content) != "image" {
+		t.Errorf("downloaded content = %q, %v", content, err)
+	}
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if list, _ := s.List(ctx, "/profiles/"); len(list) != 0 {
+		t.Errorf("List after Delete = %v", list)
+	}
+	if _, err := s.Download(ctx, key); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("Download of a missing object = %v, want %v", err, ErrObjectNotFound)
+	}
+	// A missing object is already deleted
+	if err := s.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing object: %v", err)
+	}
+}
+
+func TestLocalStorageListMissingDirectory(t *testing.T) {
+	s := NewLocalStorage(filepath.Join(t.TempDir(), "missing"), "")
+	list, err := s.List(context.Background(), "/")
+	if err != nil || len(list) != 0 {
+		t.Errorf("List = %v, %v", list, err)
+	}
+}
+
+func TestLocalStoragePathTraversal(t *testing.T) {
+	ctx := context.Background()
+	parent := t.TempDir()
+	directory := filepath.Join(parent, "storage")
+	s := NewLocalStorage(directory, "")
+	outside := filepath.Join(parent, "outside.webp")
+	if err := os.WriteFile(outside, []byte("outside"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"../outside.webp", "/../outside.webp", "/profiles/../../outside.webp"} {
+		if err := s.Upload(ctx, key, strings.NewReader("image"), "image/webp"); err != nil {
+			t.Fatalf("Upload(%q): %v", key, err)
+		}
+		content, err := os.ReadFile(outside)
+		if err != nil || string(content) != "outside" {
+			t.Fatalf("Upload(%q) has overwritten the file outside of the storage", key)
+		}
+		if _, err := os.Stat(filepath.Join(directory, "outside.webp")); err != nil {
+			t.Errorf("Upload(%q) has not written inside the storage: %v", key, err)
+		}
+		if err := s.Delete(ctx, key); err != nil {
+			t.Fatalf("Delete(%q): %v", key, err)
+		}
+		if _, err := os.Stat(outside); err != nil {
+			t.Fatalf("Delete(%q) has deleted the file outside of the storage", key)
+		}
+	}
+	for _, key := range []string{"", "/", "..", "/.."} {
+		if err := s.Upload(ctx, key, strings.NewReader("image"), "image/webp"); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Upload(%q) = %v, want ErrInvalidKey", key, err)
+		}
+		if err := s.Delete(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Delete(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+}
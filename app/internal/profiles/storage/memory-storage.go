@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryStorage - keeps the objects in memory, it is used to run the service without a bucket, e.g. in tests
+type MemoryStorage struct {
+	mutex   sync.RWMutex
+	objects map[string]*memoryObject
+	baseUrl string
+}
+
+type memoryObject struct {
+	content      []byte
+	lastModified time.Time
+	isPrivate    bool
+}
+
+func NewMemoryStorage(baseUrl string) *MemoryStorage {
+	return &MemoryStorage{
+		objects: make(map[string]*memoryObject),
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+	}
+}
+
+func (s *MemoryStorage) Upload(ctx context.Context, key string, body io.Reader, contentType string) error {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.objects[key] = &memoryObject{
+		content:      content,
+		lastModified: time.Now().UTC(),
+	}
+	return nil
+}
+
+func (s *MemoryStorage) Delete(ctx context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *MemoryStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	content, ok := s.Get(key)
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *MemoryStorage) Url(key string) string {
+	return s.baseUrl + "/" + strings.TrimPrefix(key, "/")
+}
+
+func (s *MemoryStorage) PresignGetUrl(ctx context.Context, key string, expires time.Duration) (string, error) {
+	return s.Url(key), nil
+}
+
+func (s *MemoryStorage) SetPublic(ctx context.Context, key string, isPublic bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	object, ok := s.objects[key]
+	if !ok {
+		return ErrObjectNotFound
+	}
+	object.isPrivate = !isPublic
+	return nil
+}
+
+func (s *MemoryStorage) List(ctx context.Context, prefix string) ([]*entity.StorageObjectEntity, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	list := make([]*entity.StorageObjectEntity, 0, len(s.objects))
+	for key, object := range s.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		list = append(list, &entity.StorageObjectEntity{
+			Key:          key,
+			Size:         int64(len(object.content)),
+			LastModified: object.lastModified,
+		})
+	}
+	return list, nil
+}
+
+// IsPublic - returns whether the object is readable by its public url
+func (s *MemoryStorage) IsPublic(key string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	object, ok := s.objects[key]
+	return ok && !object.isPrivate
+}
+
+// Get - returns the content of the object and whether it exists
+func (s *MemoryStorage) Get(key string) ([]byte, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, false
+	}
+	return object.content, true
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestMemoryStorageUploadDelete(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage("http://localhost/")
+	key := "/profiles/1/images/photo/full.webp"
+	if err := s.Upload(ctx, key, strings.NewReader("image"), "image/webp"); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if content, ok := s.Get(key); !ok || string(content) != "image" {
+		t.Fatalf("Get = %q, %v", content, ok)
+	}
+	if url := s.Url(key); url != "http://localhost/profiles/1/images/photo/full.webp" {
+		t.Errorf("Url = %q", url)
+	}
+	if list, err := s.List(ctx, "/profiles/2/"); err != nil || len(list) != 0 {
+		t.Errorf("List of another prefix = %v, %v", list, err)
+	}
+	list, err := s.List(ctx, "/profiles/1/")
+	if err != nil || len(list) != 1 || list[0].Key != key || list[0].Size != 5 {
+		t.Fatalf("List = %v, %v", list, err)
+	}
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, ok := s.Get(key); ok {
+		t.Error("the object exists after Delete")
+	}
+}
+
+func TestMemoryStorageSetPublic(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage("http://localhost/")
+	key := "/profiles/1/images/photo/full.webp"
+	if err := s.SetPublic(ctx, key, false); err == nil {
+		t.Error("SetPublic of a missing object succeeded")
+	}
+	if err := s.Upload(ctx, key, strings.NewReader("image"), "image/webp"); err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if !s.IsPublic(key) {
+		t.Error("an uploaded object is private")
+	}
+	if err := s.SetPublic(ctx, key, false); err != nil {
+		t.Fatalf("SetPublic: %v", err)
+	}
+	if s.IsPublic(key) {
+		t.Error("the object is public after SetPublic(false)")
+	}
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/profiles/entity"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"io"
+	"time"
+)
+
+const (
+	// s3UploadPartSize, s3UploadConcurrency - the objects are uploaded by the multipart upload,
+	// so at most s3UploadPartSize * s3UploadConcurrency bytes are buffered for one upload
+	s3UploadPartSize    = 5 * 1024 * 1024
+	s3UploadConcurrency = 2
+)
+
+type S3Config struct {
+	AccessKey    string
+	SecretKey    string
+	EndpointUrl  string
+	Region       string
+	BucketName   string
+	PublicDomain string
+}
+
+// S3Storage - stores the objects in an S3 bucket. The session and the clients are created once and reused.
+// The objects are readable by their public urls through their ACL, so the bucket must not have a public policy
+type S3Storage struct {
+	client       *s3.S3
+	uploader     *s3manager.Uploader
+	bucketName   string
+	publicDomain string
+}
+
+func NewS3Storage(cfg *S3Config) (*S3Storage, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:    aws.String(cfg.EndpointUrl),
+		Credentials: credentials.NewStaticCredentials(cfg.AccessKey, cfg.SecretKey, ""),
+		Region:      aws.String(cfg.Region),
+	})
+	if err != nil {
+		return nil, err
+	}
+	client := s3.New(sess)
+	uploader := s3manager.NewUploaderWithClient(client, func(u *s3manager.Uploader) {
+		u.PartSize = s3UploadPartSize
+		u.Concurrency = s3UploadConcurrency
+	})
+	return &S3Storage{
+		client:       client,
+		uploader:     uploader,
+		bucketName:   cfg.BucketName,
+		publicDomain: cfg.PublicDomain,
+	}, nil
+}
+
+func (s *S3Storage) Upload(ctx context.Context, key string, body io.Reader, contentType string) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+		ACL:         aws.String(s3.ObjectCannedACLPublicRead),
+	})
+	return err
+}
+
+// SetPublic - a private object is readable only by the presigned urls
+func (s *S3Storage) SetPublic(ctx context.Context, key string, isPublic bool) error {
+	acl := s3.ObjectCannedACLPrivate
+	if isPublic {
+		acl = s3.ObjectCannedACLPublicRead
+	}
+	_, err := s.client.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+		ACL:    aws.String(acl),
+	})
+	return err
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+func (s *S3Storage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *S3Storage) Url(key string) string {
+	return s.publicDomain + key
+}
+
+// List - returns all the objects whose keys start with prefix, the pages of the listing are read one by one
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]*entity.StorageObjectEntity, error) {
+	list := make([]*entity.StorageObjectEntity, 0)
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucketName),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			list = append(list, &entity.StorageObjectEntity{
+				Key:          aws.StringValue(object.Key),
+				Size:         aws.Int64Value(object.Size),
+				LastModified: aws.TimeValue(object.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// PresignGetUrl - returns the url by which the object can be downloaded without the credentials until it expires
+func (s *S3Storage) PresignGetUrl(ctx context.Context, key string, expires time.Duration) (string, error) {
+	req, _ := s.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	req.SetContext(ctx)
+	return req.Presign(expires)
+}
@@ -3,28 +3,40 @@ package app
 import (
 	"context"
 	"fmt"
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/config"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/consumer"
 	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/logger"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"time"
 )
 
 const (
 	errorFilePathApp = "internal/telegram/app/app.go"
 	bodyLimit        = 61 * 1024 * 1024 // 61 MB
+	// kafkaBatchTimeout - a dead letter is written without waiting for the default second of the batch
+	kafkaBatchTimeout = 10 * time.Millisecond
 )
 
 // App - application structure
 type App struct {
-	config      *config.Config
-	fiber       *fiber.App
-	gRPCServer  *grpc.Server
-	kafkaReader *kafka.Reader
-	Logger      logger.Logger
+	config         *config.Config
+	fiber          *fiber.App
+	gRPCServer     *grpc.Server
+	profilesConn   *grpc.ClientConn
+	commandRouter  *CommandRouter
+	kafkaReader    *kafka.Reader
+	dlqWriter      *kafka.Writer
+	consumer       *consumer.Consumer
+	webhookUpdates chan tgbotapi.Update
+	Logger         logger.Logger
 }
 
 // New - create new application
@@ -58,6 +70,21 @@ func New() *App {
 		Topic:    "like_topic",
 		MaxBytes: bodyLimit,
 	})
+	// Dead-letter topic. The topic is taken from every message
+	w := &kafka.Writer{
+		Addr:                   kafka.TCP(brokers...),
+		Balancer:               &kafka.LeastBytes{},
+		BatchTimeout:           kafkaBatchTimeout,
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+	}
+
+	// gRPC client of the profiles service
+	conn, err := grpc.NewClient(cfg.ProfilesHost, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		errorMessage := getErrorMessage("New", "grpc.NewClient", errorFilePathApp)
+		defaultLogger.Fatal(errorMessage, zap.Error(err))
+	}
 
 	// Fiber
 	f := fiber.New(fiber.Config{
@@ -72,12 +99,18 @@ func New() *App {
 		AllowMethods: "GET, POST, PUT, DELETE, OPTIONS",
 	}))
 
-	return &App{
-		config:      cfg,
-		fiber:       f,
-		kafkaReader: r,
-		Logger:      loggerLevel,
+	app := &App{
+		config:         cfg,
+		fiber:          f,
+		profilesConn:   conn,
+		commandRouter:  NewCommandRouter(loggerLevel, pb.NewProfileClient(conn)),
+		kafkaReader:    r,
+		dlqWriter:      w,
+		webhookUpdates: make(chan tgbotapi.Update, webhookBufferSize),
+		Logger:         loggerLevel,
 	}
+	app.consumer = consumer.NewConsumer(loggerLevel, r, w, app.handleMessage)
+	return app
 }
 
 // Run launches the application
@@ -89,9 +122,13 @@ func (app *App) Run(ctx context.Context) {
 			app.Logger.Fatal(errorMessage, zap.Error(err))
 			return err
 		}
-		return nil
+		// The consumer is started after the bot, it delivers the messages through the bot
+		return app.consumer.Run(ctx)
+	})
+	g.Go(func() error {
+		return app.StartHTTPServer(ctx)
 	})
-	// Close kafka reader when context done
+	// Close kafka reader and writer when context done
 	g.Go(func() error {
 		select {
 		case <-ctx.Done():
@@ -100,6 +137,16 @@ func (app *App) Run(ctx context.Context) {
 					errorFilePathApp)
 				app.Logger.Fatal(errorMessage, zap.Error(err))
 			}
+			if err := app.dlqWriter.Close(); err != nil {
+				errorMessage := getErrorMessage("Run", "dlqWriter.Close",
+					errorFilePathApp)
+				app.Logger.Fatal(errorMessage, zap.Error(err))
+			}
+			if err := app.profilesConn.Close(); err != nil {
+				errorMessage := getErrorMessage("Run", "profilesConn.Close",
+					errorFilePathApp)
+				app.Logger.Fatal(errorMessage, zap.Error(err))
+			}
 		}
 		return nil
 	})
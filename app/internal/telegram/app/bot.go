@@ -3,11 +3,13 @@ package app
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/entity"
+	"github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/events"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/consumer"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"strconv"
 	"time"
 )
@@ -18,17 +20,20 @@ const (
 	EmojiSmile          = "\U0001F642"
 	EmojiSunglasses     = "\U0001F60E"
 	UpdateConfigTimeout = 60
+	UpdateModePolling   = "polling"
+	UpdateModeWebhook   = "webhook"
 	errorFilePathBot    = "internal/telegram/app/bot.go"
 )
 
+var (
+	ErrUnsupportedEventVersion = fmt.Errorf("%w: unsupported event version", consumer.ErrPoisonMessage)
+	ErrUnknownEventType        = fmt.Errorf("%w: unknown event type", consumer.ErrPoisonMessage)
+	ErrInvalidEventPayload     = fmt.Errorf("%w: event payload does not match its type", consumer.ErrPoisonMessage)
+)
+
 // bot - telegram bot
 var bot *tgbotapi.BotAPI
 
-// isStartMessage - checks that the /start message has been sent
-func isStartMessage(update *tgbotapi.Update) bool {
-	return update.Message != nil && update.Message.Text == "/start"
-}
-
 // delay - delay
 func delay(seconds uint8) {
 	time.Sleep(time.Duration(seconds) * time.Second)
@@ -62,78 +67,143 @@ func printIntro(chatId int64, languageCode string) {
 	//getLocationRequest(chatId, 5, "Из какого ты города?")
 }
 
+// handleMessage - decodes the event from the Kafka message and delivers it
+func (app *App) handleMessage(ctx context.Context, m kafka.Message) error {
+	e := &events.Event{}
+	if err := proto.Unmarshal(m.Value, e); err != nil {
+		return fmt.Errorf("%w: %w", consumer.ErrPoisonMessage, err)
+	}
+	if err := app.handleEvent(e); err != nil {
+		return fmt.Errorf("event %s of type %s version %d: %w", e.GetId(), e.GetType(), e.GetVersion(), err)
+	}
+	return nil
+}
+
+// handleEvent - dispatches the event by its type. Chat and profile blocking events are delivered
+// only to the websocket connections of the gateway
+func (app *App) handleEvent(e *events.Event) error {
+	if e.GetVersion() != events.EventVersion_EVENT_VERSION_V1 {
+		return ErrUnsupportedEventVersion
+	}
+	switch e.GetType() {
+	case events.EventType_EVENT_TYPE_LIKE_RECEIVED:
+		p := e.GetLikeReceived()
+		if p == nil {
+			return ErrInvalidEventPayload
+		}
+		keyboard := getLikeKeyboard(p.GetTelegramUserId(), translationsCommand(p.GetLanguageCode()))
+		return sendProfileNotification(e.GetRecipientTelegramUserId(), p.GetMessage(), p.GetUserImageUrl(),
+			p.GetUsername(), &keyboard)
+	case events.EventType_EVENT_TYPE_MATCH_CREATED:
+		p := e.GetMatchCreated()
+		if p == nil {
+			return ErrInvalidEventPayload
+		}
+		return sendProfileNotification(e.GetRecipientTelegramUserId(), p.GetMessage(), p.GetUserImageUrl(),
+			p.GetUsername(), nil)
+	case events.EventType_EVENT_TYPE_PAYMENT_SUCCEEDED:
+		p := e.GetPaymentSucceeded()
+		if p == nil {
+			return ErrInvalidEventPayload
+		}
+		availableUntil := p.GetAvailableUntil().AsTime().Format("02.01.2006")
+		return sendTextNotification(e.GetRecipientTelegramUserId(),
+			translationsPaymentSucceeded(p.GetLanguageCode(), availableUntil))
+	case events.EventType_EVENT_TYPE_IMAGE_MODERATED:
+		p := e.GetImageModerated()
+		if p == nil {
+			return ErrInvalidEventPayload
+		}
+		return sendTextNotification(e.GetRecipientTelegramUserId(),
+			translationsImageModerated(p.GetLanguageCode(), p.GetIsApproved()))
+	case events.EventType_EVENT_TYPE_MESSAGE_RECEIVED, events.EventType_EVENT_TYPE_PROFILE_BLOCKED:
+		return nil
+	default:
+		return ErrUnknownEventType
+	}
+}
+
+// sendProfileNotification - sends the photo of the user who liked or matched the recipient
+func sendProfileNotification(recipientTelegramUserId, message, userImageUrl, username string,
+	keyboard *tgbotapi.InlineKeyboardMarkup) error {
+	chatId, err := strconv.ParseInt(recipientTelegramUserId, 10, 64)
+	if err != nil {
+		return err
+	}
+	msg := tgbotapi.NewPhoto(chatId, tgbotapi.FileURL(userImageUrl))
+	msg.ParseMode = "HTML"
+	msg.Caption = fmt.Sprintf("%s %s <a href=\"tg://resolve?domain=%s\">@%s</a>",
+		message, EmojiPointRight, username, username)
+	if keyboard != nil {
+		msg.ReplyMarkup = keyboard
+	}
+	_, err = bot.Send(msg)
+	return err
+}
+
+// sendTextNotification - sends the text message to the recipient
+func sendTextNotification(recipientTelegramUserId, message string) error {
+	chatId, err := strconv.ParseInt(recipientTelegramUserId, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = bot.Send(tgbotapi.NewMessage(chatId, message))
+	return err
+}
+
 // StartBot - launches the telegram
 func (app *App) StartBot(ctx context.Context) error {
 	var err error
 	// Telegram Bot
-	if bot, err = tgbotapi.NewBotAPI(app.config.TelegramBotToken); err != nil {
+	apiEndpoint := app.config.TelegramApiEndpoint
+	if apiEndpoint == "" {
+		apiEndpoint = tgbotapi.APIEndpoint
+	}
+	if bot, err = tgbotapi.NewBotAPIWithAPIEndpoint(app.config.TelegramBotToken, apiEndpoint); err != nil {
 		return err
 	}
 	bot.Debug = true
 	app.Logger.Info("Starting Telegram service")
 	app.Logger.Info("Authorized on account:", zap.String("username", bot.Self.UserName))
 
-	// удаляет Webhook
-	// https://github.com/go-telegram-bot-api/telegram-bot-api/issues/656
-	// _, err = bot.MakeRequest("deleteWebhook", tgbotapi.Params{})
-
-	updateConfig := tgbotapi.NewUpdate(0)
-	updateConfig.Timeout = UpdateConfigTimeout
-	updates := bot.GetUpdatesChan(updateConfig) // Получаем все обновления от пользователя
-
-	var hc *entity.HubContent
-
-	go func() {
-		for {
-			// Kafka
-			c, err := app.kafkaReader.ReadMessage(context.Background())
-			if err != nil {
-				errorMessage := getErrorMessage("StartBot", "r.ReadMessage",
-					errorFilePathBot)
-				app.Logger.Debug(errorMessage, zap.Error(err))
-				break
-			}
-			err = json.Unmarshal(c.Value, &hc)
-			if err != nil {
-				errorMessage := getErrorMessage("StartBot", "json.Unmarshal",
-					errorFilePathBot)
-				app.Logger.Error(errorMessage, zap.Error(err))
-				continue
-			}
-			likedTelegramUserId, err := strconv.ParseInt(hc.LikedTelegramUserId, 10, 64)
-			if err != nil {
-				errorMessage := getErrorMessage("StartBot", "strconv.ParseInt",
-					errorFilePathBot)
-				app.Logger.Debug(errorMessage, zap.Error(err))
-				break
-			}
-			msg := tgbotapi.NewPhoto(likedTelegramUserId, tgbotapi.FileURL(hc.UserImageUrl))
-			msg.ParseMode = "HTML"
-			msg.Caption = fmt.Sprintf("%s %s <a href=\"tg://resolve?domain=%s\">@%s</a>",
-				hc.Message, EmojiPointRight, hc.Username, hc.Username)
-			_, err = bot.Send(msg)
-			if err != nil {
-				errorMessage := getErrorMessage("StartBot", "telegram.Send",
-					errorFilePathBot)
-				app.Logger.Debug(errorMessage, zap.Error(err))
-			}
-		}
-	}()
+	var updates tgbotapi.UpdatesChannel
+	if app.config.TelegramUpdateMode == UpdateModeWebhook {
+		updates, err = app.startWebhook()
+	} else {
+		updates, err = app.startPolling()
+	}
+	if err != nil {
+		return err
+	}
 
 	go func() {
 		for update := range updates {
-			if update.Message == nil {
-				continue
-			}
-			chatId := update.Message.Chat.ID
-			userLanguageCode := update.Message.From.LanguageCode
-			if isStartMessage(&update) {
-				//userText := update.Message.Text // userText - сообщение, которое отправил пользователь
-				//app.Logger.Info("Начало общения: ", zap.String("username", update.Message.From.UserName),
-				//	zap.String("message", userText))
-				printIntro(chatId, userLanguageCode)
-			}
+			app.handleUpdate(ctx, &update)
 		}
 	}()
 	return nil
 }
+
+// startPolling - deletes the webhook, Telegram doesn't return the updates by getUpdates while it is set
+// https://github.com/go-telegram-bot-api/telegram-bot-api/issues/656
+func (app *App) startPolling() (tgbotapi.UpdatesChannel, error) {
+	if _, err := bot.Request(tgbotapi.DeleteWebhookConfig{}); err != nil {
+		errorMessage := getErrorMessage("startPolling", "deleteWebhook",
+			errorFilePathBot)
+		app.Logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	app.Logger.Info("Receiving Telegram updates by long polling")
+	updateConfig := tgbotapi.NewUpdate(0)
+	updateConfig.Timeout = UpdateConfigTimeout
+	return bot.GetUpdatesChan(updateConfig), nil // Получаем все обновления от пользователя
+}
+
+// handleUpdate - handles the update received by long polling or by the webhook
+func (app *App) handleUpdate(ctx context.Context, update *tgbotapi.Update) {
+	if update.CallbackQuery != nil {
+		app.commandRouter.HandleCallbackQuery(ctx, update.CallbackQuery)
+		return
+	}
+	app.commandRouter.HandleMessage(ctx, update.Message)
+}
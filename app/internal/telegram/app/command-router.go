@@ -0,0 +1,370 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	pb "github.com/EvgeniyBudaev/tgdating-go/app/contracts/proto/profiles"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/logger"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	errorFilePathCommandRouter = "internal/telegram/app/command-router.go"
+	commandTimeout             = 10 * time.Second
+	callbackDeleteConfirm      = "delete_confirm"
+	callbackDeleteCancel       = "delete_cancel"
+	callbackLikeBack           = "like_back"
+	callbackLikeSkip           = "like_skip"
+	callbackLikeBlock          = "like_block"
+)
+
+var ErrInvalidCallbackData = errors.New("invalid callback data")
+
+// commandHandler - handles the command sent by the user
+type commandHandler func(ctx context.Context, message *tgbotapi.Message, t *commandTranslation) error
+
+// callbackHandler - handles the pressed inline keyboard button. arg is the part of the callback data
+// after the action, e.g. the id of the user
+type callbackHandler func(ctx context.Context, query *tgbotapi.CallbackQuery, arg string, t *commandTranslation) error
+
+// CommandRouter - routes the bot commands and the inline keyboard buttons to their handlers.
+// The handlers act on behalf of the user who sent the update through the profiles service
+type CommandRouter struct {
+	logger           logger.Logger
+	profileClient    pb.ProfileClient
+	commandHandlers  map[string]commandHandler
+	callbackHandlers map[string]callbackHandler
+}
+
+func NewCommandRouter(l logger.Logger, pc pb.ProfileClient) *CommandRouter {
+	r := &CommandRouter{
+		logger:        l,
+		profileClient: pc,
+	}
+	r.commandHandlers = map[string]commandHandler{
+		"start":   r.handleStart,
+		"profile": r.handleProfile,
+		"likes":   r.handleLikes,
+		"matches": r.handleMatches,
+		"pause":   r.handlePause,
+		"resume":  r.handleResume,
+		"delete":  r.handleDelete,
+	}
+	r.callbackHandlers = map[string]callbackHandler{
+		callbackDeleteConfirm: r.handleDeleteConfirm,
+		callbackDeleteCancel:  r.handleDeleteCancel,
+		callbackLikeBack:      r.handleLikeBack,
+		callbackLikeSkip:      r.handleLikeSkip,
+		callbackLikeBlock:     r.handleLikeBlock,
+	}
+	return r
+}
+
+// HandleMessage - handles the message if it is a known command and returns false otherwise
+func (r *CommandRouter) HandleMessage(ctx context.Context, message *tgbotapi.Message) bool {
+	if message == nil || message.From == nil || !message.IsCommand() {
+		return false
+	}
+	handler, ok := r.commandHandlers[message.Command()]
+	if !ok {
+		return false
+	}
+	t := translationsCommand(message.From.LanguageCode)
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+	if err := handler(ctx, message, t); err != nil {
+		errorMessage := r.getErrorMessage("HandleMessage", message.Command())
+		r.logger.Debug(errorMessage, zap.Error(err))
+		r.sendText(message.Chat.ID, r.getErrorReply(err, t))
+	}
+	return true
+}
+
+// HandleCallbackQuery - handles the pressed inline keyboard button and returns false if it is unknown
+func (r *CommandRouter) HandleCallbackQuery(ctx context.Context, query *tgbotapi.CallbackQuery) bool {
+	if query == nil || query.From == nil || query.Message == nil {
+		return false
+	}
+	action, arg, _ := strings.Cut(query.Data, ":")
+	handler, ok := r.callbackHandlers[action]
+	if !ok {
+		return false
+	}
+	t := translationsCommand(query.From.LanguageCode)
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+	if err := handler(ctx, query, arg, t); err != nil {
+		errorMessage := r.getErrorMessage("HandleCallbackQuery", action)
+		r.logger.Debug(errorMessage, zap.Error(err))
+		r.answerCallback(query.ID, r.getErrorReply(err, t))
+		return true
+	}
+	r.answerCallback(query.ID, "")
+	return true
+}
+
+func (r *CommandRouter) handleStart(_ context.Context, message *tgbotapi.Message, _ *commandTranslation) error {
+	printIntro(message.Chat.ID, message.From.LanguageCode)
+	return nil
+}
+
+func (r *CommandRouter) handleProfile(ctx context.Context, message *tgbotapi.Message, t *commandTranslation) error {
+	profile, err := r.profileClient.GetProfile(ctx, &pb.ProfileGetRequest{
+		TelegramUserId: getTelegramUserId(message.From),
+	})
+	if err != nil {
+		return err
+	}
+	summary := fmt.Sprintf("%s, %d", profile.DisplayName, profile.Age)
+	if profile.Description != "" {
+		summary += "\n" + profile.Description
+	}
+	if profile.Status.GetIsFrozen() {
+		summary += "\n\n" + t.statusPaused
+	} else {
+		summary += "\n\n" + t.statusActive
+	}
+	r.sendText(message.Chat.ID, summary)
+	return nil
+}
+
+func (r *CommandRouter) handleLikes(ctx context.Context, message *tgbotapi.Message, t *commandTranslation) error {
+	likeList, err := r.profileClient.GetLikeList(ctx, &pb.GetLikeListRequest{
+		TelegramUserId: getTelegramUserId(message.From),
+	})
+	if err != nil {
+		return err
+	}
+	if len(likeList.Content) == 0 {
+		r.sendText(message.Chat.ID, t.likesEmpty)
+		return nil
+	}
+	lines := []string{t.likesTitle}
+	for _, l := range likeList.Content {
+		lines = append(lines, formatListItem(l.DisplayName, l.Username))
+	}
+	r.sendText(message.Chat.ID, strings.Join(lines, "\n"))
+	return nil
+}
+
+func (r *CommandRouter) handleMatches(ctx context.Context, message *tgbotapi.Message, t *commandTranslation) error {
+	matchList, err := r.profileClient.GetMatchList(ctx, &pb.GetMatchListRequest{
+		TelegramUserId: getTelegramUserId(message.From),
+	})
+	if err != nil {
+		return err
+	}
+	if len(matchList.Content) == 0 {
+		r.sendText(message.Chat.ID, t.matchesEmpty)
+		return nil
+	}
+	lines := []string{t.matchesTitle}
+	for _, m := range matchList.Content {
+		lines = append(lines, formatListItem(m.DisplayName, m.Username))
+	}
+	r.sendText(message.Chat.ID, strings.Join(lines, "\n"))
+	return nil
+}
+
+func (r *CommandRouter) handlePause(ctx context.Context, message *tgbotapi.Message, t *commandTranslation) error {
+	_, err := r.profileClient.FreezeProfile(ctx, &pb.ProfileFreezeRequest{
+		TelegramUserId: getTelegramUserId(message.From),
+	})
+	if err != nil {
+		return err
+	}
+	r.sendText(message.Chat.ID, t.paused)
+	return nil
+}
+
+func (r *CommandRouter) handleResume(ctx context.Context, message *tgbotapi.Message, t *commandTranslation) error {
+	_, err := r.profileClient.RestoreProfile(ctx, &pb.ProfileRestoreRequest{
+		TelegramUserId: getTelegramUserId(message.From),
+	})
+	if err != nil {
+		return err
+	}
+	r.sendText(message.Chat.ID, t.resumed)
+	return nil
+}
+
+// handleDelete - asks for the confirmation, the profile is deleted by handleDeleteConfirm
+func (r *CommandRouter) handleDelete(_ context.Context, message *tgbotapi.Message, t *commandTranslation) error {
+	msg := tgbotapi.NewMessage(message.Chat.ID, t.deleteConfirm)
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(t.deleteYes, callbackDeleteConfirm),
+			tgbotapi.NewInlineKeyboardButtonData(t.deleteNo, callbackDeleteCancel),
+		),
+	)
+	_, err := bot.Send(msg)
+	return err
+}
+
+func (r *CommandRouter) handleDeleteConfirm(
+	ctx context.Context, query *tgbotapi.CallbackQuery, _ string, t *commandTranslation) error {
+	_, err := r.profileClient.DeleteProfile(ctx, &pb.ProfileDeleteRequest{
+		TelegramUserId: getTelegramUserId(query.From),
+	})
+	if err != nil {
+		return err
+	}
+	return r.editText(query.Message, t.deleted)
+}
+
+func (r *CommandRouter) handleDeleteCancel(
+	_ context.Context, query *tgbotapi.CallbackQuery, _ string, t *commandTranslation) error {
+	return r.editText(query.Message, t.deleteCanceled)
+}
+
+// handleLikeBack - likes the user who has liked the recipient of the notification. The existing like
+// is updated, e.g. the one removed earlier in the Mini App, otherwise a new like is added
+func (r *CommandRouter) handleLikeBack(
+	ctx context.Context, query *tgbotapi.CallbackQuery, arg string, t *commandTranslation) error {
+	telegramUserId := getTelegramUserId(query.From)
+	if err := validateCallbackTelegramUserId(telegramUserId, arg); err != nil {
+		return err
+	}
+	profileDetail, err := r.profileClient.GetProfileDetail(ctx, &pb.ProfileGetDetailRequest{
+		TelegramUserId:       telegramUserId,
+		ViewedTelegramUserId: arg,
+	})
+	if err != nil {
+		return err
+	}
+	like := profileDetail.GetLike()
+	if like.GetIsLiked() {
+		return r.editCaption(query.Message, t.likedBack)
+	}
+	isMatch := false
+	if like.GetId() > 0 {
+		likeUpdated, err := r.profileClient.UpdateLike(ctx, &pb.LikeUpdateRequest{
+			Id:             like.GetId(),
+			TelegramUserId: telegramUserId,
+			IsLiked:        true,
+		})
+		if err != nil {
+			return err
+		}
+		isMatch = likeUpdated.IsMatch
+	} else {
+		likeAdded, err := r.profileClient.AddLike(ctx, &pb.LikeAddRequest{
+			TelegramUserId:      telegramUserId,
+			LikedTelegramUserId: arg,
+			Locale:              query.From.LanguageCode,
+		})
+		if err != nil {
+			return err
+		}
+		isMatch = likeAdded.IsMatch
+	}
+	if isMatch {
+		return r.editCaption(query.Message, t.matched)
+	}
+	return r.editCaption(query.Message, t.likedBack)
+}
+
+func (r *CommandRouter) handleLikeSkip(
+	_ context.Context, query *tgbotapi.CallbackQuery, _ string, t *commandTranslation) error {
+	return r.editCaption(query.Message, t.skipped)
+}
+
+func (r *CommandRouter) handleLikeBlock(
+	ctx context.Context, query *tgbotapi.CallbackQuery, arg string, t *commandTranslation) error {
+	telegramUserId := getTelegramUserId(query.From)
+	if err := validateCallbackTelegramUserId(telegramUserId, arg); err != nil {
+		return err
+	}
+	_, err := r.profileClient.AddBlock(ctx, &pb.BlockAddRequest{
+		TelegramUserId:        telegramUserId,
+		BlockedTelegramUserId: arg,
+	})
+	if err != nil {
+		return err
+	}
+	return r.editCaption(query.Message, t.blocked)
+}
+
+// getErrorReply - returns the reply for the failed command
+func (r *CommandRouter) getErrorReply(err error, t *commandTranslation) string {
+	if status.Code(err) == codes.NotFound {
+		return t.profileNotFound
+	}
+	return t.unknownError
+}
+
+func (r *CommandRouter) sendText(chatId int64, text string) {
+	if _, err := bot.Send(tgbotapi.NewMessage(chatId, text)); err != nil {
+		errorMessage := r.getErrorMessage("sendText", "bot.Send")
+		r.logger.Debug(errorMessage, zap.Error(err))
+	}
+}
+
+// editText - replaces the text of the message and removes its inline keyboard
+func (r *CommandRouter) editText(message *tgbotapi.Message, text string) error {
+	_, err := bot.Send(tgbotapi.NewEditMessageText(message.Chat.ID, message.MessageID, text))
+	return err
+}
+
+// editCaption - appends the outcome to the caption of the notification and removes its inline keyboard
+func (r *CommandRouter) editCaption(message *tgbotapi.Message, outcome string) error {
+	caption := outcome
+	if message.Caption != "" {
+		caption = message.Caption + "\n\n" + outcome
+	}
+	_, err := bot.Send(tgbotapi.NewEditMessageCaption(message.Chat.ID, message.MessageID, caption))
+	return err
+}
+
+func (r *CommandRouter) answerCallback(callbackQueryId, text string) {
+	if _, err := bot.Request(tgbotapi.NewCallback(callbackQueryId, text)); err != nil {
+		errorMessage := r.getErrorMessage("answerCallback", "bot.Request")
+		r.logger.Debug(errorMessage, zap.Error(err))
+	}
+}
+
+func (r *CommandRouter) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathCommandRouter)
+}
+
+func getTelegramUserId(user *tgbotapi.User) string {
+	return strconv.FormatInt(user.ID, 10)
+}
+
+// getLikeKeyboard - returns the buttons of the like notification
+func getLikeKeyboard(likedByTelegramUserId string, t *commandTranslation) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(t.likeBack, callbackLikeBack+":"+likedByTelegramUserId),
+			tgbotapi.NewInlineKeyboardButtonData(t.likeSkip, callbackLikeSkip+":"+likedByTelegramUserId),
+			tgbotapi.NewInlineKeyboardButtonData(t.likeBlock, callbackLikeBlock+":"+likedByTelegramUserId),
+		),
+	)
+}
+
+// validateCallbackTelegramUserId - checks the id of the user taken from the callback data
+func validateCallbackTelegramUserId(telegramUserId, callbackTelegramUserId string) error {
+	if _, err := strconv.ParseInt(callbackTelegramUserId, 10, 64); err != nil {
+		return ErrInvalidCallbackData
+	}
+	if callbackTelegramUserId == telegramUserId {
+		return ErrInvalidCallbackData
+	}
+	return nil
+}
+
+// formatListItem - formats the user in the list of likes or matches
+func formatListItem(displayName, username string) string {
+	if username == "" {
+		return "• " + displayName
+	}
+	return fmt.Sprintf("• %s @%s", displayName, username)
+}
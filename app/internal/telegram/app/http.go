@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	errorFilePathHttp = "internal/telegram/app/http.go"
+)
+
+func (app *App) StartHTTPServer(ctx context.Context) error {
+	app.setupRoutes()
+	go func() {
+		app.Logger.Info("Starting Telegram HTTP server on host: ", zap.String("host", app.config.TelegramHost))
+		if err := app.fiber.Listen(app.config.TelegramHost); err != nil {
+			errorMessage := getErrorMessage("StartHTTPServer", "Listen",
+				errorFilePathHttp)
+			app.Logger.Error(errorMessage, zap.Error(err))
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		if err := app.fiber.Shutdown(); err != nil {
+			errorMessage := getErrorMessage("StartHTTPServer", "Shutdown",
+				errorFilePathHttp)
+			app.Logger.Error(errorMessage, zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (app *App) setupRoutes() {
+	app.fiber.Get("/api/v1/consumer/stats", app.getConsumerStats())
+	if app.isWebhookEnabled() {
+		app.fiber.Post(app.getWebhookPath(), app.handleWebhook())
+	}
+}
+
+// getConsumerStats - returns the counters of the Kafka consumer
+func (app *App) getConsumerStats() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		return ctf.Status(fiber.StatusOK).JSON(app.consumer.Stats())
+	}
+}
@@ -108,3 +108,697 @@ func translationsPrintIntro(instructionMessage, languageCode, welcomeMessage str
 	}
 	return instructionMessage, welcomeMessage
 }
+
+func translationsPaymentSucceeded(languageCode, availableUntil string) string {
+	switch languageCode {
+	case "ru":
+		return "Оплата прошла успешно! Премиум доступен до " + availableUntil + " " + EmojiCoin
+	case "be":
+		return "Аплата прайшла паспяхова! Прэміум даступны да " + availableUntil + " " + EmojiCoin
+	case "uk":
+		return "Оплата пройшла успішно! Преміум доступний до " + availableUntil + " " + EmojiCoin
+	default:
+		return "The payment was successful! Premium is available until " + availableUntil + " " + EmojiCoin
+	}
+}
+
+func translationsImageModerated(languageCode string, isApproved bool) string {
+	switch languageCode {
+	case "ru":
+		if isApproved {
+			return "Ваша фотография прошла модерацию и теперь видна другим пользователям " + EmojiSmile
+		}
+		return "Ваша фотография не прошла модерацию и скрыта от других пользователей"
+	case "be":
+		if isApproved {
+			return "Ваша фатаграфія прайшла мадэрацыю і цяпер бачная іншым карыстальнікам " + EmojiSmile
+		}
+		return "Ваша фатаграфія не прайшла мадэрацыю і схавана ад іншых карыстальнікаў"
+	case "uk":
+		if isApproved {
+			return "Ваша фотографія пройшла модерацію і тепер видима іншим користувачам " + EmojiSmile
+		}
+		return "Ваша фотографія не пройшла модерацію і прихована від інших користувачів"
+	default:
+		if isApproved {
+			return "Your photo has passed moderation and is now visible to other users " + EmojiSmile
+		}
+		return "Your photo has not passed moderation and is hidden from other users"
+	}
+}
+
+// commandTranslation - the replies of the bot commands
+type commandTranslation struct {
+	profileNotFound string
+	statusActive    string
+	statusPaused    string
+	likesTitle      string
+	likesEmpty      string
+	matchesTitle    string
+	matchesEmpty    string
+	paused          string
+	resumed         string
+	deleteConfirm   string
+	deleteYes       string
+	deleteNo        string
+	deleted         string
+	deleteCanceled  string
+	unknownError    string
+	likeBack        string
+	likeSkip        string
+	likeBlock       string
+	likedBack       string
+	matched         string
+	skipped         string
+	blocked         string
+}
+
+func translationsCommand(languageCode string) *commandTranslation {
+	switch languageCode {
+	case "ru":
+		return &commandTranslation{
+			profileNotFound: "У тебя еще нет анкеты. Нажми на кнопку Menu, чтобы создать ее",
+			statusActive:    "Анкета активна",
+			statusPaused:    "Анкета на паузе и скрыта из поиска",
+			likesTitle:      "Последние лайки:",
+			likesEmpty:      "Лайков пока нет",
+			matchesTitle:    "Взаимные симпатии:",
+			matchesEmpty:    "Взаимных симпатий пока нет",
+			paused:          "Анкета поставлена на паузу и скрыта из поиска",
+			resumed:         "Анкета снова активна",
+			deleteConfirm:   "Удалить анкету? Это действие нельзя отменить",
+			deleteYes:       "Удалить",
+			deleteNo:        "Отмена",
+			deleted:         "Анкета удалена",
+			deleteCanceled:  "Удаление отменено",
+			unknownError:    "Что-то пошло не так, попробуй позже",
+			likeBack:        "Лайк в ответ",
+			likeSkip:        "Пропустить",
+			likeBlock:       "Заблокировать",
+			likedBack:       "Ты поставил лайк в ответ",
+			matched:         "Есть взаимная симпатия! Начинай общаться",
+			skipped:         "Пропущено",
+			blocked:         "Пользователь заблокирован",
+		}
+	case "ar":
+		return &commandTranslation{
+			profileNotFound: "ليس لديك ملف شخصي بعد. اضغط على زر القائمة لإنشائه",
+			statusActive:    "الملف الشخصي نشط",
+			statusPaused:    "الملف الشخصي متوقف مؤقتًا ومخفي من البحث",
+			likesTitle:      "آخر الإعجابات:",
+			likesEmpty:      "لا توجد إعجابات بعد",
+			matchesTitle:    "الإعجابات المتبادلة:",
+			matchesEmpty:    "لا توجد إعجابات متبادلة بعد",
+			paused:          "تم إيقاف الملف الشخصي مؤقتًا وإخفاؤه من البحث",
+			resumed:         "الملف الشخصي نشط مرة أخرى",
+			deleteConfirm:   "حذف الملف الشخصي؟ لا يمكن التراجع عن هذا الإجراء",
+			deleteYes:       "حذف",
+			deleteNo:        "إلغاء",
+			deleted:         "تم حذف الملف الشخصي",
+			deleteCanceled:  "تم إلغاء الحذف",
+			unknownError:    "حدث خطأ ما، حاول لاحقًا",
+			likeBack:        "إعجاب متبادل",
+			likeSkip:        "تخطي",
+			likeBlock:       "حظر",
+			likedBack:       "لقد أعجبت به بالمقابل",
+			matched:         "هناك تعاطف متبادل! ابدأ التواصل",
+			skipped:         "تم التخطي",
+			blocked:         "تم حظر المستخدم",
+		}
+	case "be":
+		return &commandTranslation{
+			profileNotFound: "У цябе яшчэ няма анкеты. Націсні на кнопку Menu, каб стварыць яе",
+			statusActive:    "Анкета актыўная",
+			statusPaused:    "Анкета на паўзе і схавана з пошуку",
+			likesTitle:      "Апошнія лайкі:",
+			likesEmpty:      "Лайкаў пакуль няма",
+			matchesTitle:    "Узаемныя сімпатыі:",
+			matchesEmpty:    "Узаемных сімпатый пакуль няма",
+			paused:          "Анкета пастаўлена на паўзу і схавана з пошуку",
+			resumed:         "Анкета зноў актыўная",
+			deleteConfirm:   "Выдаліць анкету? Гэта дзеянне нельга адмяніць",
+			deleteYes:       "Выдаліць",
+			deleteNo:        "Адмена",
+			deleted:         "Анкета выдалена",
+			deleteCanceled:  "Выдаленне адменена",
+			unknownError:    "Нешта пайшло не так, паспрабуй пазней",
+			likeBack:        "Лайк у адказ",
+			likeSkip:        "Прапусціць",
+			likeBlock:       "Заблакаваць",
+			likedBack:       "Ты паставіў лайк у адказ",
+			matched:         "Ёсць узаемная сімпатыя! Пачынай мець зносіны",
+			skipped:         "Прапушчана",
+			blocked:         "Карыстальнік заблакаваны",
+		}
+	case "ca":
+		return &commandTranslation{
+			profileNotFound: "Encara no tens perfil. Prem el botó Menú per crear-lo",
+			statusActive:    "El perfil està actiu",
+			statusPaused:    "El perfil està en pausa i amagat de la cerca",
+			likesTitle:      "Últims m'agrada:",
+			likesEmpty:      "Encara no hi ha m'agrada",
+			matchesTitle:    "Simpaties mútues:",
+			matchesEmpty:    "Encara no hi ha simpaties mútues",
+			paused:          "El perfil s'ha posat en pausa i s'ha amagat de la cerca",
+			resumed:         "El perfil torna a estar actiu",
+			deleteConfirm:   "Vols eliminar el perfil? Aquesta acció no es pot desfer",
+			deleteYes:       "Eliminar",
+			deleteNo:        "Cancel·lar",
+			deleted:         "El perfil s'ha eliminat",
+			deleteCanceled:  "S'ha cancel·lat l'eliminació",
+			unknownError:    "Alguna cosa ha anat malament, torna-ho a provar més tard",
+			likeBack:        "Tornar el m'agrada",
+			likeSkip:        "Saltar",
+			likeBlock:       "Bloquejar",
+			likedBack:       "Has tornat el m'agrada",
+			matched:         "Hi ha simpatia mútua! Comença a comunicar-te",
+			skipped:         "Saltat",
+			blocked:         "L'usuari ha estat bloquejat",
+		}
+	case "cs":
+		return &commandTranslation{
+			profileNotFound: "Ještě nemáš profil. Stiskni tlačítko Nabídka a vytvoř ho",
+			statusActive:    "Profil je aktivní",
+			statusPaused:    "Profil je pozastaven a skrytý ve vyhledávání",
+			likesTitle:      "Poslední lajky:",
+			likesEmpty:      "Zatím žádné lajky",
+			matchesTitle:    "Vzájemné sympatie:",
+			matchesEmpty:    "Zatím žádné vzájemné sympatie",
+			paused:          "Profil byl pozastaven a skryt ve vyhledávání",
+			resumed:         "Profil je opět aktivní",
+			deleteConfirm:   "Smazat profil? Tuto akci nelze vrátit zpět",
+			deleteYes:       "Smazat",
+			deleteNo:        "Zrušit",
+			deleted:         "Profil byl smazán",
+			deleteCanceled:  "Smazání bylo zrušeno",
+			unknownError:    "Něco se pokazilo, zkus to později",
+			likeBack:        "Lajk zpět",
+			likeSkip:        "Přeskočit",
+			likeBlock:       "Zablokovat",
+			likedBack:       "Lajk jsi oplatil",
+			matched:         "Máte vzájemné sympatie! Začni komunikovat",
+			skipped:         "Přeskočeno",
+			blocked:         "Uživatel byl zablokován",
+		}
+	case "de":
+		return &commandTranslation{
+			profileNotFound: "Du hast noch kein Profil. Drücke die Menütaste, um eines zu erstellen",
+			statusActive:    "Das Profil ist aktiv",
+			statusPaused:    "Das Profil ist pausiert und in der Suche ausgeblendet",
+			likesTitle:      "Letzte Likes:",
+			likesEmpty:      "Noch keine Likes",
+			matchesTitle:    "Gegenseitige Sympathien:",
+			matchesEmpty:    "Noch keine gegenseitigen Sympathien",
+			paused:          "Das Profil wurde pausiert und in der Suche ausgeblendet",
+			resumed:         "Das Profil ist wieder aktiv",
+			deleteConfirm:   "Profil löschen? Diese Aktion kann nicht rückgängig gemacht werden",
+			deleteYes:       "Löschen",
+			deleteNo:        "Abbrechen",
+			deleted:         "Das Profil wurde gelöscht",
+			deleteCanceled:  "Löschen abgebrochen",
+			unknownError:    "Etwas ist schiefgelaufen, versuche es später erneut",
+			likeBack:        "Zurück liken",
+			likeSkip:        "Überspringen",
+			likeBlock:       "Blockieren",
+			likedBack:       "Du hast zurück geliked",
+			matched:         "Gegenseitige Sympathie! Beginne zu kommunizieren",
+			skipped:         "Übersprungen",
+			blocked:         "Der Benutzer wurde blockiert",
+		}
+	case "es":
+		return &commandTranslation{
+			profileNotFound: "Todavía no tienes perfil. Pulsa el botón Menú para crearlo",
+			statusActive:    "El perfil está activo",
+			statusPaused:    "El perfil está en pausa y oculto en la búsqueda",
+			likesTitle:      "Últimos me gusta:",
+			likesEmpty:      "Todavía no hay me gusta",
+			matchesTitle:    "Simpatías mutuas:",
+			matchesEmpty:    "Todavía no hay simpatías mutuas",
+			paused:          "El perfil se ha pausado y ocultado de la búsqueda",
+			resumed:         "El perfil vuelve a estar activo",
+			deleteConfirm:   "¿Eliminar el perfil? Esta acción no se puede deshacer",
+			deleteYes:       "Eliminar",
+			deleteNo:        "Cancelar",
+			deleted:         "El perfil ha sido eliminado",
+			deleteCanceled:  "Eliminación cancelada",
+			unknownError:    "Algo salió mal, inténtalo más tarde",
+			likeBack:        "Devolver me gusta",
+			likeSkip:        "Saltar",
+			likeBlock:       "Bloquear",
+			likedBack:       "Has devuelto el me gusta",
+			matched:         "¡Hay simpatía mutua! Empieza a comunicarte",
+			skipped:         "Omitido",
+			blocked:         "El usuario ha sido bloqueado",
+		}
+	case "fi":
+		return &commandTranslation{
+			profileNotFound: "Sinulla ei ole vielä profiilia. Luo se painamalla Menu-painiketta",
+			statusActive:    "Profiili on aktiivinen",
+			statusPaused:    "Profiili on tauolla ja piilotettu hausta",
+			likesTitle:      "Viimeisimmät tykkäykset:",
+			likesEmpty:      "Ei vielä tykkäyksiä",
+			matchesTitle:    "Molemminpuoliset sympatiat:",
+			matchesEmpty:    "Ei vielä molemminpuolisia sympatioita",
+			paused:          "Profiili on asetettu tauolle ja piilotettu hausta",
+			resumed:         "Profiili on taas aktiivinen",
+			deleteConfirm:   "Poistetaanko profiili? Toimintoa ei voi peruuttaa",
+			deleteYes:       "Poista",
+			deleteNo:        "Peruuta",
+			deleted:         "Profiili on poistettu",
+			deleteCanceled:  "Poisto peruutettu",
+			unknownError:    "Jotain meni pieleen, yritä myöhemmin uudelleen",
+			likeBack:        "Tykkää takaisin",
+			likeSkip:        "Ohita",
+			likeBlock:       "Estä",
+			likedBack:       "Tykkäsit takaisin",
+			matched:         "Molemminpuolinen sympatia! Aloita keskustelu",
+			skipped:         "Ohitettu",
+			blocked:         "Käyttäjä on estetty",
+		}
+	case "fr":
+		return &commandTranslation{
+			profileNotFound: "Tu n'as pas encore de profil. Appuie sur le bouton Menu pour en créer un",
+			statusActive:    "Le profil est actif",
+			statusPaused:    "Le profil est en pause et masqué de la recherche",
+			likesTitle:      "Derniers j'aime :",
+			likesEmpty:      "Pas encore de j'aime",
+			matchesTitle:    "Sympathies mutuelles :",
+			matchesEmpty:    "Pas encore de sympathies mutuelles",
+			paused:          "Le profil a été mis en pause et masqué de la recherche",
+			resumed:         "Le profil est de nouveau actif",
+			deleteConfirm:   "Supprimer le profil ? Cette action est irréversible",
+			deleteYes:       "Supprimer",
+			deleteNo:        "Annuler",
+			deleted:         "Le profil a été supprimé",
+			deleteCanceled:  "Suppression annulée",
+			unknownError:    "Une erreur s'est produite, réessaie plus tard",
+			likeBack:        "Aimer en retour",
+			likeSkip:        "Passer",
+			likeBlock:       "Bloquer",
+			likedBack:       "Tu as aimé en retour",
+			matched:         "Sympathie mutuelle ! Commence à communiquer",
+			skipped:         "Passé",
+			blocked:         "L'utilisateur a été bloqué",
+		}
+	case "he":
+		return &commandTranslation{
+			profileNotFound: "עדיין אין לך פרופיל. לחץ על כפתור התפריט כדי ליצור אותו",
+			statusActive:    "הפרופיל פעיל",
+			statusPaused:    "הפרופיל מושהה ומוסתר מהחיפוש",
+			likesTitle:      "לייקים אחרונים:",
+			likesEmpty:      "עדיין אין לייקים",
+			matchesTitle:    "אהדה הדדית:",
+			matchesEmpty:    "עדיין אין אהדה הדדית",
+			paused:          "הפרופיל הושהה והוסתר מהחיפוש",
+			resumed:         "הפרופיל פעיל שוב",
+			deleteConfirm:   "למחוק את הפרופיל? לא ניתן לבטל פעולה זו",
+			deleteYes:       "מחק",
+			deleteNo:        "ביטול",
+			deleted:         "הפרופיל נמחק",
+			deleteCanceled:  "המחיקה בוטלה",
+			unknownError:    "משהו השתבש, נסה שוב מאוחר יותר",
+			likeBack:        "לייק בחזרה",
+			likeSkip:        "דלג",
+			likeBlock:       "חסום",
+			likedBack:       "החזרת לייק",
+			matched:         "יש אהדה הדדית! התחילו לתקשר",
+			skipped:         "דולג",
+			blocked:         "המשתמש נחסם",
+		}
+	case "hi":
+		return &commandTranslation{
+			profileNotFound: "आपकी अभी तक कोई प्रोफ़ाइल नहीं है। इसे बनाने के लिए मेनू बटन दबाएँ",
+			statusActive:    "प्रोफ़ाइल सक्रिय है",
+			statusPaused:    "प्रोफ़ाइल रुकी हुई है और खोज से छिपी है",
+			likesTitle:      "हाल की पसंद:",
+			likesEmpty:      "अभी तक कोई पसंद नहीं",
+			matchesTitle:    "आपसी सहानुभूति:",
+			matchesEmpty:    "अभी तक कोई आपसी सहानुभूति नहीं",
+			paused:          "प्रोफ़ाइल रोक दी गई है और खोज से छिपा दी गई है",
+			resumed:         "प्रोफ़ाइल फिर से सक्रिय है",
+			deleteConfirm:   "प्रोफ़ाइल हटाएँ? यह कार्रवाई पूर्ववत नहीं की जा सकती",
+			deleteYes:       "हटाएँ",
+			deleteNo:        "रद्द करें",
+			deleted:         "प्रोफ़ाइल हटा दी गई है",
+			deleteCanceled:  "हटाना रद्द कर दिया गया",
+			unknownError:    "कुछ गलत हो गया, बाद में पुनः प्रयास करें",
+			likeBack:        "वापस पसंद करें",
+			likeSkip:        "छोड़ें",
+			likeBlock:       "ब्लॉक करें",
+			likedBack:       "आपने वापस पसंद किया",
+			matched:         "आपसी सहानुभूति है! बातचीत शुरू करें",
+			skipped:         "छोड़ दिया गया",
+			blocked:         "उपयोगकर्ता को ब्लॉक कर दिया गया",
+		}
+	case "hr":
+		return &commandTranslation{
+			profileNotFound: "Još nemaš profil. Pritisni gumb Izbornik da ga izradiš",
+			statusActive:    "Profil je aktivan",
+			statusPaused:    "Profil je pauziran i skriven iz pretrage",
+			likesTitle:      "Posljednji lajkovi:",
+			likesEmpty:      "Još nema lajkova",
+			matchesTitle:    "Uzajamne simpatije:",
+			matchesEmpty:    "Još nema uzajamnih simpatija",
+			paused:          "Profil je pauziran i skriven iz pretrage",
+			resumed:         "Profil je ponovno aktivan",
+			deleteConfirm:   "Izbrisati profil? Ova se radnja ne može poništiti",
+			deleteYes:       "Izbriši",
+			deleteNo:        "Odustani",
+			deleted:         "Profil je izbrisan",
+			deleteCanceled:  "Brisanje je otkazano",
+			unknownError:    "Nešto je pošlo po zlu, pokušaj kasnije",
+			likeBack:        "Uzvrati lajk",
+			likeSkip:        "Preskoči",
+			likeBlock:       "Blokiraj",
+			likedBack:       "Uzvratio si lajk",
+			matched:         "Postoji uzajamna simpatija! Počni komunicirati",
+			skipped:         "Preskočeno",
+			blocked:         "Korisnik je blokiran",
+		}
+	case "hu":
+		return &commandTranslation{
+			profileNotFound: "Még nincs profilod. Nyomd meg a Menü gombot a létrehozásához",
+			statusActive:    "A profil aktív",
+			statusPaused:    "A profil szüneteltetve van és rejtve van a keresésben",
+			likesTitle:      "Legutóbbi lájkok:",
+			likesEmpty:      "Még nincsenek lájkok",
+			matchesTitle:    "Kölcsönös szimpátiák:",
+			matchesEmpty:    "Még nincsenek kölcsönös szimpátiák",
+			paused:          "A profil szüneteltetve és elrejtve a keresésből",
+			resumed:         "A profil újra aktív",
+			deleteConfirm:   "Törlöd a profilt? Ez a művelet nem vonható vissza",
+			deleteYes:       "Törlés",
+			deleteNo:        "Mégse",
+			deleted:         "A profil törölve",
+			deleteCanceled:  "A törlés megszakítva",
+			unknownError:    "Valami hiba történt, próbáld újra később",
+			likeBack:        "Visszalájkolás",
+			likeSkip:        "Kihagyás",
+			likeBlock:       "Letiltás",
+			likedBack:       "Visszalájkoltál",
+			matched:         "Kölcsönös szimpátia! Kezdj el beszélgetni",
+			skipped:         "Kihagyva",
+			blocked:         "A felhasználó letiltva",
+		}
+	case "id":
+		return &commandTranslation{
+			profileNotFound: "Kamu belum punya profil. Tekan tombol Menu untuk membuatnya",
+			statusActive:    "Profil aktif",
+			statusPaused:    "Profil dijeda dan disembunyikan dari pencarian",
+			likesTitle:      "Suka terbaru:",
+			likesEmpty:      "Belum ada suka",
+			matchesTitle:    "Simpati timbal balik:",
+			matchesEmpty:    "Belum ada simpati timbal balik",
+			paused:          "Profil dijeda dan disembunyikan dari pencarian",
+			resumed:         "Profil aktif kembali",
+			deleteConfirm:   "Hapus profil? Tindakan ini tidak dapat dibatalkan",
+			deleteYes:       "Hapus",
+			deleteNo:        "Batal",
+			deleted:         "Profil telah dihapus",
+			deleteCanceled:  "Penghapusan dibatalkan",
+			unknownError:    "Terjadi kesalahan, coba lagi nanti",
+			likeBack:        "Suka balik",
+			likeSkip:        "Lewati",
+			likeBlock:       "Blokir",
+			likedBack:       "Kamu menyukai balik",
+			matched:         "Ada simpati timbal balik! Mulai berkomunikasi",
+			skipped:         "Dilewati",
+			blocked:         "Pengguna telah diblokir",
+		}
+	case "it":
+		return &commandTranslation{
+			profileNotFound: "Non hai ancora un profilo. Premi il pulsante Menu per crearlo",
+			statusActive:    "Il profilo è attivo",
+			statusPaused:    "Il profilo è in pausa e nascosto dalla ricerca",
+			likesTitle:      "Ultimi mi piace:",
+			likesEmpty:      "Ancora nessun mi piace",
+			matchesTitle:    "Simpatie reciproche:",
+			matchesEmpty:    "Ancora nessuna simpatia reciproca",
+			paused:          "Il profilo è stato messo in pausa e nascosto dalla ricerca",
+			resumed:         "Il profilo è di nuovo attivo",
+			deleteConfirm:   "Eliminare il profilo? Questa azione non può essere annullata",
+			deleteYes:       "Elimina",
+			deleteNo:        "Annulla",
+			deleted:         "Il profilo è stato eliminato",
+			deleteCanceled:  "Eliminazione annullata",
+			unknownError:    "Qualcosa è andato storto, riprova più tardi",
+			likeBack:        "Ricambia il mi piace",
+			likeSkip:        "Salta",
+			likeBlock:       "Blocca",
+			likedBack:       "Hai ricambiato il mi piace",
+			matched:         "C'è simpatia reciproca! Inizia a comunicare",
+			skipped:         "Saltato",
+			blocked:         "L'utente è stato bloccato",
+		}
+	case "ja":
+		return &commandTranslation{
+			profileNotFound: "まだプロフィールがありません。メニューボタンを押して作成してください",
+			statusActive:    "プロフィールは有効です",
+			statusPaused:    "プロフィールは一時停止中で、検索に表示されません",
+			likesTitle:      "最近のいいね:",
+			likesEmpty:      "まだいいねはありません",
+			matchesTitle:    "相互のいいね:",
+			matchesEmpty:    "まだ相互のいいねはありません",
+			paused:          "プロフィールを一時停止し、検索から非表示にしました",
+			resumed:         "プロフィールが再び有効になりました",
+			deleteConfirm:   "プロフィールを削除しますか？この操作は元に戻せません",
+			deleteYes:       "削除",
+			deleteNo:        "キャンセル",
+			deleted:         "プロフィールを削除しました",
+			deleteCanceled:  "削除をキャンセルしました",
+			unknownError:    "問題が発生しました。後でもう一度お試しください",
+			likeBack:        "いいねを返す",
+			likeSkip:        "スキップ",
+			likeBlock:       "ブロック",
+			likedBack:       "いいねを返しました",
+			matched:         "相互のいいねです！会話を始めましょう",
+			skipped:         "スキップしました",
+			blocked:         "ユーザーをブロックしました",
+		}
+	case "kk":
+		return &commandTranslation{
+			profileNotFound: "Сенде әлі сауалнама жоқ. Оны жасау үшін Menu түймесін бас",
+			statusActive:    "Сауалнама белсенді",
+			statusPaused:    "Сауалнама кідіртілген және іздеуден жасырылған",
+			likesTitle:      "Соңғы лайктар:",
+			likesEmpty:      "Әзірге лайктар жоқ",
+			matchesTitle:    "Өзара ұнатулар:",
+			matchesEmpty:    "Әзірге өзара ұнатулар жоқ",
+			paused:          "Сауалнама кідіртіліп, іздеуден жасырылды",
+			resumed:         "Сауалнама қайта белсенді",
+			deleteConfirm:   "Сауалнаманы жою керек пе? Бұл әрекетті болдырмау мүмкін емес",
+			deleteYes:       "Жою",
+			deleteNo:        "Болдырмау",
+			deleted:         "Сауалнама жойылды",
+			deleteCanceled:  "Жою болдырылмады",
+			unknownError:    "Бірдеңе дұрыс болмады, кейінірек қайталап көр",
+			likeBack:        "Жауап лайк",
+			likeSkip:        "Өткізіп жіберу",
+			likeBlock:       "Бұғаттау",
+			likedBack:       "Сен жауап лайк бастың",
+			matched:         "Өзара ұнату бар! Сөйлесуді баста",
+			skipped:         "Өткізіп жіберілді",
+			blocked:         "Пайдаланушы бұғатталды",
+		}
+	case "ko":
+		return &commandTranslation{
+			profileNotFound: "아직 프로필이 없습니다. 메뉴 버튼을 눌러 만드세요",
+			statusActive:    "프로필이 활성화되어 있습니다",
+			statusPaused:    "프로필이 일시 중지되어 검색에서 숨겨져 있습니다",
+			likesTitle:      "최근 좋아요:",
+			likesEmpty:      "아직 좋아요가 없습니다",
+			matchesTitle:    "상호 호감:",
+			matchesEmpty:    "아직 상호 호감이 없습니다",
+			paused:          "프로필이 일시 중지되어 검색에서 숨겨졌습니다",
+			resumed:         "프로필이 다시 활성화되었습니다",
+			deleteConfirm:   "프로필을 삭제하시겠습니까? 이 작업은 되돌릴 수 없습니다",
+			deleteYes:       "삭제",
+			deleteNo:        "취소",
+			deleted:         "프로필이 삭제되었습니다",
+			deleteCanceled:  "삭제가 취소되었습니다",
+			unknownError:    "문제가 발생했습니다. 나중에 다시 시도하세요",
+			likeBack:        "좋아요 보내기",
+			likeSkip:        "건너뛰기",
+			likeBlock:       "차단",
+			likedBack:       "좋아요를 보냈습니다",
+			matched:         "상호 호감입니다! 대화를 시작하세요",
+			skipped:         "건너뛰었습니다",
+			blocked:         "사용자가 차단되었습니다",
+		}
+	case "nl":
+		return &commandTranslation{
+			profileNotFound: "Je hebt nog geen profiel. Druk op de Menu-knop om er een te maken",
+			statusActive:    "Het profiel is actief",
+			statusPaused:    "Het profiel is gepauzeerd en verborgen in de zoekresultaten",
+			likesTitle:      "Laatste likes:",
+			likesEmpty:      "Nog geen likes",
+			matchesTitle:    "Wederzijdse sympathieën:",
+			matchesEmpty:    "Nog geen wederzijdse sympathieën",
+			paused:          "Het profiel is gepauzeerd en verborgen in de zoekresultaten",
+			resumed:         "Het profiel is weer actief",
+			deleteConfirm:   "Profiel verwijderen? Deze actie kan niet ongedaan worden gemaakt",
+			deleteYes:       "Verwijderen",
+			deleteNo:        "Annuleren",
+			deleted:         "Het profiel is verwijderd",
+			deleteCanceled:  "Verwijderen geannuleerd",
+			unknownError:    "Er is iets misgegaan, probeer het later opnieuw",
+			likeBack:        "Terug liken",
+			likeSkip:        "Overslaan",
+			likeBlock:       "Blokkeren",
+			likedBack:       "Je hebt terug geliket",
+			matched:         "Wederzijdse sympathie! Begin te communiceren",
+			skipped:         "Overgeslagen",
+			blocked:         "De gebruiker is geblokkeerd",
+		}
+	case "no":
+		return &commandTranslation{
+			profileNotFound: "Du har ingen profil ennå. Trykk på Meny-knappen for å opprette en",
+			statusActive:    "Profilen er aktiv",
+			statusPaused:    "Profilen er satt på pause og skjult fra søk",
+			likesTitle:      "Siste likes:",
+			likesEmpty:      "Ingen likes ennå",
+			matchesTitle:    "Gjensidige sympatier:",
+			matchesEmpty:    "Ingen gjensidige sympatier ennå",
+			paused:          "Profilen er satt på pause og skjult fra søk",
+			resumed:         "Profilen er aktiv igjen",
+			deleteConfirm:   "Slette profilen? Denne handlingen kan ikke angres",
+			deleteYes:       "Slett",
+			deleteNo:        "Avbryt",
+			deleted:         "Profilen er slettet",
+			deleteCanceled:  "Slettingen er avbrutt",
+			unknownError:    "Noe gikk galt, prøv igjen senere",
+			likeBack:        "Lik tilbake",
+			likeSkip:        "Hopp over",
+			likeBlock:       "Blokker",
+			likedBack:       "Du likte tilbake",
+			matched:         "Gjensidig sympati! Begynn å kommunisere",
+			skipped:         "Hoppet over",
+			blocked:         "Brukeren er blokkert",
+		}
+	case "pt":
+		return &commandTranslation{
+			profileNotFound: "Você ainda não tem perfil. Pressione o botão Menu para criá-lo",
+			statusActive:    "O perfil está ativo",
+			statusPaused:    "O perfil está pausado e oculto da pesquisa",
+			likesTitle:      "Últimas curtidas:",
+			likesEmpty:      "Ainda não há curtidas",
+			matchesTitle:    "Simpatias mútuas:",
+			matchesEmpty:    "Ainda não há simpatias mútuas",
+			paused:          "O perfil foi pausado e ocultado da pesquisa",
+			resumed:         "O perfil está ativo novamente",
+			deleteConfirm:   "Excluir o perfil? Esta ação não pode ser desfeita",
+			deleteYes:       "Excluir",
+			deleteNo:        "Cancelar",
+			deleted:         "O perfil foi excluído",
+			deleteCanceled:  "Exclusão cancelada",
+			unknownError:    "Algo deu errado, tente novamente mais tarde",
+			likeBack:        "Curtir de volta",
+			likeSkip:        "Pular",
+			likeBlock:       "Bloquear",
+			likedBack:       "Você curtiu de volta",
+			matched:         "Há simpatia mútua! Comece a se comunicar",
+			skipped:         "Pulado",
+			blocked:         "O usuário foi bloqueado",
+		}
+	case "sv":
+		return &commandTranslation{
+			profileNotFound: "Du har ingen profil ännu. Tryck på Meny-knappen för att skapa en",
+			statusActive:    "Profilen är aktiv",
+			statusPaused:    "Profilen är pausad och dold i sökningen",
+			likesTitle:      "Senaste gillningar:",
+			likesEmpty:      "Inga gillningar ännu",
+			matchesTitle:    "Ömsesidiga sympatier:",
+			matchesEmpty:    "Inga ömsesidiga sympatier ännu",
+			paused:          "Profilen har pausats och dolts i sökningen",
+			resumed:         "Profilen är aktiv igen",
+			deleteConfirm:   "Radera profilen? Den här åtgärden kan inte ångras",
+			deleteYes:       "Radera",
+			deleteNo:        "Avbryt",
+			deleted:         "Profilen har raderats",
+			deleteCanceled:  "Raderingen avbröts",
+			unknownError:    "Något gick fel, försök igen senare",
+			likeBack:        "Gilla tillbaka",
+			likeSkip:        "Hoppa över",
+			likeBlock:       "Blockera",
+			likedBack:       "Du gillade tillbaka",
+			matched:         "Ömsesidig sympati! Börja kommunicera",
+			skipped:         "Överhoppad",
+			blocked:         "Användaren har blockerats",
+		}
+	case "uk":
+		return &commandTranslation{
+			profileNotFound: "У тебе ще немає анкети. Натисни кнопку Menu, щоб створити її",
+			statusActive:    "Анкета активна",
+			statusPaused:    "Анкета на паузі та прихована з пошуку",
+			likesTitle:      "Останні лайки:",
+			likesEmpty:      "Лайків поки немає",
+			matchesTitle:    "Взаємні симпатії:",
+			matchesEmpty:    "Взаємних симпатій поки немає",
+			paused:          "Анкету поставлено на паузу та приховано з пошуку",
+			resumed:         "Анкета знову активна",
+			deleteConfirm:   "Видалити анкету? Цю дію неможливо скасувати",
+			deleteYes:       "Видалити",
+			deleteNo:        "Скасувати",
+			deleted:         "Анкету видалено",
+			deleteCanceled:  "Видалення скасовано",
+			unknownError:    "Щось пішло не так, спробуй пізніше",
+			likeBack:        "Лайк у відповідь",
+			likeSkip:        "Пропустити",
+			likeBlock:       "Заблокувати",
+			likedBack:       "Ти поставив лайк у відповідь",
+			matched:         "Є взаємна симпатія! Починай спілкуватися",
+			skipped:         "Пропущено",
+			blocked:         "Користувача заблоковано",
+		}
+	case "zh":
+		return &commandTranslation{
+			profileNotFound: "你还没有个人资料。按菜单按钮创建一个",
+			statusActive:    "个人资料已启用",
+			statusPaused:    "个人资料已暂停，不会出现在搜索中",
+			likesTitle:      "最近的点赞：",
+			likesEmpty:      "还没有点赞",
+			matchesTitle:    "互相喜欢：",
+			matchesEmpty:    "还没有互相喜欢",
+			paused:          "个人资料已暂停，并已从搜索中隐藏",
+			resumed:         "个人资料已重新启用",
+			deleteConfirm:   "删除个人资料？此操作无法撤销",
+			deleteYes:       "删除",
+			deleteNo:        "取消",
+			deleted:         "个人资料已删除",
+			deleteCanceled:  "已取消删除",
+			unknownError:    "出了点问题，请稍后再试",
+			likeBack:        "回赞",
+			likeSkip:        "跳过",
+			likeBlock:       "屏蔽",
+			likedBack:       "你已回赞",
+			matched:         "互相喜欢！开始聊天吧",
+			skipped:         "已跳过",
+			blocked:         "用户已被屏蔽",
+		}
+	default:
+		return &commandTranslation{
+			profileNotFound: "You don't have a profile yet. Press the Menu button to create one",
+			statusActive:    "The profile is active",
+			statusPaused:    "The profile is paused and hidden from search",
+			likesTitle:      "Recent likes:",
+			likesEmpty:      "No likes yet",
+			matchesTitle:    "Mutual sympathies:",
+			matchesEmpty:    "No mutual sympathies yet",
+			paused:          "The profile has been paused and hidden from search",
+			resumed:         "The profile is active again",
+			deleteConfirm:   "Delete the profile? This action cannot be undone",
+			deleteYes:       "Delete",
+			deleteNo:        "Cancel",
+			deleted:         "The profile has been deleted",
+			deleteCanceled:  "Deletion canceled",
+			unknownError:    "Something went wrong, try again later",
+			likeBack:        "Like back",
+			likeSkip:        "Skip",
+			likeBlock:       "Block",
+			likedBack:       "You liked back",
+			matched:         "It's a match! Start communicating",
+			skipped:         "Skipped",
+			blocked:         "The user has been blocked",
+		}
+	}
+}
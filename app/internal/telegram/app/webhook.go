@@ -0,0 +1,79 @@
+package app
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"strings"
+)
+
+const (
+	errorFilePathWebhook     = "internal/telegram/app/webhook.go"
+	webhookPathPrefix        = "/api/v1/telegram/webhook/"
+	webhookBufferSize        = 100
+	headerWebhookSecretToken = "X-Telegram-Bot-Api-Secret-Token"
+)
+
+var ErrWebhookNotConfigured = errors.New("telegram webhook url or secret is not configured")
+
+// isWebhookEnabled - checks that the updates are received by the webhook. The webhook
+// is never exposed without the secret token
+func (app *App) isWebhookEnabled() bool {
+	return app.config.TelegramUpdateMode == UpdateModeWebhook && app.config.TelegramWebhookSecret != ""
+}
+
+// getWebhookPath - returns the secret path of the webhook. It is derived from the bot token,
+// so the path is stable between restarts and can't be guessed
+func (app *App) getWebhookPath() string {
+	hash := sha256.Sum256([]byte(app.config.TelegramBotToken))
+	return webhookPathPrefix + hex.EncodeToString(hash[:])
+}
+
+// startWebhook - registers the webhook in Telegram and returns the channel of the received updates
+func (app *App) startWebhook() (tgbotapi.UpdatesChannel, error) {
+	if !app.isWebhookEnabled() || app.config.TelegramWebhookUrl == "" {
+		return nil, ErrWebhookNotConfigured
+	}
+	params := tgbotapi.Params{
+		"url":          strings.TrimSuffix(app.config.TelegramWebhookUrl, "/") + app.getWebhookPath(),
+		"secret_token": app.config.TelegramWebhookSecret,
+	}
+	if _, err := bot.MakeRequest("setWebhook", params); err != nil {
+		errorMessage := getErrorMessage("startWebhook", "setWebhook",
+			errorFilePathWebhook)
+		app.Logger.Debug(errorMessage, zap.Error(err))
+		return nil, err
+	}
+	app.Logger.Info("Receiving Telegram updates by webhook")
+	return app.webhookUpdates, nil
+}
+
+// handleWebhook - accepts the update sent by Telegram. The update is queued for the same handler
+// as the polling updates, so Telegram gets the response without waiting for the handling
+func (app *App) handleWebhook() fiber.Handler {
+	return func(ctf *fiber.Ctx) error {
+		secretToken := ctf.Get(headerWebhookSecretToken)
+		if subtle.ConstantTimeCompare([]byte(secretToken), []byte(app.config.TelegramWebhookSecret)) != 1 {
+			return ctf.SendStatus(fiber.StatusUnauthorized)
+		}
+		update := tgbotapi.Update{}
+		if err := json.Unmarshal(ctf.Body(), &update); err != nil {
+			errorMessage := getErrorMessage("handleWebhook", "json.Unmarshal",
+				errorFilePathWebhook)
+			app.Logger.Debug(errorMessage, zap.Error(err))
+			return ctf.SendStatus(fiber.StatusBadRequest)
+		}
+		select {
+		case app.webhookUpdates <- update:
+			return ctf.SendStatus(fiber.StatusOK)
+		default:
+			// Telegram retries the update later
+			return ctf.SendStatus(fiber.StatusServiceUnavailable)
+		}
+	}
+}
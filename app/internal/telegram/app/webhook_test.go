@@ -0,0 +1,152 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/config"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testBotToken      = "123:token"
+	testWebhookUrl    = "https://bot.example.com/"
+	testWebhookSecret = "secret"
+)
+
+// testBotApiRequest - a method of the Bot API called by the bot with its parameters
+type testBotApiRequest struct {
+	method string
+	params url.Values
+}
+
+// newTestBotApi - a fake Bot API, every call of the bot is sent to the returned channel
+func newTestBotApi(t *testing.T) (*httptest.Server, chan testBotApiRequest) {
+	requests := make(chan testBotApiRequest, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("ParseForm: %v", err)
+		}
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		requests <- testBotApiRequest{method: method, params: r.PostForm}
+		result := "true"
+		switch method {
+		case "getMe":
+			result = `{"id":1,"is_bot":true,"username":"test_bot"}`
+		case "editMessageText":
+			result = `{"message_id":1,"chat":{"id":1}}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+// waitBotApiRequest - waits for the call of the method of the Bot API, the calls of other methods are skipped
+func waitBotApiRequest(t *testing.T, requests chan testBotApiRequest, method string) url.Values {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case r := <-requests:
+			if r.method == method {
+				return r.params
+			}
+		case <-timeout:
+			t.Fatalf("%s is not called", method)
+			return nil
+		}
+	}
+}
+
+func newTestWebhookApp(apiEndpoint string) *App {
+	return &App{
+		config: &config.Config{
+			TelegramBotToken:      testBotToken,
+			TelegramApiEndpoint:   apiEndpoint,
+			TelegramUpdateMode:    UpdateModeWebhook,
+			TelegramWebhookUrl:    testWebhookUrl,
+			TelegramWebhookSecret: testWebhookSecret,
+		},
+		fiber:          fiber.New(),
+		commandRouter:  NewCommandRouter(zap.NewNop(), nil),
+		webhookUpdates: make(chan tgbotapi.Update, webhookBufferSize),
+		Logger:         zap.NewNop(),
+	}
+}
+
+func newTestWebhookRequest(t *testing.T, path, secretToken string, update any) *http.Request {
+	body, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(string(body)))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	if secretToken != "" {
+		req.Header.Set(headerWebhookSecretToken, secretToken)
+	}
+	return req
+}
+
+func TestWebhook(t *testing.T) {
+	srv, requests := newTestBotApi(t)
+	app := newTestWebhookApp(srv.URL + "/bot%s/%s")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := app.StartBot(ctx); err != nil {
+		t.Fatalf("StartBot: %v", err)
+	}
+	app.setupRoutes()
+
+	params := waitBotApiRequest(t, requests, "setWebhook")
+	if got, want := params.Get("url"), strings.TrimSuffix(testWebhookUrl, "/")+app.getWebhookPath(); got != want {
+		t.Errorf("setWebhook url = %q, want %q", got, want)
+	}
+	if got := params.Get("secret_token"); got != testWebhookSecret {
+		t.Errorf("setWebhook secret_token = %q", got)
+	}
+
+	// The button that cancels the deletion of the profile is handled without the profiles service
+	newUpdate := func(callbackQueryId string) tgbotapi.Update {
+		return tgbotapi.Update{
+			UpdateID: 1,
+			CallbackQuery: &tgbotapi.CallbackQuery{
+				ID:      callbackQueryId,
+				From:    &tgbotapi.User{ID: 1, LanguageCode: "en"},
+				Message: &tgbotapi.Message{MessageID: 1, Chat: &tgbotapi.Chat{ID: 1}},
+				Data:    callbackDeleteCancel,
+			},
+		}
+	}
+	for _, secretToken := range []string{"", "wrong"} {
+		resp, err := app.fiber.Test(newTestWebhookRequest(t, app.getWebhookPath(), secretToken, newUpdate("1")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != fiber.StatusUnauthorized {
+			t.Errorf("status with the secret token %q = %d, want %d", secretToken, resp.StatusCode,
+				fiber.StatusUnauthorized)
+		}
+	}
+
+	resp, err := app.fiber.Test(newTestWebhookRequest(t, app.getWebhookPath(), testWebhookSecret, newUpdate("42")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+	// handleUpdate answers the pressed button. The updates are handled in order, so the answer
+	// to a rejected update would come first
+	params = waitBotApiRequest(t, requests, "answerCallbackQuery")
+	if got := params.Get("callback_query_id"); got != "42" {
+		t.Errorf("answerCallbackQuery callback_query_id = %q, want %q", got, "42")
+	}
+}
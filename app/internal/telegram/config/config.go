@@ -7,11 +7,17 @@ import (
 )
 
 type Config struct {
-	LoggerLevel      string `envconfig:"LOGGER_LEVEL"`
-	TelegramBotToken string `envconfig:"TELEGRAM_BOT_TOKEN"`
-	Kafka1           string `envconfig:"KAFKA_1"`
-	Kafka2           string `envconfig:"KAFKA_2"`
-	Kafka3           string `envconfig:"KAFKA_3"`
+	LoggerLevel           string `envconfig:"LOGGER_LEVEL"`
+	TelegramHost          string `envconfig:"TELEGRAM_HOST"`
+	ProfilesHost          string `envconfig:"PROFILES_HOST"`
+	TelegramBotToken      string `envconfig:"TELEGRAM_BOT_TOKEN"`
+	TelegramApiEndpoint   string `envconfig:"TELEGRAM_API_ENDPOINT"`
+	TelegramUpdateMode    string `envconfig:"TELEGRAM_UPDATE_MODE" default:"polling"`
+	TelegramWebhookUrl    string `envconfig:"TELEGRAM_WEBHOOK_URL"`
+	TelegramWebhookSecret string `envconfig:"TELEGRAM_WEBHOOK_SECRET"`
+	Kafka1                string `envconfig:"KAFKA_1"`
+	Kafka2                string `envconfig:"KAFKA_2"`
+	Kafka3                string `envconfig:"KAFKA_3"`
 }
 
 func Load(l logger.Logger) (*Config, error) {
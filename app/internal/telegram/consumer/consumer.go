@@ -0,0 +1,215 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/EvgeniyBudaev/tgdating-go/app/internal/telegram/logger"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	errorFilePathConsumer = "internal/telegram/consumer/consumer.go"
+	DeadLetterTopicSuffix = ".dlq"
+	maxAttempts           = 5
+	retryBaseDelay        = time.Second
+	retryMaxDelay         = 30 * time.Second
+	headerError           = "x-error"
+	headerAttempts        = "x-attempts"
+	headerTopic           = "x-original-topic"
+	headerPartition       = "x-original-partition"
+	headerOffset          = "x-original-offset"
+)
+
+// ErrPoisonMessage - the message can never be delivered, it is dead-lettered without retries
+var ErrPoisonMessage = errors.New("poison message")
+
+// MessageReader - the source of the messages, implemented by *kafka.Reader
+type MessageReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// MessageWriter - the dead-letter topic, implemented by *kafka.Writer
+type MessageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// Handler - delivers a single message
+type Handler func(ctx context.Context, m kafka.Message) error
+
+// Stats - the counters of the consumer
+type Stats struct {
+	Delivered    uint64 `json:"delivered"`
+	Retried      uint64 `json:"retried"`
+	DeadLettered uint64 `json:"deadLettered"`
+}
+
+// Consumer - reads the messages one by one and commits the offset of a message only after
+// it has been delivered or written to the dead-letter topic
+type Consumer struct {
+	logger       logger.Logger
+	reader       MessageReader
+	dlqWriter    MessageWriter
+	handler      Handler
+	delivered    atomic.Uint64
+	retried      atomic.Uint64
+	deadLettered atomic.Uint64
+}
+
+func NewConsumer(l logger.Logger, r MessageReader, dlq MessageWriter, h Handler) *Consumer {
+	return &Consumer{
+		logger:    l,
+		reader:    r,
+		dlqWriter: dlq,
+		handler:   h,
+	}
+}
+
+// Run - consumes the messages until ctx is canceled
+func (c *Consumer) Run(ctx context.Context) error {
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			errorMessage := c.getErrorMessage("Run", "FetchMessage")
+			c.logger.Debug(errorMessage, zap.Error(err))
+			if !c.sleep(ctx, retryBaseDelay) {
+				return nil
+			}
+			continue
+		}
+		if err := c.process(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			errorMessage := c.getErrorMessage("Run", "process")
+			c.logger.Error(errorMessage, zap.Error(err))
+		}
+	}
+}
+
+// Stats - returns a snapshot of the counters
+func (c *Consumer) Stats() *Stats {
+	return &Stats{
+		Delivered:    c.delivered.Load(),
+		Retried:      c.retried.Load(),
+		DeadLettered: c.deadLettered.Load(),
+	}
+}
+
+func (c *Consumer) process(ctx context.Context, m kafka.Message) error {
+	var err error
+	attempt := 1
+	for ; ; attempt++ {
+		if err = c.handler(ctx, m); err == nil {
+			c.delivered.Add(1)
+			return c.reader.CommitMessages(ctx, m)
+		}
+		if IsPoisonMessage(err) || attempt >= maxAttempts {
+			break
+		}
+		c.retried.Add(1)
+		c.logger.Debug("message delivery failed, retrying", zap.Error(err), zap.Int("attempt", attempt),
+			zap.Int64("offset", m.Offset))
+		if !c.sleep(ctx, getRetryDelay(attempt, err)) {
+			return ctx.Err()
+		}
+	}
+	if err := c.deadLetter(ctx, m, err, attempt); err != nil {
+		return err
+	}
+	c.deadLettered.Add(1)
+	return c.reader.CommitMessages(ctx, m)
+}
+
+// deadLetter - writes the message to the dead-letter topic. The offset of the message is committed
+// only after the write, so the write is retried until it succeeds instead of losing the message
+func (c *Consumer) deadLetter(ctx context.Context, m kafka.Message, cause error, attempts int) error {
+	headers := make([]kafka.Header, 0, len(m.Headers)+5)
+	headers = append(headers, m.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: headerError, Value: []byte(cause.Error())},
+		kafka.Header{Key: headerAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: headerTopic, Value: []byte(m.Topic)},
+		kafka.Header{Key: headerPartition, Value: []byte(strconv.Itoa(m.Partition))},
+		kafka.Header{Key: headerOffset, Value: []byte(strconv.FormatInt(m.Offset, 10))},
+	)
+	dlqMessage := kafka.Message{
+		Topic:   m.Topic + DeadLetterTopicSuffix,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}
+	c.logger.Warn("message is dead-lettered", zap.Error(cause), zap.Int64("offset", m.Offset))
+	for attempt := 1; ; attempt++ {
+		err := c.dlqWriter.WriteMessages(ctx, dlqMessage)
+		if err == nil {
+			return nil
+		}
+		errorMessage := c.getErrorMessage("deadLetter", "WriteMessages")
+		c.logger.Error(errorMessage, zap.Error(err))
+		if !c.sleep(ctx, getRetryDelay(attempt, err)) {
+			return ctx.Err()
+		}
+	}
+}
+
+// sleep - waits for the delay and returns false if ctx has been canceled
+func (c *Consumer) sleep(ctx context.Context, delay time.Duration) bool {
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+func (c *Consumer) getErrorMessage(repositoryMethodName string, callMethodName string) string {
+	return fmt.Sprintf("error func %s, method %s by path %s", repositoryMethodName, callMethodName,
+		errorFilePathConsumer)
+}
+
+// IsPoisonMessage - checks that the retries of the message are pointless: the message is malformed,
+// or Telegram has rejected it, e.g. the user has blocked the bot
+func IsPoisonMessage(err error) bool {
+	if errors.Is(err, ErrPoisonMessage) {
+		return true
+	}
+	var numError *strconv.NumError
+	if errors.As(err, &numError) {
+		return true
+	}
+	var telegramError *tgbotapi.Error
+	if errors.As(err, &telegramError) {
+		return telegramError.Code == http.StatusBadRequest || telegramError.Code == http.StatusForbidden
+	}
+	return false
+}
+
+// getRetryDelay - doubles the delay after every failed attempt up to retryMaxDelay.
+// Telegram flood control tells how long to wait, that delay is used instead
+func getRetryDelay(attempt int, err error) time.Duration {
+	var telegramError *tgbotapi.Error
+	if errors.As(err, &telegramError) && telegramError.RetryAfter > 0 {
+		return time.Duration(telegramError.RetryAfter) * time.Second
+	}
+	delay := retryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS dating.profile_matches CASCADE;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS dating.profile_matches
+(
+    id                       BIGSERIAL    NOT NULL PRIMARY KEY,
+    telegram_user_id         VARCHAR(255) NOT NULL,
+    matched_telegram_user_id VARCHAR(255) NOT NULL,
+    created_at               TIMESTAMP    NOT NULL,
+    CONSTRAINT uq_profile_matches_pair UNIQUE (telegram_user_id, matched_telegram_user_id),
+    CONSTRAINT fk_profile_matches_telegram_user_id FOREIGN KEY (telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE,
+    CONSTRAINT fk_profile_matches_matched_telegram_user_id FOREIGN KEY (matched_telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_matches_matched_telegram_user_id ON dating.profile_matches (matched_telegram_user_id);
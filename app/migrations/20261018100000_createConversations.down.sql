@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS dating.messages CASCADE;
+DROP TABLE IF EXISTS dating.conversations CASCADE;
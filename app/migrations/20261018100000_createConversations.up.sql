@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS dating.conversations
+(
+    id                    BIGSERIAL    NOT NULL PRIMARY KEY,
+    telegram_user_id      VARCHAR(255) NOT NULL,
+    peer_telegram_user_id VARCHAR(255) NOT NULL,
+    last_message_id       BIGINT,
+    last_message_at       TIMESTAMP,
+    created_at            TIMESTAMP    NOT NULL,
+    updated_at            TIMESTAMP    NOT NULL,
+    CONSTRAINT uq_conversations_pair UNIQUE (telegram_user_id, peer_telegram_user_id),
+    CONSTRAINT fk_conversations_telegram_user_id FOREIGN KEY (telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE,
+    CONSTRAINT fk_conversations_peer_telegram_user_id FOREIGN KEY (peer_telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_conversations_peer_telegram_user_id ON dating.conversations (peer_telegram_user_id);
+
+CREATE TABLE IF NOT EXISTS dating.messages
+(
+    id                         BIGSERIAL    NOT NULL PRIMARY KEY,
+    conversation_id            BIGINT       NOT NULL,
+    sender_telegram_user_id    VARCHAR(255) NOT NULL,
+    recipient_telegram_user_id VARCHAR(255) NOT NULL,
+    text                       TEXT         NOT NULL,
+    is_read                    BOOL         NOT NULL,
+    read_at                    TIMESTAMP,
+    created_at                 TIMESTAMP    NOT NULL,
+    CONSTRAINT fk_messages_conversation_id FOREIGN KEY (conversation_id) REFERENCES dating.conversations (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_messages_conversation_id_id ON dating.messages (conversation_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_messages_unread ON dating.messages (conversation_id, recipient_telegram_user_id) WHERE is_read = false;
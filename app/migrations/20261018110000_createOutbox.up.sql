@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS dating.outbox
+(
+    id              BIGSERIAL    NOT NULL PRIMARY KEY,
+    topic           VARCHAR(255) NOT NULL,
+    message_key     VARCHAR(255) NOT NULL,
+    payload         BYTEA        NOT NULL,
+    is_sent         BOOL         NOT NULL,
+    attempts        INTEGER      NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP    NOT NULL,
+    created_at      TIMESTAMP    NOT NULL,
+    sent_at         TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON dating.outbox (next_attempt_at, id) WHERE is_sent = false;
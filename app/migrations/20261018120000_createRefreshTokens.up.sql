@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS dating.profile_refresh_tokens
+(
+    id               BIGSERIAL    NOT NULL PRIMARY KEY,
+    telegram_user_id VARCHAR(255) NOT NULL,
+    token_hash       VARCHAR(64)  NOT NULL,
+    expires_at       TIMESTAMP    NOT NULL,
+    revoked_at       TIMESTAMP,
+    created_at       TIMESTAMP    NOT NULL,
+    CONSTRAINT uq_profile_refresh_tokens_token_hash UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_refresh_tokens_telegram_user_id ON dating.profile_refresh_tokens (telegram_user_id) WHERE revoked_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS dating.idx_profile_complaints_criminal_telegram_user_id;
+DROP TABLE IF EXISTS dating.admin_audit_logs CASCADE;
+DROP TABLE IF EXISTS dating.admins CASCADE;
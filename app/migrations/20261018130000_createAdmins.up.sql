@@ -0,0 +1,27 @@
+-- Admins are added manually, e.g.
+-- INSERT INTO dating.admins (telegram_user_id, role, created_at, updated_at) VALUES ('123', 'owner', NOW(), NOW());
+CREATE TABLE IF NOT EXISTS dating.admins
+(
+    id               BIGSERIAL    NOT NULL PRIMARY KEY,
+    telegram_user_id VARCHAR(255) NOT NULL UNIQUE,
+    role             VARCHAR(50)  NOT NULL,
+    created_at       TIMESTAMP    NOT NULL,
+    updated_at       TIMESTAMP    NOT NULL,
+    CONSTRAINT chk_admins_role CHECK (role IN ('moderator', 'support', 'owner'))
+);
+
+CREATE TABLE IF NOT EXISTS dating.admin_audit_logs
+(
+    id                      BIGSERIAL    NOT NULL PRIMARY KEY,
+    admin_telegram_user_id  VARCHAR(255) NOT NULL,
+    role                    VARCHAR(50)  NOT NULL,
+    action                  VARCHAR(100) NOT NULL,
+    target_telegram_user_id VARCHAR(255),
+    target_image_id         BIGINT,
+    reason                  TEXT         NOT NULL DEFAULT '',
+    created_at              TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_admin_telegram_user_id ON dating.admin_audit_logs (admin_telegram_user_id, id);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_target_telegram_user_id ON dating.admin_audit_logs (target_telegram_user_id, id);
+CREATE INDEX IF NOT EXISTS idx_profile_complaints_criminal_telegram_user_id ON dating.profile_complaints (criminal_telegram_user_id);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS dating.profile_complaint_appeals;
+
+DROP INDEX IF EXISTS dating.idx_profile_complaints_telegram_user_id_status;
+DROP INDEX IF EXISTS dating.idx_profile_complaints_criminal_telegram_user_id_status;
+
+ALTER TABLE dating.profile_complaints
+    DROP CONSTRAINT IF EXISTS chk_profile_complaints_status;
+
+ALTER TABLE dating.profile_complaints
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS weight,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,37 @@
+ALTER TABLE dating.profile_complaints
+    ADD COLUMN IF NOT EXISTS status      VARCHAR(50)      NOT NULL DEFAULT 'open',
+    ADD COLUMN IF NOT EXISTS weight      DOUBLE PRECISION NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS reviewed_by VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+
+ALTER TABLE dating.profile_complaints
+    ADD CONSTRAINT chk_profile_complaints_status CHECK (status IN ('open', 'under_review', 'upheld', 'dismissed'));
+
+-- the users already blocked by complaints get a case to review
+UPDATE dating.profile_complaints pc
+SET status = 'under_review'
+FROM dating.profile_statuses ps
+WHERE ps.telegram_user_id = pc.criminal_telegram_user_id
+  AND ps.is_blocked = true;
+
+CREATE INDEX IF NOT EXISTS idx_profile_complaints_criminal_telegram_user_id_status
+    ON dating.profile_complaints (criminal_telegram_user_id, status);
+CREATE INDEX IF NOT EXISTS idx_profile_complaints_telegram_user_id_status
+    ON dating.profile_complaints (telegram_user_id, status);
+
+CREATE TABLE IF NOT EXISTS dating.profile_complaint_appeals
+(
+    id               BIGSERIAL    NOT NULL PRIMARY KEY,
+    telegram_user_id VARCHAR(255) NOT NULL,
+    description      TEXT         NOT NULL DEFAULT '',
+    status           VARCHAR(50)  NOT NULL DEFAULT 'open',
+    reviewed_by      VARCHAR(255),
+    created_at       TIMESTAMP    NOT NULL,
+    updated_at       TIMESTAMP    NOT NULL,
+    CONSTRAINT chk_profile_complaint_appeals_status CHECK (status IN ('open', 'accepted', 'rejected')),
+    CONSTRAINT fk_profile_complaint_appeals_telegram_user_id FOREIGN KEY (telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE
+);
+
+-- a user has at most one appeal waiting for a decision
+CREATE UNIQUE INDEX IF NOT EXISTS idx_profile_complaint_appeals_telegram_user_id_open
+    ON dating.profile_complaint_appeals (telegram_user_id) WHERE status = 'open';
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS dating.image_hash_blocklist;
+
+DROP INDEX IF EXISTS dating.idx_profile_images_content_hash;
+
+ALTER TABLE dating.profile_images
+    DROP COLUMN IF EXISTS content_hash;
+
+DROP INDEX IF EXISTS dating.idx_profile_image_statuses_moderation_status_pending;
+
+ALTER TABLE dating.profile_image_statuses
+    DROP CONSTRAINT IF EXISTS chk_profile_image_statuses_moderation_status;
+
+ALTER TABLE dating.profile_image_statuses
+    DROP COLUMN IF EXISTS moderation_next_attempt_at,
+    DROP COLUMN IF EXISTS moderation_attempts,
+    DROP COLUMN IF EXISTS moderated_at,
+    DROP COLUMN IF EXISTS moderation_reason,
+    DROP COLUMN IF EXISTS moderation_status;
@@ -0,0 +1,32 @@
+-- the images uploaded before the moderation are considered approved
+ALTER TABLE dating.profile_image_statuses
+    ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(50) NOT NULL DEFAULT 'approved',
+    ADD COLUMN IF NOT EXISTS moderation_reason TEXT        NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS moderated_at      TIMESTAMP;
+
+-- an image that can't be loaded or checked is retried with a backoff and fails after the last attempt
+ALTER TABLE dating.profile_image_statuses
+    ADD COLUMN IF NOT EXISTS moderation_attempts        INTEGER   NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS moderation_next_attempt_at TIMESTAMP;
+
+ALTER TABLE dating.profile_image_statuses
+    ALTER COLUMN moderation_status SET DEFAULT 'pending';
+
+ALTER TABLE dating.profile_image_statuses
+    ADD CONSTRAINT chk_profile_image_statuses_moderation_status
+        CHECK (moderation_status IN ('pending', 'approved', 'blocked', 'failed'));
+
+CREATE INDEX IF NOT EXISTS idx_profile_image_statuses_moderation_status_pending
+    ON dating.profile_image_statuses (image_id) WHERE moderation_status = 'pending';
+
+ALTER TABLE dating.profile_images
+    ADD COLUMN IF NOT EXISTS content_hash VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_profile_images_content_hash ON dating.profile_images (content_hash);
+
+CREATE TABLE IF NOT EXISTS dating.image_hash_blocklist
+(
+    hash       VARCHAR(64) NOT NULL PRIMARY KEY,
+    reason     TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMP   NOT NULL
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS dating.idx_profile_images_telegram_user_id_position;
+
+ALTER TABLE dating.profile_images
+    DROP COLUMN IF EXISTS position;
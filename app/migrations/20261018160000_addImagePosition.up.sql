@@ -0,0 +1,18 @@
+ALTER TABLE dating.profile_images
+    ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+
+-- the latest image has been the avatar so far, it keeps the first position and becomes the primary one
+UPDATE dating.profile_images pi
+SET position = ordered.position
+FROM (SELECT id,
+             ROW_NUMBER() OVER (PARTITION BY telegram_user_id ORDER BY created_at DESC, id DESC) - 1 AS position
+      FROM dating.profile_images) ordered
+WHERE ordered.id = pi.id;
+
+UPDATE dating.profile_image_statuses pis
+SET is_primary = (pi.position = 0)
+FROM dating.profile_images pi
+WHERE pi.id = pis.image_id;
+
+CREATE INDEX IF NOT EXISTS idx_profile_images_telegram_user_id_position
+    ON dating.profile_images (telegram_user_id, position);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS dating.profile_image_grants
+(
+    id                      BIGSERIAL    NOT NULL PRIMARY KEY,
+    telegram_user_id        VARCHAR(255) NOT NULL,
+    viewer_telegram_user_id VARCHAR(255) NOT NULL,
+    created_at              TIMESTAMP    NOT NULL,
+    CONSTRAINT uq_profile_image_grants_telegram_user_id_viewer UNIQUE (telegram_user_id, viewer_telegram_user_id),
+    CONSTRAINT fk_profile_image_grants_telegram_user_id FOREIGN KEY (telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE,
+    CONSTRAINT fk_profile_image_grants_viewer_telegram_user_id FOREIGN KEY (viewer_telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE
+);
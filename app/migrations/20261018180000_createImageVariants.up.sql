@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS dating.profile_image_variants
+(
+    id         BIGSERIAL    NOT NULL PRIMARY KEY,
+    image_id   BIGINT       NOT NULL,
+    variant    VARCHAR(50)  NOT NULL,
+    key        VARCHAR      NOT NULL,
+    url        VARCHAR      NOT NULL,
+    width      INTEGER      NOT NULL DEFAULT 0,
+    height     INTEGER      NOT NULL DEFAULT 0,
+    size       BIGINT       NOT NULL DEFAULT 0,
+    created_at TIMESTAMP    NOT NULL,
+    CONSTRAINT uq_profile_image_variants_image_id_variant UNIQUE (image_id, variant),
+    CONSTRAINT chk_profile_image_variants_variant CHECK (variant IN ('thumbnail', 'medium', 'full')),
+    CONSTRAINT fk_profile_image_variants_image_id FOREIGN KEY (image_id) REFERENCES dating.profile_images (id) ON DELETE CASCADE
+);
+
+-- the images uploaded before have only the original file, it becomes their full variant
+INSERT INTO dating.profile_image_variants (image_id, variant, key, url, size, created_at)
+SELECT id, 'full', '/profiles/' || telegram_user_id || '/images/' || name, url, COALESCE(size, 0), created_at
+FROM dating.profile_images
+WHERE name IS NOT NULL AND url IS NOT NULL
+ON CONFLICT (image_id, variant) DO NOTHING;
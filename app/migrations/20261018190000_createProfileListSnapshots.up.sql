@@ -0,0 +1,11 @@
+-- the ranked discovery feeds of the viewers, one per first page, the next pages are read from them by the cursor
+CREATE TABLE IF NOT EXISTS dating.profile_list_snapshots
+(
+    id                          BIGSERIAL      NOT NULL PRIMARY KEY,
+    telegram_user_id            VARCHAR(255)   NOT NULL,
+    candidate_telegram_user_ids VARCHAR(255)[] NOT NULL,
+    created_at                  TIMESTAMP      NOT NULL,
+    CONSTRAINT fk_profile_list_snapshots_telegram_user_id FOREIGN KEY (telegram_user_id) REFERENCES dating.profiles (telegram_user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_list_snapshots_created_at ON dating.profile_list_snapshots (created_at);